@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -158,12 +159,19 @@ func (x *Bill) GetFiscalNoteUrl() string {
 
 // ListBillsRequest supports filtering and pagination.
 type ListBillsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionYear   int32                  `protobuf:"varint,1,opt,name=session_year,json=sessionYear,proto3" json:"session_year,omitempty"` // e.g. 2026; defaults to current year if 0
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                               // e.g. "introduced", "passed"
-	SponsorId     string                 `protobuf:"bytes,3,opt,name=sponsor_id,json=sponsorId,proto3" json:"sponsor_id,omitempty"`        // UUID of sponsor legislator
-	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`                                  // 1-indexed; defaults to 1
-	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`          // defaults to 50
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SessionYear int32                  `protobuf:"varint,1,opt,name=session_year,json=sessionYear,proto3" json:"session_year,omitempty"` // e.g. 2026; defaults to current year if 0
+	Status      string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                               // e.g. "introduced", "passed"
+	SponsorId   string                 `protobuf:"bytes,3,opt,name=sponsor_id,json=sponsorId,proto3" json:"sponsor_id,omitempty"`        // UUID of sponsor legislator
+	Page        int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`                                  // 1-indexed; defaults to 1
+	PageSize    int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`          // defaults to 50
+	// Fields to return for each bill, e.g. "id,bill_number,title,status".
+	// Empty returns every field. Masks without "sponsor" (or a "sponsor.*"
+	// subpath) skip the sponsor lookup entirely.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// The caller's feed: only bills they follow, from every session unless
+	// session_year is set, most recent action first. Requires sign-in.
+	Followed      bool `protobuf:"varint,7,opt,name=followed,proto3" json:"followed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -233,6 +241,20 @@ func (x *ListBillsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListBillsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *ListBillsRequest) GetFollowed() bool {
+	if x != nil {
+		return x.Followed
+	}
+	return false
+}
+
 type ListBillsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Bills         []*Bill                `protobuf:"bytes,1,rep,name=bills,proto3" json:"bills,omitempty"`
@@ -373,11 +395,164 @@ func (x *GetBillResponse) GetBill() *Bill {
 	return nil
 }
 
+// WatchBillsRequest selects the bills to watch: those in bill_ids, or
+// else those matching the filters. With neither, every bill is watched.
+type WatchBillsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	BillIds     []string               `protobuf:"bytes,1,rep,name=bill_ids,json=billIds,proto3" json:"bill_ids,omitempty"` // at most 100
+	SessionYear int32                  `protobuf:"varint,2,opt,name=session_year,json=sessionYear,proto3" json:"session_year,omitempty"`
+	SponsorId   string                 `protobuf:"bytes,3,opt,name=sponsor_id,json=sponsorId,proto3" json:"sponsor_id,omitempty"` // UUID of sponsor legislator
+	// Only bills the caller follows, directly or through their sponsor, as
+	// of when the stream starts. Requires sign-in.
+	Followed bool `protobuf:"varint,4,opt,name=followed,proto3" json:"followed,omitempty"`
+	// "bill.status_changed", "bill.action", "bill.new_version"; empty means
+	// status changes and actions.
+	EventTypes    []string `protobuf:"bytes,5,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchBillsRequest) Reset() {
+	*x = WatchBillsRequest{}
+	mi := &file_proto_v1_bills_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBillsRequest) ProtoMessage() {}
+
+func (x *WatchBillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_bills_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBillsRequest.ProtoReflect.Descriptor instead.
+func (*WatchBillsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_bills_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchBillsRequest) GetBillIds() []string {
+	if x != nil {
+		return x.BillIds
+	}
+	return nil
+}
+
+func (x *WatchBillsRequest) GetSessionYear() int32 {
+	if x != nil {
+		return x.SessionYear
+	}
+	return 0
+}
+
+func (x *WatchBillsRequest) GetSponsorId() string {
+	if x != nil {
+		return x.SponsorId
+	}
+	return ""
+}
+
+func (x *WatchBillsRequest) GetFollowed() bool {
+	if x != nil {
+		return x.Followed
+	}
+	return false
+}
+
+func (x *WatchBillsRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+// WatchBillsResponse is one update to a watched bill. The stream opens with
+// a "snapshot" of each bill in bill_ids, and sends a "heartbeat" with no
+// bill when it has been idle for a while, so proxies keep it open.
+type WatchBillsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`    // an event type, "snapshot" or "heartbeat"
+	Bill          *Bill                  `protobuf:"bytes,2,opt,name=bill,proto3" json:"bill,omitempty"`                               // the bill after the change; without its sponsor embedded
+	Previous      string                 `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`                       // the status, last action or full text URL before the change
+	OccurredAt    string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // RFC3339 timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchBillsResponse) Reset() {
+	*x = WatchBillsResponse{}
+	mi := &file_proto_v1_bills_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBillsResponse) ProtoMessage() {}
+
+func (x *WatchBillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_bills_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBillsResponse.ProtoReflect.Descriptor instead.
+func (*WatchBillsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_bills_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchBillsResponse) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WatchBillsResponse) GetBill() *Bill {
+	if x != nil {
+		return x.Bill
+	}
+	return nil
+}
+
+func (x *WatchBillsResponse) GetPrevious() string {
+	if x != nil {
+		return x.Previous
+	}
+	return ""
+}
+
+func (x *WatchBillsResponse) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
 var File_proto_v1_bills_proto protoreflect.FileDescriptor
 
 const file_proto_v1_bills_proto_rawDesc = "" +
 	"\n" +
-	"\x14proto/v1/bills.proto\x12\x06api.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/v1/legislators.proto\"\x8c\x03\n" +
+	"\x14proto/v1/bills.proto\x12\x06api.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x13proto/v1/auth.proto\x1a\x1aproto/v1/legislators.proto\"\x8c\x03\n" +
 	"\x04Bill\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vbill_number\x18\x02 \x01(\tR\n" +
@@ -393,24 +568,43 @@ const file_proto_v1_bills_proto_rawDesc = "" +
 	" \x01(\tR\n" +
 	"lastAction\x12(\n" +
 	"\x10last_action_date\x18\v \x01(\tR\x0elastActionDate\x12&\n" +
-	"\x0ffiscal_note_url\x18\f \x01(\tR\rfiscalNoteUrl\"\x9d\x01\n" +
+	"\x0ffiscal_note_url\x18\f \x01(\tR\rfiscalNoteUrl\"\xf2\x01\n" +
 	"\x10ListBillsRequest\x12!\n" +
 	"\fsession_year\x18\x01 \x01(\x05R\vsessionYear\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
 	"sponsor_id\x18\x03 \x01(\tR\tsponsorId\x12\x12\n" +
 	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"M\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x127\n" +
+	"\tread_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12\x1a\n" +
+	"\bfollowed\x18\a \x01(\bR\bfollowed\"M\n" +
 	"\x11ListBillsResponse\x12\"\n" +
 	"\x05bills\x18\x01 \x03(\v2\f.api.v1.BillR\x05bills\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\" \n" +
 	"\x0eGetBillRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"3\n" +
 	"\x0fGetBillResponse\x12 \n" +
-	"\x04bill\x18\x01 \x01(\v2\f.api.v1.BillR\x04bill2\xb6\x01\n" +
+	"\x04bill\x18\x01 \x01(\v2\f.api.v1.BillR\x04bill\"\xad\x01\n" +
+	"\x11WatchBillsRequest\x12\x19\n" +
+	"\bbill_ids\x18\x01 \x03(\tR\abillIds\x12!\n" +
+	"\fsession_year\x18\x02 \x01(\x05R\vsessionYear\x12\x1d\n" +
+	"\n" +
+	"sponsor_id\x18\x03 \x01(\tR\tsponsorId\x12\x1a\n" +
+	"\bfollowed\x18\x04 \x01(\bR\bfollowed\x12\x1f\n" +
+	"\vevent_types\x18\x05 \x03(\tR\n" +
+	"eventTypes\"\x92\x01\n" +
+	"\x12WatchBillsResponse\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12 \n" +
+	"\x04bill\x18\x02 \x01(\v2\f.api.v1.BillR\x04bill\x12\x1a\n" +
+	"\bprevious\x18\x03 \x01(\tR\bprevious\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt2\x9c\x02\n" +
 	"\vBillService\x12S\n" +
 	"\tListBills\x12\x18.api.v1.ListBillsRequest\x1a\x19.api.v1.ListBillsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/bills\x12R\n" +
-	"\aGetBill\x12\x16.api.v1.GetBillRequest\x1a\x17.api.v1.GetBillResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/bills/{id}B\xa4\x01\x92A5\x123\n" +
+	"\aGetBill\x12\x16.api.v1.GetBillRequest\x1a\x17.api.v1.GetBillResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/bills/{id}\x12^\n" +
+	"\n" +
+	"WatchBills\x12\x19.api.v1.WatchBillsRequest\x1a\x1a.api.v1.WatchBillsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/bills:watch0\x01\x1a\x04\xa0\xbb\x18\x01B\xa4\x01\x92A5\x123\n" +
 	"\tBills API\x12!API for querying Utah state bills2\x031.0\n" +
 	"\n" +
 	"com.api.v1B\n" +
@@ -428,28 +622,35 @@ func file_proto_v1_bills_proto_rawDescGZIP() []byte {
 	return file_proto_v1_bills_proto_rawDescData
 }
 
-var file_proto_v1_bills_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_v1_bills_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_proto_v1_bills_proto_goTypes = []any{
-	(*Bill)(nil),              // 0: api.v1.Bill
-	(*ListBillsRequest)(nil),  // 1: api.v1.ListBillsRequest
-	(*ListBillsResponse)(nil), // 2: api.v1.ListBillsResponse
-	(*GetBillRequest)(nil),    // 3: api.v1.GetBillRequest
-	(*GetBillResponse)(nil),   // 4: api.v1.GetBillResponse
-	(*Legislator)(nil),        // 5: api.v1.Legislator
+	(*Bill)(nil),                  // 0: api.v1.Bill
+	(*ListBillsRequest)(nil),      // 1: api.v1.ListBillsRequest
+	(*ListBillsResponse)(nil),     // 2: api.v1.ListBillsResponse
+	(*GetBillRequest)(nil),        // 3: api.v1.GetBillRequest
+	(*GetBillResponse)(nil),       // 4: api.v1.GetBillResponse
+	(*WatchBillsRequest)(nil),     // 5: api.v1.WatchBillsRequest
+	(*WatchBillsResponse)(nil),    // 6: api.v1.WatchBillsResponse
+	(*Legislator)(nil),            // 7: api.v1.Legislator
+	(*fieldmaskpb.FieldMask)(nil), // 8: google.protobuf.FieldMask
 }
 var file_proto_v1_bills_proto_depIdxs = []int32{
-	5, // 0: api.v1.Bill.sponsor:type_name -> api.v1.Legislator
-	0, // 1: api.v1.ListBillsResponse.bills:type_name -> api.v1.Bill
-	0, // 2: api.v1.GetBillResponse.bill:type_name -> api.v1.Bill
-	1, // 3: api.v1.BillService.ListBills:input_type -> api.v1.ListBillsRequest
-	3, // 4: api.v1.BillService.GetBill:input_type -> api.v1.GetBillRequest
-	2, // 5: api.v1.BillService.ListBills:output_type -> api.v1.ListBillsResponse
-	4, // 6: api.v1.BillService.GetBill:output_type -> api.v1.GetBillResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	7, // 0: api.v1.Bill.sponsor:type_name -> api.v1.Legislator
+	8, // 1: api.v1.ListBillsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0, // 2: api.v1.ListBillsResponse.bills:type_name -> api.v1.Bill
+	0, // 3: api.v1.GetBillResponse.bill:type_name -> api.v1.Bill
+	0, // 4: api.v1.WatchBillsResponse.bill:type_name -> api.v1.Bill
+	1, // 5: api.v1.BillService.ListBills:input_type -> api.v1.ListBillsRequest
+	3, // 6: api.v1.BillService.GetBill:input_type -> api.v1.GetBillRequest
+	5, // 7: api.v1.BillService.WatchBills:input_type -> api.v1.WatchBillsRequest
+	2, // 8: api.v1.BillService.ListBills:output_type -> api.v1.ListBillsResponse
+	4, // 9: api.v1.BillService.GetBill:output_type -> api.v1.GetBillResponse
+	6, // 10: api.v1.BillService.WatchBills:output_type -> api.v1.WatchBillsResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_bills_proto_init() }
@@ -457,6 +658,7 @@ func file_proto_v1_bills_proto_init() {
 	if File_proto_v1_bills_proto != nil {
 		return
 	}
+	file_proto_v1_auth_proto_init()
 	file_proto_v1_legislators_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -464,7 +666,7 @@ func file_proto_v1_bills_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_bills_proto_rawDesc), len(file_proto_v1_bills_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
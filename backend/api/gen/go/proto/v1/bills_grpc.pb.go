@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BillService_ListBills_FullMethodName = "/api.v1.BillService/ListBills"
-	BillService_GetBill_FullMethodName   = "/api.v1.BillService/GetBill"
+	BillService_ListBills_FullMethodName  = "/api.v1.BillService/ListBills"
+	BillService_GetBill_FullMethodName    = "/api.v1.BillService/GetBill"
+	BillService_WatchBills_FullMethodName = "/api.v1.BillService/WatchBills"
 )
 
 // BillServiceClient is the client API for BillService service.
@@ -31,6 +32,12 @@ const (
 type BillServiceClient interface {
 	ListBills(ctx context.Context, in *ListBillsRequest, opts ...grpc.CallOption) (*ListBillsResponse, error)
 	GetBill(ctx context.Context, in *GetBillRequest, opts ...grpc.CallOption) (*GetBillResponse, error)
+	// WatchBills streams updates to bills as this server's ingestion writes
+	// them. Over HTTP, send "Accept: text/event-stream" to receive them as
+	// Server-Sent Events; otherwise they arrive as newline-delimited JSON.
+	// A stream that falls too far behind is ended with UNAVAILABLE, and the
+	// client should reconnect.
+	WatchBills(ctx context.Context, in *WatchBillsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchBillsResponse], error)
 }
 
 type billServiceClient struct {
@@ -61,6 +68,25 @@ func (c *billServiceClient) GetBill(ctx context.Context, in *GetBillRequest, opt
 	return out, nil
 }
 
+func (c *billServiceClient) WatchBills(ctx context.Context, in *WatchBillsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchBillsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BillService_ServiceDesc.Streams[0], BillService_WatchBills_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchBillsRequest, WatchBillsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BillService_WatchBillsClient = grpc.ServerStreamingClient[WatchBillsResponse]
+
 // BillServiceServer is the server API for BillService service.
 // All implementations must embed UnimplementedBillServiceServer
 // for forward compatibility.
@@ -69,6 +95,12 @@ func (c *billServiceClient) GetBill(ctx context.Context, in *GetBillRequest, opt
 type BillServiceServer interface {
 	ListBills(context.Context, *ListBillsRequest) (*ListBillsResponse, error)
 	GetBill(context.Context, *GetBillRequest) (*GetBillResponse, error)
+	// WatchBills streams updates to bills as this server's ingestion writes
+	// them. Over HTTP, send "Accept: text/event-stream" to receive them as
+	// Server-Sent Events; otherwise they arrive as newline-delimited JSON.
+	// A stream that falls too far behind is ended with UNAVAILABLE, and the
+	// client should reconnect.
+	WatchBills(*WatchBillsRequest, grpc.ServerStreamingServer[WatchBillsResponse]) error
 	mustEmbedUnimplementedBillServiceServer()
 }
 
@@ -85,6 +117,9 @@ func (UnimplementedBillServiceServer) ListBills(context.Context, *ListBillsReque
 func (UnimplementedBillServiceServer) GetBill(context.Context, *GetBillRequest) (*GetBillResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetBill not implemented")
 }
+func (UnimplementedBillServiceServer) WatchBills(*WatchBillsRequest, grpc.ServerStreamingServer[WatchBillsResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchBills not implemented")
+}
 func (UnimplementedBillServiceServer) mustEmbedUnimplementedBillServiceServer() {}
 func (UnimplementedBillServiceServer) testEmbeddedByValue()                     {}
 
@@ -142,6 +177,17 @@ func _BillService_GetBill_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BillService_WatchBills_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBillsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BillServiceServer).WatchBills(m, &grpc.GenericServerStream[WatchBillsRequest, WatchBillsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BillService_WatchBillsServer = grpc.ServerStreamingServer[WatchBillsResponse]
+
 // BillService_ServiceDesc is the grpc.ServiceDesc for BillService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -158,6 +204,12 @@ var BillService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _BillService_GetBill_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchBills",
+			Handler:       _BillService_WatchBills_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "proto/v1/bills.proto",
 }
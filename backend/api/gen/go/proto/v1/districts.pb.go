@@ -255,7 +255,7 @@ var File_proto_v1_districts_proto protoreflect.FileDescriptor
 
 const file_proto_v1_districts_proto_rawDesc = "" +
 	"\n" +
-	"\x18proto/v1/districts.proto\x12\x06api.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/v1/legislators.proto\"D\n" +
+	"\x18proto/v1/districts.proto\x12\x06api.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x13proto/v1/auth.proto\x1a\x1aproto/v1/legislators.proto\"D\n" +
 	"\bLocation\x12\x1a\n" +
 	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
 	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\xb6\x01\n" +
@@ -272,9 +272,9 @@ const file_proto_v1_districts_proto_rawDesc = "" +
 	"\blocation\x18\x01 \x01(\v2\x10.api.v1.LocationR\blocation\"\x95\x01\n" +
 	"\x1fGetDistrictFromLocationResponse\x127\n" +
 	"\x0ehouse_district\x18\x01 \x01(\v2\x10.api.v1.DistrictR\rhouseDistrict\x129\n" +
-	"\x0fsenate_district\x18\x02 \x01(\v2\x10.api.v1.DistrictR\x0esenateDistrict2\x9e\x01\n" +
+	"\x0fsenate_district\x18\x02 \x01(\v2\x10.api.v1.DistrictR\x0esenateDistrict2\xa4\x01\n" +
 	"\x0fDistrictService\x12\x8a\x01\n" +
-	"\x17GetDistrictFromLocation\x12&.api.v1.GetDistrictFromLocationRequest\x1a'.api.v1.GetDistrictFromLocationResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/districts/locationB\xda\x01\x92Ag\x12e\n" +
+	"\x17GetDistrictFromLocation\x12&.api.v1.GetDistrictFromLocationRequest\x1a'.api.v1.GetDistrictFromLocationResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/districts/location\x1a\x04\xa0\xbb\x18\x01B\xda\x01\x92Ag\x12e\n" +
 	"\rDistricts API\x12OAPI for retrieving Utah district and representative information by GPS location2\x031.0\n" +
 	"\n" +
 	"com.api.v1B\x0eDistrictsProtoP\x01Z\x19api/gen/go/proto/v1;apiv1\xa2\x02\x03AXX\xaa\x02\x06Api.V1\xca\x02\x06Api\\V1\xe2\x02\x12Api\\V1\\GPBMetadata\xea\x02\aApi::V1b\x06proto3"
@@ -318,6 +318,7 @@ func file_proto_v1_districts_proto_init() {
 	if File_proto_v1_districts_proto != nil {
 		return
 	}
+	file_proto_v1_auth_proto_init()
 	file_proto_v1_legislators_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
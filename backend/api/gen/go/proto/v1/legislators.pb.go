@@ -23,7 +23,7 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Legislator represents a current Utah House or Senate member.
+// Legislator represents a current or former Utah House or Senate member.
 type Legislator struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -36,6 +36,10 @@ type Legislator struct {
 	Phone          string                 `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"`
 	Website        string                 `protobuf:"bytes,9,opt,name=website,proto3" json:"website,omitempty"`
 	ImageUrl       string                 `protobuf:"bytes,10,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	TermStart      string                 `protobuf:"bytes,11,opt,name=term_start,json=termStart,proto3" json:"term_start,omitempty"` // RFC3339; start of the current or most recent term, empty if unknown
+	TermEnd        string                 `protobuf:"bytes,12,opt,name=term_end,json=termEnd,proto3" json:"term_end,omitempty"`       // RFC3339; empty while serving
+	Current        bool                   `protobuf:"varint,13,opt,name=current,proto3" json:"current,omitempty"`                     // true if the legislator holds a seat today
+	Terms          []*Term                `protobuf:"bytes,14,rep,name=terms,proto3" json:"terms,omitempty"`                          // full service history; populated by GetLegislator only
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -140,18 +144,120 @@ func (x *Legislator) GetImageUrl() string {
 	return ""
 }
 
-// ListLegislatorsRequest allows filtering legislators by chamber.
+func (x *Legislator) GetTermStart() string {
+	if x != nil {
+		return x.TermStart
+	}
+	return ""
+}
+
+func (x *Legislator) GetTermEnd() string {
+	if x != nil {
+		return x.TermEnd
+	}
+	return ""
+}
+
+func (x *Legislator) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *Legislator) GetTerms() []*Term {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+// Term is a seat held by a legislator for a period of time.
+type Term struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Chamber        string                 `protobuf:"bytes,1,opt,name=chamber,proto3" json:"chamber,omitempty"` // "house" or "senate"
+	DistrictNumber int32                  `protobuf:"varint,2,opt,name=district_number,json=districtNumber,proto3" json:"district_number,omitempty"`
+	StartDate      string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // RFC3339; empty if unknown
+	EndDate        string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // RFC3339; empty while serving
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Term) Reset() {
+	*x = Term{}
+	mi := &file_proto_v1_legislators_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Term) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Term) ProtoMessage() {}
+
+func (x *Term) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_legislators_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Term.ProtoReflect.Descriptor instead.
+func (*Term) Descriptor() ([]byte, []int) {
+	return file_proto_v1_legislators_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Term) GetChamber() string {
+	if x != nil {
+		return x.Chamber
+	}
+	return ""
+}
+
+func (x *Term) GetDistrictNumber() int32 {
+	if x != nil {
+		return x.DistrictNumber
+	}
+	return 0
+}
+
+func (x *Term) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Term) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// ListLegislatorsRequest allows filtering legislators by chamber and date.
 type ListLegislatorsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional: "house" or "senate". Returns all if omitted.
-	Chamber       string `protobuf:"bytes,1,opt,name=chamber,proto3" json:"chamber,omitempty"`
+	Chamber string `protobuf:"bytes,1,opt,name=chamber,proto3" json:"chamber,omitempty"`
+	// Also return legislators who no longer hold a seat.
+	IncludeFormer bool `protobuf:"varint,2,opt,name=include_former,json=includeFormer,proto3" json:"include_former,omitempty"`
+	// Optional: return the members serving on this date ("YYYY-MM-DD" or
+	// RFC3339) instead of today.
+	AsOf          string `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListLegislatorsRequest) Reset() {
 	*x = ListLegislatorsRequest{}
-	mi := &file_proto_v1_legislators_proto_msgTypes[1]
+	mi := &file_proto_v1_legislators_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -163,7 +269,7 @@ func (x *ListLegislatorsRequest) String() string {
 func (*ListLegislatorsRequest) ProtoMessage() {}
 
 func (x *ListLegislatorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_legislators_proto_msgTypes[1]
+	mi := &file_proto_v1_legislators_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -176,7 +282,7 @@ func (x *ListLegislatorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLegislatorsRequest.ProtoReflect.Descriptor instead.
 func (*ListLegislatorsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_legislators_proto_rawDescGZIP(), []int{1}
+	return file_proto_v1_legislators_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ListLegislatorsRequest) GetChamber() string {
@@ -186,6 +292,20 @@ func (x *ListLegislatorsRequest) GetChamber() string {
 	return ""
 }
 
+func (x *ListLegislatorsRequest) GetIncludeFormer() bool {
+	if x != nil {
+		return x.IncludeFormer
+	}
+	return false
+}
+
+func (x *ListLegislatorsRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
 type ListLegislatorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Legislators   []*Legislator          `protobuf:"bytes,1,rep,name=legislators,proto3" json:"legislators,omitempty"`
@@ -195,7 +315,7 @@ type ListLegislatorsResponse struct {
 
 func (x *ListLegislatorsResponse) Reset() {
 	*x = ListLegislatorsResponse{}
-	mi := &file_proto_v1_legislators_proto_msgTypes[2]
+	mi := &file_proto_v1_legislators_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -207,7 +327,7 @@ func (x *ListLegislatorsResponse) String() string {
 func (*ListLegislatorsResponse) ProtoMessage() {}
 
 func (x *ListLegislatorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_legislators_proto_msgTypes[2]
+	mi := &file_proto_v1_legislators_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -220,7 +340,7 @@ func (x *ListLegislatorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLegislatorsResponse.ProtoReflect.Descriptor instead.
 func (*ListLegislatorsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_legislators_proto_rawDescGZIP(), []int{2}
+	return file_proto_v1_legislators_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListLegislatorsResponse) GetLegislators() []*Legislator {
@@ -239,7 +359,7 @@ type GetLegislatorRequest struct {
 
 func (x *GetLegislatorRequest) Reset() {
 	*x = GetLegislatorRequest{}
-	mi := &file_proto_v1_legislators_proto_msgTypes[3]
+	mi := &file_proto_v1_legislators_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -251,7 +371,7 @@ func (x *GetLegislatorRequest) String() string {
 func (*GetLegislatorRequest) ProtoMessage() {}
 
 func (x *GetLegislatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_legislators_proto_msgTypes[3]
+	mi := &file_proto_v1_legislators_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,7 +384,7 @@ func (x *GetLegislatorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLegislatorRequest.ProtoReflect.Descriptor instead.
 func (*GetLegislatorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_legislators_proto_rawDescGZIP(), []int{3}
+	return file_proto_v1_legislators_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetLegislatorRequest) GetId() string {
@@ -283,7 +403,7 @@ type GetLegislatorResponse struct {
 
 func (x *GetLegislatorResponse) Reset() {
 	*x = GetLegislatorResponse{}
-	mi := &file_proto_v1_legislators_proto_msgTypes[4]
+	mi := &file_proto_v1_legislators_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -295,7 +415,7 @@ func (x *GetLegislatorResponse) String() string {
 func (*GetLegislatorResponse) ProtoMessage() {}
 
 func (x *GetLegislatorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_legislators_proto_msgTypes[4]
+	mi := &file_proto_v1_legislators_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -308,7 +428,7 @@ func (x *GetLegislatorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLegislatorResponse.ProtoReflect.Descriptor instead.
 func (*GetLegislatorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_legislators_proto_rawDescGZIP(), []int{4}
+	return file_proto_v1_legislators_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetLegislatorResponse) GetLegislator() *Legislator {
@@ -318,11 +438,320 @@ func (x *GetLegislatorResponse) GetLegislator() *Legislator {
 	return nil
 }
 
+// GetLegislatorStatsRequest selects a legislator's voting statistics.
+type GetLegislatorStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional: only sessions in this year. Returns every session if omitted.
+	SessionYear   int32 `protobuf:"varint,2,opt,name=session_year,json=sessionYear,proto3" json:"session_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLegislatorStatsRequest) Reset() {
+	*x = GetLegislatorStatsRequest{}
+	mi := &file_proto_v1_legislators_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLegislatorStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLegislatorStatsRequest) ProtoMessage() {}
+
+func (x *GetLegislatorStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_legislators_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLegislatorStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetLegislatorStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_legislators_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetLegislatorStatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetLegislatorStatsRequest) GetSessionYear() int32 {
+	if x != nil {
+		return x.SessionYear
+	}
+	return 0
+}
+
+type GetLegislatorStatsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Sessions      []*LegislatorSessionStats `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"` // newest session first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLegislatorStatsResponse) Reset() {
+	*x = GetLegislatorStatsResponse{}
+	mi := &file_proto_v1_legislators_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLegislatorStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLegislatorStatsResponse) ProtoMessage() {}
+
+func (x *GetLegislatorStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_legislators_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLegislatorStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetLegislatorStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_legislators_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetLegislatorStatsResponse) GetSessions() []*LegislatorSessionStats {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// LegislatorSessionStats is a legislator's voting and sponsorship record for
+// one session, computed from LegiScan roll calls by a scheduled job.
+type LegislatorSessionStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         int32                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // LegiScan session_id
+	SessionName       string                 `protobuf:"bytes,2,opt,name=session_name,json=sessionName,proto3" json:"session_name,omitempty"`
+	SessionYear       int32                  `protobuf:"varint,3,opt,name=session_year,json=sessionYear,proto3" json:"session_year,omitempty"`
+	EligibleVotes     int32                  `protobuf:"varint,4,opt,name=eligible_votes,json=eligibleVotes,proto3" json:"eligible_votes,omitempty"`               // roll calls the member was listed on
+	VotesCast         int32                  `protobuf:"varint,5,opt,name=votes_cast,json=votesCast,proto3" json:"votes_cast,omitempty"`                           // yea or nay
+	MissedVotes       int32                  `protobuf:"varint,6,opt,name=missed_votes,json=missedVotes,proto3" json:"missed_votes,omitempty"`                     // absent or present-not-voting
+	ParticipationRate float64                `protobuf:"fixed64,7,opt,name=participation_rate,json=participationRate,proto3" json:"participation_rate,omitempty"`  // votes_cast / eligible_votes
+	VotesAgainstParty int32                  `protobuf:"varint,8,opt,name=votes_against_party,json=votesAgainstParty,proto3" json:"votes_against_party,omitempty"` // opposite to the majority of their party
+	BillsSponsored    int32                  `protobuf:"varint,9,opt,name=bills_sponsored,json=billsSponsored,proto3" json:"bills_sponsored,omitempty"`            // as primary sponsor
+	BillsPassed       int32                  `protobuf:"varint,10,opt,name=bills_passed,json=billsPassed,proto3" json:"bills_passed,omitempty"`
+	Agreement         []*AgreementScore      `protobuf:"bytes,11,rep,name=agreement,proto3" json:"agreement,omitempty"`
+	ComputedAt        string                 `protobuf:"bytes,12,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"` // RFC3339
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LegislatorSessionStats) Reset() {
+	*x = LegislatorSessionStats{}
+	mi := &file_proto_v1_legislators_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegislatorSessionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegislatorSessionStats) ProtoMessage() {}
+
+func (x *LegislatorSessionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_legislators_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegislatorSessionStats.ProtoReflect.Descriptor instead.
+func (*LegislatorSessionStats) Descriptor() ([]byte, []int) {
+	return file_proto_v1_legislators_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LegislatorSessionStats) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *LegislatorSessionStats) GetSessionName() string {
+	if x != nil {
+		return x.SessionName
+	}
+	return ""
+}
+
+func (x *LegislatorSessionStats) GetSessionYear() int32 {
+	if x != nil {
+		return x.SessionYear
+	}
+	return 0
+}
+
+func (x *LegislatorSessionStats) GetEligibleVotes() int32 {
+	if x != nil {
+		return x.EligibleVotes
+	}
+	return 0
+}
+
+func (x *LegislatorSessionStats) GetVotesCast() int32 {
+	if x != nil {
+		return x.VotesCast
+	}
+	return 0
+}
+
+func (x *LegislatorSessionStats) GetMissedVotes() int32 {
+	if x != nil {
+		return x.MissedVotes
+	}
+	return 0
+}
+
+func (x *LegislatorSessionStats) GetParticipationRate() float64 {
+	if x != nil {
+		return x.ParticipationRate
+	}
+	return 0
+}
+
+func (x *LegislatorSessionStats) GetVotesAgainstParty() int32 {
+	if x != nil {
+		return x.VotesAgainstParty
+	}
+	return 0
+}
+
+func (x *LegislatorSessionStats) GetBillsSponsored() int32 {
+	if x != nil {
+		return x.BillsSponsored
+	}
+	return 0
+}
+
+func (x *LegislatorSessionStats) GetBillsPassed() int32 {
+	if x != nil {
+		return x.BillsPassed
+	}
+	return 0
+}
+
+func (x *LegislatorSessionStats) GetAgreement() []*AgreementScore {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *LegislatorSessionStats) GetComputedAt() string {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return ""
+}
+
+// AgreementScore is how often two legislators voted the same way.
+type AgreementScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LegislatorId  string                 `protobuf:"bytes,1,opt,name=legislator_id,json=legislatorId,proto3" json:"legislator_id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	SharedVotes   int32                  `protobuf:"varint,4,opt,name=shared_votes,json=sharedVotes,proto3" json:"shared_votes,omitempty"` // roll calls where both voted yea or nay
+	Rate          float64                `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`                                 // fraction of shared_votes in agreement
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgreementScore) Reset() {
+	*x = AgreementScore{}
+	mi := &file_proto_v1_legislators_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgreementScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgreementScore) ProtoMessage() {}
+
+func (x *AgreementScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_legislators_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgreementScore.ProtoReflect.Descriptor instead.
+func (*AgreementScore) Descriptor() ([]byte, []int) {
+	return file_proto_v1_legislators_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AgreementScore) GetLegislatorId() string {
+	if x != nil {
+		return x.LegislatorId
+	}
+	return ""
+}
+
+func (x *AgreementScore) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *AgreementScore) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *AgreementScore) GetSharedVotes() int32 {
+	if x != nil {
+		return x.SharedVotes
+	}
+	return 0
+}
+
+func (x *AgreementScore) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
 var File_proto_v1_legislators_proto protoreflect.FileDescriptor
 
 const file_proto_v1_legislators_proto_rawDesc = "" +
 	"\n" +
-	"\x1aproto/v1/legislators.proto\x12\x06api.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x94\x02\n" +
+	"\x1aproto/v1/legislators.proto\x12\x06api.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x13proto/v1/auth.proto\"\x8c\x03\n" +
 	"\n" +
 	"Legislator\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
@@ -336,9 +765,22 @@ const file_proto_v1_legislators_proto_rawDesc = "" +
 	"\x05phone\x18\b \x01(\tR\x05phone\x12\x18\n" +
 	"\awebsite\x18\t \x01(\tR\awebsite\x12\x1b\n" +
 	"\timage_url\x18\n" +
-	" \x01(\tR\bimageUrl\"2\n" +
+	" \x01(\tR\bimageUrl\x12\x1d\n" +
+	"\n" +
+	"term_start\x18\v \x01(\tR\ttermStart\x12\x19\n" +
+	"\bterm_end\x18\f \x01(\tR\atermEnd\x12\x18\n" +
+	"\acurrent\x18\r \x01(\bR\acurrent\x12\"\n" +
+	"\x05terms\x18\x0e \x03(\v2\f.api.v1.TermR\x05terms\"\x83\x01\n" +
+	"\x04Term\x12\x18\n" +
+	"\achamber\x18\x01 \x01(\tR\achamber\x12'\n" +
+	"\x0fdistrict_number\x18\x02 \x01(\x05R\x0edistrictNumber\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\"n\n" +
 	"\x16ListLegislatorsRequest\x12\x18\n" +
-	"\achamber\x18\x01 \x01(\tR\achamber\"O\n" +
+	"\achamber\x18\x01 \x01(\tR\achamber\x12%\n" +
+	"\x0einclude_former\x18\x02 \x01(\bR\rincludeFormer\x12\x13\n" +
+	"\x05as_of\x18\x03 \x01(\tR\x04asOf\"O\n" +
 	"\x17ListLegislatorsResponse\x124\n" +
 	"\vlegislators\x18\x01 \x03(\v2\x12.api.v1.LegislatorR\vlegislators\"&\n" +
 	"\x14GetLegislatorRequest\x12\x0e\n" +
@@ -346,10 +788,40 @@ const file_proto_v1_legislators_proto_rawDesc = "" +
 	"\x15GetLegislatorResponse\x122\n" +
 	"\n" +
 	"legislator\x18\x01 \x01(\v2\x12.api.v1.LegislatorR\n" +
-	"legislator2\xec\x01\n" +
+	"legislator\"N\n" +
+	"\x19GetLegislatorStatsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fsession_year\x18\x02 \x01(\x05R\vsessionYear\"X\n" +
+	"\x1aGetLegislatorStatsResponse\x12:\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1e.api.v1.LegislatorSessionStatsR\bsessions\"\xe8\x03\n" +
+	"\x16LegislatorSessionStats\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x05R\tsessionId\x12!\n" +
+	"\fsession_name\x18\x02 \x01(\tR\vsessionName\x12!\n" +
+	"\fsession_year\x18\x03 \x01(\x05R\vsessionYear\x12%\n" +
+	"\x0eeligible_votes\x18\x04 \x01(\x05R\religibleVotes\x12\x1d\n" +
+	"\n" +
+	"votes_cast\x18\x05 \x01(\x05R\tvotesCast\x12!\n" +
+	"\fmissed_votes\x18\x06 \x01(\x05R\vmissedVotes\x12-\n" +
+	"\x12participation_rate\x18\a \x01(\x01R\x11participationRate\x12.\n" +
+	"\x13votes_against_party\x18\b \x01(\x05R\x11votesAgainstParty\x12'\n" +
+	"\x0fbills_sponsored\x18\t \x01(\x05R\x0ebillsSponsored\x12!\n" +
+	"\fbills_passed\x18\n" +
+	" \x01(\x05R\vbillsPassed\x124\n" +
+	"\tagreement\x18\v \x03(\v2\x16.api.v1.AgreementScoreR\tagreement\x12\x1f\n" +
+	"\vcomputed_at\x18\f \x01(\tR\n" +
+	"computedAt\"\xa8\x01\n" +
+	"\x0eAgreementScore\x12#\n" +
+	"\rlegislator_id\x18\x01 \x01(\tR\flegislatorId\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12!\n" +
+	"\fshared_votes\x18\x04 \x01(\x05R\vsharedVotes\x12\x12\n" +
+	"\x04rate\x18\x05 \x01(\x01R\x04rate2\xf3\x02\n" +
 	"\x11LegislatorService\x12k\n" +
 	"\x0fListLegislators\x12\x1e.api.v1.ListLegislatorsRequest\x1a\x1f.api.v1.ListLegislatorsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/legislators\x12j\n" +
-	"\rGetLegislator\x12\x1c.api.v1.GetLegislatorRequest\x1a\x1d.api.v1.GetLegislatorResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/legislators/{id}B\xb6\x01\x92AA\x12?\n" +
+	"\rGetLegislator\x12\x1c.api.v1.GetLegislatorRequest\x1a\x1d.api.v1.GetLegislatorResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/legislators/{id}\x12\x7f\n" +
+	"\x12GetLegislatorStats\x12!.api.v1.GetLegislatorStatsRequest\x1a\".api.v1.GetLegislatorStatsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/legislators/{id}/stats\x1a\x04\xa0\xbb\x18\x01B\xb6\x01\x92AA\x12?\n" +
 	"\x0fLegislators API\x12'API for querying Utah state legislators2\x031.0\n" +
 	"\n" +
 	"com.api.v1B\x10LegislatorsProtoP\x01Z\x19api/gen/go/proto/v1;apiv1\xa2\x02\x03AXX\xaa\x02\x06Api.V1\xca\x02\x06Api\\V1\xe2\x02\x12Api\\V1\\GPBMetadata\xea\x02\aApi::V1b\x06proto3"
@@ -366,26 +838,36 @@ func file_proto_v1_legislators_proto_rawDescGZIP() []byte {
 	return file_proto_v1_legislators_proto_rawDescData
 }
 
-var file_proto_v1_legislators_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_v1_legislators_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_proto_v1_legislators_proto_goTypes = []any{
-	(*Legislator)(nil),              // 0: api.v1.Legislator
-	(*ListLegislatorsRequest)(nil),  // 1: api.v1.ListLegislatorsRequest
-	(*ListLegislatorsResponse)(nil), // 2: api.v1.ListLegislatorsResponse
-	(*GetLegislatorRequest)(nil),    // 3: api.v1.GetLegislatorRequest
-	(*GetLegislatorResponse)(nil),   // 4: api.v1.GetLegislatorResponse
+	(*Legislator)(nil),                 // 0: api.v1.Legislator
+	(*Term)(nil),                       // 1: api.v1.Term
+	(*ListLegislatorsRequest)(nil),     // 2: api.v1.ListLegislatorsRequest
+	(*ListLegislatorsResponse)(nil),    // 3: api.v1.ListLegislatorsResponse
+	(*GetLegislatorRequest)(nil),       // 4: api.v1.GetLegislatorRequest
+	(*GetLegislatorResponse)(nil),      // 5: api.v1.GetLegislatorResponse
+	(*GetLegislatorStatsRequest)(nil),  // 6: api.v1.GetLegislatorStatsRequest
+	(*GetLegislatorStatsResponse)(nil), // 7: api.v1.GetLegislatorStatsResponse
+	(*LegislatorSessionStats)(nil),     // 8: api.v1.LegislatorSessionStats
+	(*AgreementScore)(nil),             // 9: api.v1.AgreementScore
 }
 var file_proto_v1_legislators_proto_depIdxs = []int32{
-	0, // 0: api.v1.ListLegislatorsResponse.legislators:type_name -> api.v1.Legislator
-	0, // 1: api.v1.GetLegislatorResponse.legislator:type_name -> api.v1.Legislator
-	1, // 2: api.v1.LegislatorService.ListLegislators:input_type -> api.v1.ListLegislatorsRequest
-	3, // 3: api.v1.LegislatorService.GetLegislator:input_type -> api.v1.GetLegislatorRequest
-	2, // 4: api.v1.LegislatorService.ListLegislators:output_type -> api.v1.ListLegislatorsResponse
-	4, // 5: api.v1.LegislatorService.GetLegislator:output_type -> api.v1.GetLegislatorResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1, // 0: api.v1.Legislator.terms:type_name -> api.v1.Term
+	0, // 1: api.v1.ListLegislatorsResponse.legislators:type_name -> api.v1.Legislator
+	0, // 2: api.v1.GetLegislatorResponse.legislator:type_name -> api.v1.Legislator
+	8, // 3: api.v1.GetLegislatorStatsResponse.sessions:type_name -> api.v1.LegislatorSessionStats
+	9, // 4: api.v1.LegislatorSessionStats.agreement:type_name -> api.v1.AgreementScore
+	2, // 5: api.v1.LegislatorService.ListLegislators:input_type -> api.v1.ListLegislatorsRequest
+	4, // 6: api.v1.LegislatorService.GetLegislator:input_type -> api.v1.GetLegislatorRequest
+	6, // 7: api.v1.LegislatorService.GetLegislatorStats:input_type -> api.v1.GetLegislatorStatsRequest
+	3, // 8: api.v1.LegislatorService.ListLegislators:output_type -> api.v1.ListLegislatorsResponse
+	5, // 9: api.v1.LegislatorService.GetLegislator:output_type -> api.v1.GetLegislatorResponse
+	7, // 10: api.v1.LegislatorService.GetLegislatorStats:output_type -> api.v1.GetLegislatorStatsResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_legislators_proto_init() }
@@ -393,13 +875,14 @@ func file_proto_v1_legislators_proto_init() {
 	if File_proto_v1_legislators_proto != nil {
 		return
 	}
+	file_proto_v1_auth_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_legislators_proto_rawDesc), len(file_proto_v1_legislators_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
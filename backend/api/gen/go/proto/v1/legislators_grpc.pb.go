@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LegislatorService_ListLegislators_FullMethodName = "/api.v1.LegislatorService/ListLegislators"
-	LegislatorService_GetLegislator_FullMethodName   = "/api.v1.LegislatorService/GetLegislator"
+	LegislatorService_ListLegislators_FullMethodName    = "/api.v1.LegislatorService/ListLegislators"
+	LegislatorService_GetLegislator_FullMethodName      = "/api.v1.LegislatorService/GetLegislator"
+	LegislatorService_GetLegislatorStats_FullMethodName = "/api.v1.LegislatorService/GetLegislatorStats"
 )
 
 // LegislatorServiceClient is the client API for LegislatorService service.
@@ -31,6 +32,7 @@ const (
 type LegislatorServiceClient interface {
 	ListLegislators(ctx context.Context, in *ListLegislatorsRequest, opts ...grpc.CallOption) (*ListLegislatorsResponse, error)
 	GetLegislator(ctx context.Context, in *GetLegislatorRequest, opts ...grpc.CallOption) (*GetLegislatorResponse, error)
+	GetLegislatorStats(ctx context.Context, in *GetLegislatorStatsRequest, opts ...grpc.CallOption) (*GetLegislatorStatsResponse, error)
 }
 
 type legislatorServiceClient struct {
@@ -61,6 +63,16 @@ func (c *legislatorServiceClient) GetLegislator(ctx context.Context, in *GetLegi
 	return out, nil
 }
 
+func (c *legislatorServiceClient) GetLegislatorStats(ctx context.Context, in *GetLegislatorStatsRequest, opts ...grpc.CallOption) (*GetLegislatorStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLegislatorStatsResponse)
+	err := c.cc.Invoke(ctx, LegislatorService_GetLegislatorStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LegislatorServiceServer is the server API for LegislatorService service.
 // All implementations must embed UnimplementedLegislatorServiceServer
 // for forward compatibility.
@@ -69,6 +81,7 @@ func (c *legislatorServiceClient) GetLegislator(ctx context.Context, in *GetLegi
 type LegislatorServiceServer interface {
 	ListLegislators(context.Context, *ListLegislatorsRequest) (*ListLegislatorsResponse, error)
 	GetLegislator(context.Context, *GetLegislatorRequest) (*GetLegislatorResponse, error)
+	GetLegislatorStats(context.Context, *GetLegislatorStatsRequest) (*GetLegislatorStatsResponse, error)
 	mustEmbedUnimplementedLegislatorServiceServer()
 }
 
@@ -85,6 +98,9 @@ func (UnimplementedLegislatorServiceServer) ListLegislators(context.Context, *Li
 func (UnimplementedLegislatorServiceServer) GetLegislator(context.Context, *GetLegislatorRequest) (*GetLegislatorResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetLegislator not implemented")
 }
+func (UnimplementedLegislatorServiceServer) GetLegislatorStats(context.Context, *GetLegislatorStatsRequest) (*GetLegislatorStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLegislatorStats not implemented")
+}
 func (UnimplementedLegislatorServiceServer) mustEmbedUnimplementedLegislatorServiceServer() {}
 func (UnimplementedLegislatorServiceServer) testEmbeddedByValue()                           {}
 
@@ -142,6 +158,24 @@ func _LegislatorService_GetLegislator_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LegislatorService_GetLegislatorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLegislatorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegislatorServiceServer).GetLegislatorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LegislatorService_GetLegislatorStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegislatorServiceServer).GetLegislatorStats(ctx, req.(*GetLegislatorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LegislatorService_ServiceDesc is the grpc.ServiceDesc for LegislatorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +191,10 @@ var LegislatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLegislator",
 			Handler:    _LegislatorService_GetLegislator_Handler,
 		},
+		{
+			MethodName: "GetLegislatorStats",
+			Handler:    _LegislatorService_GetLegislatorStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/v1/legislators.proto",
@@ -0,0 +1,157 @@
+// Package analytics computes legislator voting statistics from roll-call
+// data. It is pure: callers load the inputs and persist the results.
+package analytics
+
+import (
+	"sort"
+	"time"
+
+	"api/internal/domain"
+)
+
+// Session is the input for one session's statistics. All member references
+// (RollCall.Votes keys, Party keys, Sponsorship.SponsorID) are legislator IDs.
+type Session struct {
+	ID        int
+	Name      string
+	Year      int
+	RollCalls []domain.RollCall
+	Party     map[string]string // legislator ID → party
+	Bills     []Sponsorship
+}
+
+// Sponsorship is a bill's primary sponsor and outcome.
+type Sponsorship struct {
+	SponsorID string
+	Passed    bool
+}
+
+// ComputeSessionStats returns one LegislatorStats per member who appears in
+// a roll call or sponsored a bill, sorted by legislator ID.
+func ComputeSessionStats(s Session, now time.Time) []domain.LegislatorStats {
+	stats := map[string]*domain.LegislatorStats{}
+	get := func(id string) *domain.LegislatorStats {
+		st, ok := stats[id]
+		if !ok {
+			st = &domain.LegislatorStats{
+				LegislatorID: id,
+				SessionID:    s.ID,
+				SessionName:  s.Name,
+				SessionYear:  s.Year,
+				ComputedAt:   now,
+			}
+			stats[id] = st
+		}
+		return st
+	}
+
+	type pair struct{ a, b string }
+	shared := map[pair]int{}
+	agreed := map[pair]int{}
+
+	for _, rc := range s.RollCalls {
+		majority := partyMajorities(rc, s.Party)
+
+		var cast []string
+		for id, v := range rc.Votes {
+			st := get(id)
+			st.EligibleVotes++
+			if v != domain.VoteYea && v != domain.VoteNay {
+				st.MissedVotes++
+				continue
+			}
+			st.VotesCast++
+			cast = append(cast, id)
+			if m, ok := majority[s.Party[id]]; ok && m != v {
+				st.VotesAgainstParty++
+			}
+		}
+
+		for i, a := range cast {
+			for _, b := range cast[i+1:] {
+				p := pair{a, b}
+				if b < a {
+					p = pair{b, a}
+				}
+				shared[p]++
+				if rc.Votes[a] == rc.Votes[b] {
+					agreed[p]++
+				}
+			}
+		}
+	}
+
+	for _, b := range s.Bills {
+		if b.SponsorID == "" {
+			continue
+		}
+		st := get(b.SponsorID)
+		st.BillsSponsored++
+		if b.Passed {
+			st.BillsPassed++
+		}
+	}
+
+	for p, n := range shared {
+		rate := float64(agreed[p]) / float64(n)
+		get(p.a).Agreement = append(get(p.a).Agreement, domain.Agreement{LegislatorID: p.b, SharedVotes: n, Rate: rate})
+		get(p.b).Agreement = append(get(p.b).Agreement, domain.Agreement{LegislatorID: p.a, SharedVotes: n, Rate: rate})
+	}
+
+	out := make([]domain.LegislatorStats, 0, len(stats))
+	for _, st := range stats {
+		if st.EligibleVotes > 0 {
+			st.ParticipationRate = float64(st.VotesCast) / float64(st.EligibleVotes)
+		}
+		sort.Slice(st.Agreement, func(i, j int) bool {
+			return st.Agreement[i].LegislatorID < st.Agreement[j].LegislatorID
+		})
+		out = append(out, *st)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].LegislatorID < out[j].LegislatorID })
+	return out
+}
+
+// partyMajorities returns, per party, the yea/nay position most of its
+// members took on rc. Parties split evenly have no majority and are omitted.
+func partyMajorities(rc domain.RollCall, party map[string]string) map[string]domain.Vote {
+	yeas, nays := map[string]int{}, map[string]int{}
+	for id, v := range rc.Votes {
+		p := party[id]
+		if p == "" {
+			continue
+		}
+		switch v {
+		case domain.VoteYea:
+			yeas[p]++
+		case domain.VoteNay:
+			nays[p]++
+		}
+	}
+
+	out := map[string]domain.Vote{}
+	for _, p := range partiesOf(yeas, nays) {
+		switch {
+		case yeas[p] > nays[p]:
+			out[p] = domain.VoteYea
+		case nays[p] > yeas[p]:
+			out[p] = domain.VoteNay
+		}
+	}
+	return out
+}
+
+// partiesOf returns the union of the keys of counts.
+func partiesOf(counts ...map[string]int) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, c := range counts {
+		for p := range c {
+			if !seen[p] {
+				seen[p] = true
+				out = append(out, p)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,182 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"api/internal/domain"
+)
+
+const (
+	yea     = domain.VoteYea
+	nay     = domain.VoteNay
+	absent  = domain.VoteAbsent
+	present = domain.VotePresent
+)
+
+func rollCall(votes map[string]domain.Vote) domain.RollCall {
+	return domain.RollCall{Chamber: "house", Votes: votes}
+}
+
+func TestPartyMajorities(t *testing.T) {
+	party := map[string]string{"r1": "R", "r2": "R", "r3": "R", "d1": "D", "d2": "D", "i1": ""}
+	for _, tc := range []struct {
+		name  string
+		votes map[string]domain.Vote
+		want  map[string]domain.Vote
+	}{
+		{"majorities", map[string]domain.Vote{"r1": yea, "r2": yea, "r3": nay, "d1": nay, "d2": nay}, map[string]domain.Vote{"R": yea, "D": nay}},
+		{"tie has no majority", map[string]domain.Vote{"r1": yea, "r2": nay, "d1": yea, "d2": nay}, map[string]domain.Vote{}},
+		{"absent and present don't count", map[string]domain.Vote{"r1": absent, "r2": present, "r3": nay, "d1": absent}, map[string]domain.Vote{"R": nay}},
+		{"no party is ignored", map[string]domain.Vote{"i1": yea, "d1": nay}, map[string]domain.Vote{"D": nay}},
+		{"nobody voted", map[string]domain.Vote{"r1": absent}, map[string]domain.Vote{}},
+	} {
+		got := partyMajorities(rollCall(tc.votes), party)
+		if len(got) != len(tc.want) {
+			t.Errorf("%s: majorities = %v, want %v", tc.name, got, tc.want)
+			continue
+		}
+		for p, v := range tc.want {
+			if got[p] != v {
+				t.Errorf("%s: majorities = %v, want %v", tc.name, got, tc.want)
+				break
+			}
+		}
+	}
+}
+
+func TestComputeSessionStats(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+	party := map[string]string{"a": "R", "b": "R", "c": "R", "d": "D"}
+
+	for _, tc := range []struct {
+		name      string
+		rollCalls []map[string]domain.Vote
+		bills     []Sponsorship
+		want      map[string]domain.LegislatorStats // only the counters are compared
+	}{
+		{
+			name: "participation",
+			rollCalls: []map[string]domain.Vote{
+				{"a": yea, "b": absent, "d": nay},
+				{"a": nay, "b": present, "d": absent},
+				{"a": yea, "b": yea},
+				{"a": absent},
+			},
+			want: map[string]domain.LegislatorStats{
+				"a": {EligibleVotes: 4, VotesCast: 3, MissedVotes: 1, ParticipationRate: 0.75},
+				"b": {EligibleVotes: 3, VotesCast: 1, MissedVotes: 2, ParticipationRate: 1.0 / 3},
+				"d": {EligibleVotes: 2, VotesCast: 1, MissedVotes: 1, ParticipationRate: 0.5},
+			},
+		},
+		{
+			name: "votes against party",
+			rollCalls: []map[string]domain.Vote{
+				{"a": yea, "b": yea, "c": nay, "d": nay},
+				{"a": nay, "b": nay, "c": nay, "d": yea},
+				{"a": yea, "b": yea, "c": nay, "d": yea},
+			},
+			want: map[string]domain.LegislatorStats{
+				"a": {EligibleVotes: 3, VotesCast: 3, ParticipationRate: 1},
+				"b": {EligibleVotes: 3, VotesCast: 3, ParticipationRate: 1},
+				"c": {EligibleVotes: 3, VotesCast: 3, ParticipationRate: 1, VotesAgainstParty: 2},
+				// d is the only Democrat, so always votes with the majority.
+				"d": {EligibleVotes: 3, VotesCast: 3, ParticipationRate: 1},
+			},
+		},
+		{
+			name: "tie is never against party",
+			rollCalls: []map[string]domain.Vote{
+				{"a": yea, "b": nay, "c": absent},
+			},
+			want: map[string]domain.LegislatorStats{
+				"a": {EligibleVotes: 1, VotesCast: 1, ParticipationRate: 1},
+				"b": {EligibleVotes: 1, VotesCast: 1, ParticipationRate: 1},
+				"c": {EligibleVotes: 1, MissedVotes: 1},
+			},
+		},
+		{
+			name: "absent members don't make a majority",
+			rollCalls: []map[string]domain.Vote{
+				{"a": nay, "b": absent, "c": absent},
+			},
+			want: map[string]domain.LegislatorStats{
+				"a": {EligibleVotes: 1, VotesCast: 1, ParticipationRate: 1},
+				"b": {EligibleVotes: 1, MissedVotes: 1},
+				"c": {EligibleVotes: 1, MissedVotes: 1},
+			},
+		},
+		{
+			name: "sponsorships",
+			bills: []Sponsorship{
+				{SponsorID: "a", Passed: true}, {SponsorID: "a"}, {SponsorID: "d", Passed: true}, {Passed: true},
+			},
+			want: map[string]domain.LegislatorStats{
+				"a": {BillsSponsored: 2, BillsPassed: 1},
+				"d": {BillsSponsored: 1, BillsPassed: 1},
+			},
+		},
+	} {
+		s := Session{ID: 7, Name: "2026 General Session", Year: 2026, Party: party, Bills: tc.bills}
+		for _, votes := range tc.rollCalls {
+			s.RollCalls = append(s.RollCalls, rollCall(votes))
+		}
+		got := ComputeSessionStats(s, now)
+		if len(got) != len(tc.want) {
+			t.Errorf("%s: stats for %d members, want %d", tc.name, len(got), len(tc.want))
+		}
+		for i, st := range got {
+			if i > 0 && got[i-1].LegislatorID >= st.LegislatorID {
+				t.Errorf("%s: stats not sorted by legislator ID", tc.name)
+			}
+			if st.SessionID != 7 || st.SessionYear != 2026 || !st.ComputedAt.Equal(now) {
+				t.Errorf("%s: %s session = %d/%d at %v", tc.name, st.LegislatorID, st.SessionID, st.SessionYear, st.ComputedAt)
+			}
+			want, ok := tc.want[st.LegislatorID]
+			if !ok {
+				t.Errorf("%s: unexpected stats for %s", tc.name, st.LegislatorID)
+				continue
+			}
+			st.LegislatorID, st.SessionID, st.SessionName, st.SessionYear, st.ComputedAt, st.Agreement = "", 0, "", 0, time.Time{}, nil
+			if st.ParticipationRate-want.ParticipationRate > 1e-9 || want.ParticipationRate-st.ParticipationRate > 1e-9 {
+				t.Errorf("%s: %s participation = %v, want %v", tc.name, got[i].LegislatorID, st.ParticipationRate, want.ParticipationRate)
+			}
+			st.ParticipationRate, want.ParticipationRate = 0, 0
+			if st.EligibleVotes != want.EligibleVotes || st.VotesCast != want.VotesCast || st.MissedVotes != want.MissedVotes ||
+				st.VotesAgainstParty != want.VotesAgainstParty || st.BillsSponsored != want.BillsSponsored || st.BillsPassed != want.BillsPassed {
+				t.Errorf("%s: %s = %+v, want %+v", tc.name, got[i].LegislatorID, st, want)
+			}
+		}
+	}
+}
+
+func TestAgreement(t *testing.T) {
+	s := Session{
+		Party: map[string]string{"a": "R", "b": "R", "c": "D"},
+		RollCalls: []domain.RollCall{
+			rollCall(map[string]domain.Vote{"a": yea, "b": yea, "c": nay}),
+			rollCall(map[string]domain.Vote{"a": nay, "b": yea, "c": nay}),
+			rollCall(map[string]domain.Vote{"a": yea, "b": absent, "c": yea}),
+			rollCall(map[string]domain.Vote{"a": present, "b": nay, "c": nay}),
+		},
+	}
+	want := map[string][]domain.Agreement{
+		// Only roll calls where both voted yea or nay are shared.
+		"a": {{LegislatorID: "b", SharedVotes: 2, Rate: 0.5}, {LegislatorID: "c", SharedVotes: 3, Rate: 2.0 / 3}},
+		"b": {{LegislatorID: "a", SharedVotes: 2, Rate: 0.5}, {LegislatorID: "c", SharedVotes: 3, Rate: 1.0 / 3}},
+		"c": {{LegislatorID: "a", SharedVotes: 3, Rate: 2.0 / 3}, {LegislatorID: "b", SharedVotes: 3, Rate: 1.0 / 3}},
+	}
+	for _, st := range ComputeSessionStats(s, time.Time{}) {
+		w := want[st.LegislatorID]
+		if len(st.Agreement) != len(w) {
+			t.Errorf("%s agreement = %+v, want %+v", st.LegislatorID, st.Agreement, w)
+			continue
+		}
+		for i, a := range st.Agreement {
+			if a.LegislatorID != w[i].LegislatorID || a.SharedVotes != w[i].SharedVotes || a.Rate-w[i].Rate > 1e-9 || w[i].Rate-a.Rate > 1e-9 {
+				t.Errorf("%s agreement = %+v, want %+v", st.LegislatorID, st.Agreement, w)
+				break
+			}
+		}
+	}
+}
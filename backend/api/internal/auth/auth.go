@@ -0,0 +1,65 @@
+// Package auth identifies callers of the gRPC API from their Supabase
+// access tokens and enforces the auth policy each RPC declares in the
+// protos (see proto/v1/auth.proto).
+//
+// Services read the caller with FromContext, or RequireUser when a method
+// needs one.
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// User is an authenticated caller.
+type User struct {
+	ID    string // Supabase auth.users id, the token's subject
+	Role  string // Postgres role the token grants, normally "authenticated"
+	Email string // may be empty
+}
+
+type userKey struct{}
+
+// NewContext returns a copy of ctx carrying u.
+func NewContext(ctx context.Context, u User) context.Context {
+	return context.WithValue(ctx, userKey{}, u)
+}
+
+// FromContext returns the caller stored in ctx by the interceptors, if the
+// call carried a valid token.
+func FromContext(ctx context.Context) (User, bool) {
+	u, ok := ctx.Value(userKey{}).(User)
+	return u, ok
+}
+
+// RequireUser returns the caller, or an Unauthenticated error for
+// anonymous calls.
+func RequireUser(ctx context.Context) (User, error) {
+	u, ok := FromContext(ctx)
+	if !ok {
+		return User{}, status.Error(codes.Unauthenticated, "sign-in required")
+	}
+	return u, nil
+}
+
+// BearerToken returns the token of the call's "authorization" metadata,
+// which the gateway forwards from the HTTP Authorization header.
+func BearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	vals := md.Get("authorization")
+	if len(vals) == 0 {
+		return ""
+	}
+	v := vals[0]
+	if len(v) > 7 && strings.EqualFold(v[:7], "bearer ") {
+		return strings.TrimSpace(v[7:])
+	}
+	return strings.TrimSpace(v)
+}
@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	_ "google.golang.org/grpc/health/grpc_health_v1" // registers grpc.health.v1
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "api/gen/go/proto/v1"
+)
+
+const secret = "test-secret"
+
+func sign(t *testing.T, c jwt.MapClaims) string {
+	t.Helper()
+	s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func userClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":   "5f1c7c5e-0000-4000-8000-000000000001",
+		"aud":   "authenticated",
+		"role":  "authenticated",
+		"email": "voter@example.com",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestVerifierSecret(t *testing.T) {
+	v, err := NewVerifier(VerifierConfig{Secret: secret})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := v.Verify(sign(t, userClaims()))
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if u.ID != "5f1c7c5e-0000-4000-8000-000000000001" || u.Role != "authenticated" || u.Email != "voter@example.com" {
+		t.Errorf("user = %+v", u)
+	}
+
+	for name, mutate := range map[string]func(jwt.MapClaims){
+		"expired":      func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+		"no expiry":    func(c jwt.MapClaims) { delete(c, "exp") },
+		"anon key":     func(c jwt.MapClaims) { delete(c, "sub"); c["role"] = "anon" },
+		"wrong aud":    func(c jwt.MapClaims) { c["aud"] = "other" },
+		"no audience":  func(c jwt.MapClaims) { delete(c, "aud") },
+		"empty claims": func(c jwt.MapClaims) { clear(c) },
+	} {
+		c := userClaims()
+		mutate(c)
+		if _, err := v.Verify(sign(t, c)); err == nil {
+			t.Errorf("%s: Verify succeeded", name)
+		}
+	}
+
+	forged, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, userClaims()).SignedString([]byte("other"))
+	if _, err := v.Verify(forged); err == nil {
+		t.Error("token signed with another secret verified")
+	}
+}
+
+func TestVerifierJWKS(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc := base64.RawURLEncoding
+	jwks, _ := json.Marshal(map[string]any{"keys": []map[string]string{{
+		"kty": "EC", "kid": "k1", "crv": "P-256", "alg": "ES256",
+		"x": enc.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+		"y": enc.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+	}}})
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, jwks, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := NewVerifier(VerifierConfig{JWKSFile: path, Issuer: "https://example.supabase.co/auth/v1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := userClaims()
+	c["iss"] = "https://example.supabase.co/auth/v1"
+	tok := jwt.NewWithClaims(jwt.SigningMethodES256, c)
+	tok.Header["kid"] = "k1"
+	s, err := tok.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Verify(s); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+
+	c["iss"] = "https://evil.example/auth/v1"
+	tok = jwt.NewWithClaims(jwt.SigningMethodES256, c)
+	tok.Header["kid"] = "k1"
+	s, _ = tok.SignedString(key)
+	if _, err := v.Verify(s); err == nil {
+		t.Error("token from another issuer verified")
+	}
+
+	// A key set disables the shared secret.
+	if _, err := v.Verify(sign(t, userClaims())); err == nil {
+		t.Error("HS256 token verified without a secret configured")
+	}
+}
+
+func TestMethodPolicy(t *testing.T) {
+	for method, want := range map[string]pb.AuthPolicy{
+		"/api.v1.BillService/ListBills":    pb.AuthPolicy_AUTH_POLICY_PUBLIC,
+		"/api.v1.StatusService/GetStatus":  pb.AuthPolicy_AUTH_POLICY_PUBLIC,
+		"/api.v1.AdminService/ListJobRuns": pb.AuthPolicy_AUTH_POLICY_SUPERUSER,
+		"/api.v1.NoSuchService/Method":     pb.AuthPolicy_AUTH_POLICY_AUTHENTICATED,
+		"/grpc.health.v1.Health/Check":     pb.AuthPolicy_AUTH_POLICY_PUBLIC,
+	} {
+		if got := MethodPolicy(method); got != want {
+			t.Errorf("MethodPolicy(%s) = %s, want %s", method, got, want)
+		}
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	v, err := NewVerifier(VerifierConfig{Secret: secret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unary := NewInterceptor(v).Unary()
+	valid := sign(t, userClaims())
+	expired := userClaims()
+	expired["exp"] = time.Now().Add(-time.Minute).Unix()
+
+	tests := []struct {
+		name, method, token string
+		code                codes.Code
+		user                bool
+	}{
+		{"public anonymous", "/api.v1.BillService/ListBills", "", codes.OK, false},
+		{"public signed in", "/api.v1.BillService/ListBills", valid, codes.OK, true},
+		{"public bad token", "/api.v1.BillService/ListBills", sign(t, expired), codes.OK, false},
+		{"authenticated anonymous", "/api.v1.NoSuchService/Method", "", codes.Unauthenticated, false},
+		{"authenticated bad token", "/api.v1.NoSuchService/Method", sign(t, expired), codes.Unauthenticated, false},
+		{"authenticated signed in", "/api.v1.NoSuchService/Method", valid, codes.OK, true},
+		{"superuser", "/api.v1.AdminService/ListJobRuns", "pocketbase-token", codes.OK, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tt.token))
+			}
+			var gotUser bool
+			_, err := unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, req any) (any, error) {
+				_, gotUser = FromContext(ctx)
+				return nil, nil
+			})
+			if status.Code(err) != tt.code {
+				t.Fatalf("code = %s, want %s (%v)", status.Code(err), tt.code, err)
+			}
+			if gotUser != tt.user {
+				t.Errorf("user in context = %v, want %v", gotUser, tt.user)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	pb "api/gen/go/proto/v1"
+)
+
+// Interceptor authenticates gRPC calls with Supabase access tokens.
+//
+// A valid token puts its User in the call's context. Methods with the
+// AUTHENTICATED policy reject calls without one; PUBLIC methods serve them
+// anonymously, ignoring tokens that don't verify. SUPERUSER methods are
+// left to the PocketBase superuser check.
+type Interceptor struct {
+	verifier *Verifier // nil rejects every token
+}
+
+// NewInterceptor creates an Interceptor. With a nil verifier no caller is
+// ever authenticated, so only public methods can be called.
+func NewInterceptor(v *Verifier) *Interceptor {
+	return &Interceptor{verifier: v}
+}
+
+// Unary returns the interceptor for unary RPCs.
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := i.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the interceptor for streaming RPCs.
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate applies the policy of method to the call.
+func (i *Interceptor) authenticate(ctx context.Context, method string) (context.Context, error) {
+	policy := MethodPolicy(method)
+	if policy == pb.AuthPolicy_AUTH_POLICY_SUPERUSER {
+		return ctx, nil
+	}
+
+	if token := BearerToken(ctx); token != "" && i.verifier != nil {
+		u, err := i.verifier.Verify(token)
+		if err == nil {
+			return NewContext(ctx, u), nil
+		}
+		if policy == pb.AuthPolicy_AUTH_POLICY_AUTHENTICATED {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+		}
+	}
+	if policy == pb.AuthPolicy_AUTH_POLICY_PUBLIC {
+		return ctx, nil
+	}
+	return nil, status.Error(codes.Unauthenticated, "sign-in required")
+}
+
+// serverStream overrides the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context { return s.ctx }
+
+var policies sync.Map // full method name → pb.AuthPolicy
+
+// publicServices are standard services, without auth options of their own,
+// that anyone can call: load balancers check health anonymously.
+var publicServices = map[protoreflect.FullName]bool{
+	"grpc.health.v1.Health": true,
+}
+
+// MethodPolicy returns the auth policy of a gRPC method, given its full
+// name ("/api.v1.BillService/ListBills"): the method's auth_policy option,
+// else its service's default_auth_policy, else AUTHENTICATED. Unknown
+// methods are AUTHENTICATED too, and health checks PUBLIC.
+func MethodPolicy(fullMethod string) pb.AuthPolicy {
+	if p, ok := policies.Load(fullMethod); ok {
+		return p.(pb.AuthPolicy)
+	}
+	p := lookupPolicy(fullMethod)
+	policies.Store(fullMethod, p)
+	return p
+}
+
+func lookupPolicy(fullMethod string) pb.AuthPolicy {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return pb.AuthPolicy_AUTH_POLICY_AUTHENTICATED
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return pb.AuthPolicy_AUTH_POLICY_AUTHENTICATED
+	}
+	service := method.Parent().(protoreflect.ServiceDescriptor)
+	if publicServices[service.FullName()] {
+		return pb.AuthPolicy_AUTH_POLICY_PUBLIC
+	}
+	if p := proto.GetExtension(method.Options(), pb.E_AuthPolicy).(pb.AuthPolicy); p != pb.AuthPolicy_AUTH_POLICY_UNSPECIFIED {
+		return p
+	}
+	if p := proto.GetExtension(service.Options(), pb.E_DefaultAuthPolicy).(pb.AuthPolicy); p != pb.AuthPolicy_AUTH_POLICY_UNSPECIFIED {
+		return p
+	}
+	return pb.AuthPolicy_AUTH_POLICY_AUTHENTICATED
+}
@@ -0,0 +1,167 @@
+// Package calendar knows when the Utah Legislature is in session, and
+// derives from that how often ingestion should poll for changes.
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Mountain is Utah's time zone, falling back to standard time if the zone
+// database is unavailable.
+var Mountain = func() *time.Location {
+	if loc, err := time.LoadLocation("America/Denver"); err == nil {
+		return loc
+	}
+	return time.FixedZone("MST", -7*60*60)
+}()
+
+// Session kinds.
+const (
+	General = "general"
+	Special = "special"
+)
+
+// Session is a legislative session. Name is the Utah Legislature's session
+// code, e.g. "2026GS" or "2026S1".
+type Session struct {
+	Name  string
+	Kind  string
+	Start time.Time // first day, midnight Mountain time
+	End   time.Time // last day, midnight Mountain time
+}
+
+// Contains reports whether t falls on a day of the session.
+func (s Session) Contains(t time.Time) bool {
+	return !t.Before(s.Start) && t.Before(s.End.AddDate(0, 0, 1))
+}
+
+// generalSessionDays is the constitutional limit on a General Session's
+// length, counted after the day it convenes.
+const generalSessionDays = 45
+
+// GeneralSession returns a year's General Session. It convenes on the third
+// Tuesday in January and adjourns sine die 45 days later (e.g. January 20
+// to March 6 in 2026).
+func GeneralSession(year int) Session {
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, Mountain)
+	firstTuesday := 1 + (int(time.Tuesday)-int(jan1.Weekday())+7)%7
+	start := time.Date(year, time.January, firstTuesday+14, 0, 0, 0, 0, Mountain)
+	return Session{
+		Name:  fmt.Sprintf("%dGS", year),
+		Kind:  General,
+		Start: start,
+		End:   start.AddDate(0, 0, generalSessionDays),
+	}
+}
+
+// Calendar lists the General Sessions, which follow a fixed rule, and the
+// special sessions called by the governor, which must be configured.
+type Calendar struct {
+	special []Session
+}
+
+// New creates a Calendar with the given special sessions.
+func New(special []Session) *Calendar {
+	special = append([]Session(nil), special...)
+	sort.Slice(special, func(i, j int) bool { return special[i].Start.Before(special[j].Start) })
+	return &Calendar{special: special}
+}
+
+// ParseSpecialSessions parses a comma-separated list of special sessions,
+// each "YYYY-MM-DD" for a one-day session or "YYYY-MM-DD/YYYY-MM-DD" for its
+// first and last day. Sessions are named "<year>S1", "<year>S2", ... in date
+// order within each year, matching the Utah Legislature's codes.
+func ParseSpecialSessions(s string) ([]Session, error) {
+	var sessions []Session
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		first, last, _ := strings.Cut(part, "/")
+		if last == "" {
+			last = first
+		}
+		start, err := time.ParseInLocation(time.DateOnly, first, Mountain)
+		if err != nil {
+			return nil, fmt.Errorf("special session %q: %w", part, err)
+		}
+		end, err := time.ParseInLocation(time.DateOnly, last, Mountain)
+		if err != nil {
+			return nil, fmt.Errorf("special session %q: %w", part, err)
+		}
+		if end.Before(start) {
+			return nil, fmt.Errorf("special session %q ends before it starts", part)
+		}
+		sessions = append(sessions, Session{Kind: Special, Start: start, End: end})
+	}
+
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].Start.Before(sessions[j].Start) })
+	n := map[int]int{}
+	for i := range sessions {
+		year := sessions[i].Start.Year()
+		n[year]++
+		sessions[i].Name = fmt.Sprintf("%dS%d", year, n[year])
+	}
+	return sessions, nil
+}
+
+// Sessions returns every session starting in year, in date order.
+func (c *Calendar) Sessions(year int) []Session {
+	out := []Session{GeneralSession(year)}
+	for _, s := range c.special {
+		if s.Start.Year() == year {
+			out = append(out, s)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Start.Before(out[j].Start) })
+	return out
+}
+
+// Current returns the session in progress at t, if any.
+func (c *Calendar) Current(t time.Time) (Session, bool) {
+	t = t.In(Mountain)
+	for _, s := range c.Sessions(t.Year()) {
+		if s.Contains(t) {
+			return s, true
+		}
+	}
+	return Session{}, false
+}
+
+// Previous returns the latest session that ended before t.
+func (c *Calendar) Previous(t time.Time) Session {
+	t = t.In(Mountain)
+	for year := t.Year(); ; year-- {
+		sessions := c.Sessions(year)
+		for i := len(sessions) - 1; i >= 0; i-- {
+			if !sessions[i].Contains(t) && sessions[i].End.Before(t) {
+				return sessions[i]
+			}
+		}
+	}
+}
+
+// Next returns the first session starting after t.
+func (c *Calendar) Next(t time.Time) Session {
+	t = t.In(Mountain)
+	for year := t.Year(); ; year++ {
+		for _, s := range c.Sessions(year) {
+			if s.Start.After(t) {
+				return s
+			}
+		}
+	}
+}
+
+// Latest returns the session in progress at t, or else the one that last
+// ended: the session whose bills are most likely to be changing.
+func (c *Calendar) Latest(t time.Time) Session {
+	if s, ok := c.Current(t); ok {
+		return s
+	}
+	return c.Previous(t)
+}
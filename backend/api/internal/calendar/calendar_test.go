@@ -0,0 +1,122 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func mt(s string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04", s, Mountain)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestGeneralSession(t *testing.T) {
+	for year, want := range map[int][2]string{
+		2024: {"2024-01-16", "2024-03-01"},
+		2025: {"2025-01-21", "2025-03-07"},
+		2026: {"2026-01-20", "2026-03-06"},
+	} {
+		s := GeneralSession(year)
+		if got := [2]string{s.Start.Format(time.DateOnly), s.End.Format(time.DateOnly)}; got != want {
+			t.Errorf("GeneralSession(%d) = %v, want %v", year, got, want)
+		}
+	}
+}
+
+func TestParseSpecialSessions(t *testing.T) {
+	got, err := ParseSpecialSessions("2026-08-21, 2026-05-20/2026-05-21,2025-06-18")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"2025S1 2025-06-18 2025-06-18", "2026S1 2026-05-20 2026-05-21", "2026S2 2026-08-21 2026-08-21"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d sessions, want %d", len(got), len(want))
+	}
+	for i, s := range got {
+		if line := s.Name + " " + s.Start.Format(time.DateOnly) + " " + s.End.Format(time.DateOnly); line != want[i] {
+			t.Errorf("session %d = %q, want %q", i, line, want[i])
+		}
+	}
+
+	for _, bad := range []string{"2026-13-01", "2026-05-21/2026-05-20"} {
+		if _, err := ParseSpecialSessions(bad); err == nil {
+			t.Errorf("ParseSpecialSessions(%q) succeeded, want error", bad)
+		}
+	}
+}
+
+func TestMode(t *testing.T) {
+	special, _ := ParseSpecialSessions("2026-08-20")
+	c := New(special)
+
+	tests := []struct {
+		at   string
+		want Mode
+	}{
+		{"2026-01-20 08:00", ModeInSession},
+		{"2026-03-05 23:00", ModeInSession},
+		{"2026-03-06 11:59", ModeInSession},
+		{"2026-03-06 18:00", ModeFinalNight},
+		{"2026-03-07 00:30", ModeInterim},
+		{"2026-06-10 12:00", ModeDormant}, // more than 90 days after sine die
+		{"2026-08-20 20:00", ModeFinalNight},
+		{"2026-09-01 12:00", ModeInterim}, // after the special session
+		{"2026-12-15 12:00", ModeInterim}, // prefiling for 2027GS
+		{"2025-11-01 12:00", ModeDormant},
+	}
+	for _, tt := range tests {
+		if got := c.Mode(mt(tt.at)); got != tt.want {
+			t.Errorf("Mode(%s) = %s, want %s", tt.at, got, tt.want)
+		}
+	}
+}
+
+func TestDue(t *testing.T) {
+	c := New(nil)
+
+	count := func(from time.Time, d time.Duration) int {
+		n := 0
+		for at := from; at.Before(from.Add(d)); at = at.Add(tick) {
+			if c.Due(at) {
+				n++
+			}
+		}
+		return n
+	}
+
+	tests := []struct {
+		name string
+		from string
+		d    time.Duration
+		want int
+	}{
+		{"in session", "2026-02-02 00:00", 24 * time.Hour, 24},
+		{"final night", "2026-03-06 12:00", 12 * time.Hour, 48},
+		{"interim", "2026-04-01 00:00", 7 * 24 * time.Hour, 7},
+		{"dormant", "2026-07-01 00:00", 28 * 24 * time.Hour, 4},
+	}
+	for _, tt := range tests {
+		if got := count(mt(tt.from), tt.d); got != tt.want {
+			t.Errorf("%s: %d runs, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLatestAndNext(t *testing.T) {
+	special, _ := ParseSpecialSessions("2026-05-20/2026-05-21")
+	c := New(special)
+
+	at := mt("2026-06-01 12:00")
+	if got := c.Latest(at).Name; got != "2026S1" {
+		t.Errorf("Latest = %s, want 2026S1", got)
+	}
+	if got := c.Next(at).Name; got != "2027GS" {
+		t.Errorf("Next = %s, want 2027GS", got)
+	}
+	if got := c.Latest(mt("2026-02-01 12:00")).Name; got != "2026GS" {
+		t.Errorf("Latest during session = %s, want 2026GS", got)
+	}
+}
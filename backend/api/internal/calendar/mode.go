@@ -0,0 +1,72 @@
+package calendar
+
+import "time"
+
+// Mode is how actively the legislature is producing changes.
+type Mode string
+
+// Modes, from most to least active.
+const (
+	// ModeFinalNight is the evening of a session's last day, when hundreds
+	// of bills pass in a few hours before adjourning sine die.
+	ModeFinalNight Mode = "final_night"
+	// ModeInSession is any other day of a session.
+	ModeInSession Mode = "in_session"
+	// ModeInterim covers the weeks around sessions: bill prefiling before a
+	// General Session, and signings, vetoes and effective dates after one.
+	ModeInterim Mode = "interim"
+	// ModeDormant is the rest of the year.
+	ModeDormant Mode = "dormant"
+)
+
+const (
+	finalNightFrom = 12 * time.Hour      // into a session's last day
+	interimBefore  = 45 * 24 * time.Hour // before a General Session
+	interimAfter   = 90 * 24 * time.Hour // after any session
+	tick           = 15 * time.Minute    // the scheduler's polling tick
+)
+
+// Mode returns the mode at t.
+func (c *Calendar) Mode(t time.Time) Mode {
+	t = t.In(Mountain)
+	if s, ok := c.Current(t); ok {
+		if !t.Before(s.End.Add(finalNightFrom)) {
+			return ModeFinalNight
+		}
+		return ModeInSession
+	}
+
+	prev := c.Previous(t)
+	if t.Sub(prev.End.AddDate(0, 0, 1)) < interimAfter {
+		return ModeInterim
+	}
+	if next := GeneralSession(t.Year() + 1); next.Start.Sub(t) < interimBefore {
+		return ModeInterim
+	}
+	if this := GeneralSession(t.Year()); this.Start.After(t) && this.Start.Sub(t) < interimBefore {
+		return ModeInterim
+	}
+	return ModeDormant
+}
+
+// PollInterval returns how often bills should be polled in mode m.
+func PollInterval(m Mode) time.Duration {
+	switch m {
+	case ModeFinalNight:
+		return tick
+	case ModeInSession:
+		return time.Hour
+	case ModeInterim:
+		return 24 * time.Hour
+	default:
+		return 7 * 24 * time.Hour
+	}
+}
+
+// Due reports whether a job polling at the current mode's interval should
+// run at t, for a scheduler that ticks every 15 minutes: it is due on the
+// first tick of each interval.
+func (c *Calendar) Due(t time.Time) bool {
+	interval := PollInterval(c.Mode(t))
+	return t.Sub(t.Truncate(interval)) < tick
+}
@@ -0,0 +1,215 @@
+// Package config loads typed settings from, in increasing order of
+// precedence, their defaults, a JSON file, environment variables and
+// command-line flags.
+//
+// A setting is a struct field tagged with its JSON key and, optionally, the
+// environment variable and flag that override it:
+//
+//	Token string `json:"token" env:"UTAH_LEGISLATURE_TOKEN" flag:"utah-token" usage:"developer token from le.utah.gov"`
+//
+// Nested structs are walked. Supported field types are string, bool, int,
+// time.Duration and []string (comma-separated in the environment).
+//
+// Credentials are also tagged secret:"true", or secret:"password" for URLs
+// whose password alone is secret, so Print can redact them.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// setting is one tagged leaf field of a config struct.
+type setting struct {
+	field  reflect.Value
+	env    string
+	flag   string
+	usage  string
+	secret string
+}
+
+// settings returns the tagged leaf fields of *cfg.
+func settings(cfg any) []setting {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		panic("config: cfg must be a pointer to a struct")
+	}
+	var out []setting
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			if f.Type.Kind() == reflect.Struct {
+				walk(v.Field(i))
+				continue
+			}
+			out = append(out, setting{
+				field:  v.Field(i),
+				env:    f.Tag.Get("env"),
+				flag:   f.Tag.Get("flag"),
+				usage:  f.Tag.Get("usage"),
+				secret: f.Tag.Get("secret"),
+			})
+		}
+	}
+	walk(v.Elem())
+	return out
+}
+
+// BindFlags registers a flag on fs for every setting of *cfg with a flag
+// tag, defaulting to its current value. Parsed flags are applied by Load.
+func BindFlags(fs *pflag.FlagSet, cfg any) {
+	for _, s := range settings(cfg) {
+		if s.flag == "" {
+			continue
+		}
+		switch {
+		case s.field.Type() == durationType:
+			fs.Duration(s.flag, time.Duration(s.field.Int()), s.usage)
+		case s.field.Kind() == reflect.String:
+			fs.String(s.flag, s.field.String(), s.usage)
+		case s.field.Kind() == reflect.Bool:
+			fs.Bool(s.flag, s.field.Bool(), s.usage)
+		case s.field.Kind() == reflect.Int:
+			fs.Int(s.flag, int(s.field.Int()), s.usage)
+		case s.field.Kind() == reflect.Slice && s.field.Type().Elem().Kind() == reflect.String:
+			fs.StringSlice(s.flag, s.field.Interface().([]string), s.usage)
+		default:
+			panic(fmt.Sprintf("config: unsupported type %s for flag %q", s.field.Type(), s.flag))
+		}
+	}
+}
+
+// Load overlays *cfg, which holds the defaults, with the JSON file at path
+// (skipped if path is empty), then the environment, then the flags of fs
+// that were set on the command line (fs may be nil).
+func Load(cfg any, path string, fs *pflag.FlagSet) error {
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read config: %w", err)
+		}
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(cfg); err != nil {
+			return fmt.Errorf("parse config %s: %w", path, err)
+		}
+	}
+
+	for _, s := range settings(cfg) {
+		if s.env == "" {
+			continue
+		}
+		if v, ok := os.LookupEnv(s.env); ok && v != "" {
+			if err := set(s.field, v); err != nil {
+				return fmt.Errorf("%s: %w", s.env, err)
+			}
+		}
+	}
+
+	if fs == nil {
+		return nil
+	}
+	for _, s := range settings(cfg) {
+		if s.flag == "" || !fs.Changed(s.flag) {
+			continue
+		}
+		if s.field.Kind() == reflect.Slice {
+			v, err := fs.GetStringSlice(s.flag)
+			if err != nil {
+				return err
+			}
+			s.field.Set(reflect.ValueOf(v))
+			continue
+		}
+		if err := set(s.field, fs.Lookup(s.flag).Value.String()); err != nil {
+			return fmt.Errorf("--%s: %w", s.flag, err)
+		}
+	}
+	return nil
+}
+
+// Print writes *cfg to w as indented JSON, in the format Load reads, with
+// its secrets redacted.
+func Print(w io.Writer, cfg any) error {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		panic("config: cfg must be a pointer to a struct")
+	}
+	redacted := reflect.New(v.Elem().Type())
+	redacted.Elem().Set(v.Elem())
+	for _, s := range settings(redacted.Interface()) {
+		if s.secret == "" || s.field.Kind() != reflect.String || s.field.String() == "" {
+			continue
+		}
+		s.field.SetString(redact(s.field.String(), s.secret))
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(redacted.Interface())
+}
+
+// redact hides a secret value. URLs tagged secret:"password" keep all but
+// their password, if they parse.
+func redact(v, secret string) string {
+	if secret == "password" {
+		if u, err := url.Parse(v); err == nil && u.Scheme != "" {
+			return u.Redacted()
+		}
+	}
+	return "REDACTED"
+}
+
+// set parses v into field according to its type.
+func set(field reflect.Value, v string) error {
+	switch {
+	case field.Type() == durationType:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+	case field.Kind() == reflect.String:
+		field.SetString(v)
+	case field.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case field.Kind() == reflect.Int:
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(n))
+	case field.Kind() == reflect.Slice:
+		var items []string
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
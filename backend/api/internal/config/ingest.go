@@ -0,0 +1,75 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"api/internal/calendar"
+)
+
+// Ingest configures the ingestion jobs: where they write and the upstream
+// credentials they use.
+type Ingest struct {
+	Store    Store    `json:"store"`
+	Utah     Utah     `json:"utah_legislature"`
+	LegiScan LegiScan `json:"legiscan"`
+
+	// LegislatorsSource is "utah", "openstates" or "utah+openstates".
+	LegislatorsSource string `json:"legislators_source" env:"LEGISLATORS_SOURCE" flag:"legislators-source" usage:"legislator source: utah, openstates or utah+openstates"`
+
+	// SpecialSessions lists special sessions as "YYYY-MM-DD[/YYYY-MM-DD]",
+	// see calendar.ParseSpecialSessions.
+	SpecialSessions string `json:"special_sessions" env:"SPECIAL_SESSIONS" flag:"special-sessions" usage:"special sessions, e.g. 2026-05-20/2026-05-21"`
+
+	// PushgatewayURL is a Prometheus Pushgateway to push each run's
+	// metrics to, since a one-off command isn't around to be scraped.
+	PushgatewayURL string `json:"pushgateway_url" env:"PUSHGATEWAY_URL" flag:"pushgateway-url" usage:"Prometheus Pushgateway to push job metrics to" secret:"password"`
+}
+
+// Store selects the database: Postgres if DatabaseURL is set, otherwise
+// the PocketBase data directory.
+type Store struct {
+	PocketBaseDataDir string `json:"pocketbase_data_dir" env:"POCKETBASE_DATA_DIR" flag:"data-dir" usage:"PocketBase data directory"`
+	DatabaseURL       string `json:"database_url" env:"DATABASE_URL" flag:"database-url" usage:"Postgres connection string; used instead of PocketBase when set" secret:"password"`
+}
+
+// Utah configures the official Utah Legislature API.
+type Utah struct {
+	Token   string `json:"token" env:"UTAH_LEGISLATURE_TOKEN" flag:"utah-token" usage:"developer token from le.utah.gov" secret:"true"`
+	Session string `json:"session" env:"UTAH_SESSION" flag:"session" usage:"session to sync bills for, e.g. 2026GS (default: the latest session)"`
+}
+
+// LegiScan configures the LegiScan API.
+type LegiScan struct {
+	APIKey      string `json:"api_key" env:"LEGISCAN_API_KEY" flag:"legiscan-api-key" usage:"API key from legiscan.com" secret:"true"`
+	SessionYear int    `json:"session_year" env:"STATS_SESSION_YEAR" flag:"stats-session-year" usage:"only recompute stats for sessions starting in this year"`
+	Force       bool   `json:"force" env:"STATS_FORCE" flag:"stats-force" usage:"recompute stats even for sessions whose dataset is unchanged"`
+}
+
+// Validate reports the settings that are invalid.
+func (c Ingest) Validate() error {
+	return errors.Join(validateSources(c.LegislatorsSource, c.SpecialSessions)...)
+}
+
+// validateSources checks the settings of the ingestion jobs shared by the
+// ingest command and the server.
+func validateSources(legislatorsSource, specialSessions string) []error {
+	var errs []error
+	switch legislatorsSource {
+	case "", "utah", "openstates", "utah+openstates":
+	default:
+		errs = append(errs, fmt.Errorf("legislators_source: unknown source %q", legislatorsSource))
+	}
+	if _, err := calendar.ParseSpecialSessions(specialSessions); err != nil {
+		errs = append(errs, fmt.Errorf("special_sessions: %w", err))
+	}
+	return errs
+}
+
+// DefaultIngest returns the defaults of the ingestion settings.
+func DefaultIngest() Ingest {
+	return Ingest{
+		Store:             Store{PocketBaseDataDir: "./pb_data"},
+		LegislatorsSource: "utah",
+	}
+}
@@ -0,0 +1,233 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pocketbase/pocketbase/tools/cron"
+)
+
+// Server configures the API server. The ingestion settings it shares with
+// the ingest command have the same keys, variables and flags.
+type Server struct {
+	Store    Store    `json:"store"`
+	Utah     Utah     `json:"utah_legislature"`
+	LegiScan LegiScan `json:"legiscan"`
+
+	// LegislatorsSource is "utah", "openstates" or "utah+openstates".
+	LegislatorsSource string `json:"legislators_source" env:"LEGISLATORS_SOURCE" flag:"legislators-source" usage:"legislator source: utah, openstates or utah+openstates"`
+
+	// SpecialSessions lists special sessions as "YYYY-MM-DD[/YYYY-MM-DD]",
+	// see calendar.ParseSpecialSessions.
+	SpecialSessions string `json:"special_sessions" env:"SPECIAL_SESSIONS" flag:"special-sessions" usage:"special sessions, e.g. 2026-05-20/2026-05-21"`
+
+	Listen        Listen        `json:"listen"`
+	TLS           TLS           `json:"tls"`
+	CORS          CORS          `json:"cors"`
+	Cache         Cache         `json:"cache"`
+	Scheduler     Scheduler     `json:"scheduler"`
+	Auth          Auth          `json:"auth"`
+	Notifications Notifications `json:"notifications"`
+
+	// MetricsToken is the bearer token /metrics requires; empty serves
+	// them to anyone.
+	MetricsToken string `json:"metrics_token" env:"METRICS_TOKEN" secret:"true"`
+}
+
+// Listen sets where the server listens.
+type Listen struct {
+	// HTTP is the address of PocketBase's HTTP server, which also serves
+	// the gateway. Empty keeps the serve command's default; its --http
+	// flag overrides this.
+	HTTP string `json:"http" env:"HTTP_ADDR"`
+	GRPC string `json:"grpc" env:"GRPC_ADDR" flag:"grpc-addr" usage:"address of the gRPC server"`
+
+	// GRPCOnHTTP serves gRPC on the HTTP address instead of GRPC, over
+	// HTTP/2: h2c in plaintext, or negotiated under the serve command's
+	// --https. The server then exposes a single port.
+	GRPCOnHTTP bool `json:"grpc_on_http" env:"GRPC_ON_HTTP" flag:"grpc-on-http" usage:"serve gRPC on the HTTP address instead of --grpc-addr"`
+
+	// APIPrefix is the path the gateway's /v1 routes are served under, so
+	// GET /v1/bills is served at /api/v1/bills by default.
+	APIPrefix string `json:"api_prefix" env:"API_PREFIX" flag:"api-prefix" usage:"path prefix of the gateway's /v1 routes"`
+}
+
+// TLS holds the certificate of the gRPC server's own listener; without
+// one, it serves plaintext. ClientCAFile also requires clients to present a
+// certificate it signed. With Listen.GRPCOnHTTP, the serve command's
+// --https secures gRPC instead.
+type TLS struct {
+	CertFile     string `json:"cert_file" env:"TLS_CERT_FILE" flag:"tls-cert" usage:"TLS certificate of the gRPC server, PEM"`
+	KeyFile      string `json:"key_file" env:"TLS_KEY_FILE" flag:"tls-key" usage:"private key of --tls-cert, PEM"`
+	ClientCAFile string `json:"client_ca_file" env:"TLS_CLIENT_CA_FILE" flag:"tls-client-ca" usage:"CA certificates that must sign gRPC clients' certificates, PEM"`
+}
+
+// Enabled reports whether a certificate is configured.
+func (t TLS) Enabled() bool {
+	return t.CertFile != ""
+}
+
+// CORS sets which browser origins may call the HTTP API.
+type CORS struct {
+	// AllowedOrigins are origins such as "https://app.example", or "*".
+	// Empty keeps the serve command's default, any origin; its --origins
+	// flag overrides this.
+	AllowedOrigins []string `json:"allowed_origins" env:"CORS_ALLOWED_ORIGINS"`
+}
+
+// Cache sets how long reads are cached. Cached reads are invalidated when
+// a sync is signalled, and expire after their TTL even if none is.
+type Cache struct {
+	BillTTL       time.Duration `json:"bill_ttl" env:"BILL_CACHE_TTL" flag:"bill-cache-ttl" usage:"how long bill reads are cached"`
+	LegislatorTTL time.Duration `json:"legislator_ttl" env:"LEGISLATOR_CACHE_TTL" flag:"legislator-cache-ttl" usage:"how long legislator reads are cached"`
+
+	// SyncCheckInterval bounds how long a finished job takes to show up.
+	SyncCheckInterval time.Duration `json:"sync_check_interval" env:"SYNC_CHECK_INTERVAL" flag:"sync-check-interval" usage:"how often to check for syncs by other instances"`
+}
+
+// Scheduler sets the cadence of the embedded jobs, as cron expressions in
+// UTC.
+type Scheduler struct {
+	// Disabled leaves the ingestion jobs, and the sending of notifications
+	// and webhooks, to another instance.
+	Disabled bool `json:"disabled" env:"DISABLE_SCHEDULER" flag:"disable-scheduler" usage:"don't run the ingestion jobs on this instance"`
+
+	Legislators string `json:"legislators" env:"LEGISLATORS_SCHEDULE" flag:"legislators-schedule" usage:"when to sync legislators"`
+	// Bills is a tick; the session calendar decides which ticks sync, so
+	// it should stay at every 15 minutes.
+	Bills string `json:"bills" env:"BILLS_SCHEDULE" flag:"bills-schedule" usage:"when to consider syncing bills"`
+	Stats string `json:"stats" env:"STATS_SCHEDULE" flag:"stats-schedule" usage:"when to recompute legislator stats"`
+
+	// Jitter delays each scheduled run by up to this long, so jobs sharing
+	// a schedule don't hit upstream APIs at the same instant.
+	Jitter time.Duration `json:"jitter" env:"SCHEDULER_JITTER" flag:"scheduler-jitter" usage:"random delay of scheduled runs, up to"`
+
+	// Notifications and webhooks are sent from their queues on these
+	// schedules, without jitter.
+	Notifications string `json:"notifications" env:"NOTIFICATIONS_SCHEDULE" flag:"notifications-schedule" usage:"when to send queued notifications"`
+	Webhooks      string `json:"webhooks" env:"WEBHOOKS_SCHEDULE" flag:"webhooks-schedule" usage:"when to send queued webhook deliveries"`
+}
+
+// Auth configures the verification of Supabase access tokens. Without a
+// secret or key set, only public RPCs can be called.
+type Auth struct {
+	SupabaseJWTSecret string `json:"supabase_jwt_secret" env:"SUPABASE_JWT_SECRET" secret:"true"`
+	SupabaseJWKSFile  string `json:"supabase_jwks_file" env:"SUPABASE_JWKS_FILE" flag:"supabase-jwks-file" usage:"Supabase JWKS file to verify access tokens with"`
+	SupabaseJWTIssuer string `json:"supabase_jwt_issuer" env:"SUPABASE_JWT_ISSUER" flag:"supabase-jwt-issuer" usage:"required issuer of access tokens"`
+}
+
+// Enabled reports whether access tokens can be verified.
+func (a Auth) Enabled() bool {
+	return a.SupabaseJWTSecret != "" || a.SupabaseJWKSFile != ""
+}
+
+// Notifications configures the notification channels; each is enabled by
+// its first setting.
+type Notifications struct {
+	SMTPAddr     string `json:"smtp_addr" env:"SMTP_ADDR" flag:"smtp-addr" usage:"SMTP server to send email notifications through, host:port"`
+	SMTPUsername string `json:"smtp_username" env:"SMTP_USERNAME"`
+	SMTPPassword string `json:"smtp_password" env:"SMTP_PASSWORD" secret:"true"`
+	SMTPFrom     string `json:"smtp_from" env:"SMTP_FROM" flag:"smtp-from" usage:"sender of email notifications"`
+
+	VAPIDPrivateKey string `json:"vapid_private_key" env:"VAPID_PRIVATE_KEY" secret:"true"`
+	VAPIDSubject    string `json:"vapid_subject" env:"VAPID_SUBJECT" flag:"vapid-subject" usage:"contact URL or mailto: sent to web push services"`
+
+	FCMCredentialsFile string `json:"fcm_credentials_file" env:"FCM_CREDENTIALS_FILE" flag:"fcm-credentials-file" usage:"Firebase service account JSON for FCM notifications"`
+}
+
+// DefaultServer returns the defaults of the server settings.
+func DefaultServer() Server {
+	return Server{
+		Store:             Store{PocketBaseDataDir: "./pb_data"},
+		LegislatorsSource: "utah",
+		Listen: Listen{
+			GRPC:      ":50051",
+			APIPrefix: "/api",
+		},
+		Cache: Cache{
+			BillTTL:           10 * time.Minute,
+			LegislatorTTL:     time.Hour,
+			SyncCheckInterval: 15 * time.Second,
+		},
+		Scheduler: Scheduler{
+			Legislators:   "0 10 * * *",   // daily, 3-4am in Utah
+			Bills:         "*/15 * * * *", // a tick; the session calendar decides which run
+			Stats:         "0 11 * * 1",   // weekly; LegiScan rebuilds datasets weekly
+			Jitter:        5 * time.Minute,
+			Notifications: "* * * * *",
+			Webhooks:      "* * * * *",
+		},
+	}
+}
+
+// Validate reports the settings that are invalid.
+func (c Server) Validate() error {
+	errs := validateSources(c.LegislatorsSource, c.SpecialSessions)
+
+	if c.Listen.HTTP != "" {
+		if _, _, err := net.SplitHostPort(c.Listen.HTTP); err != nil {
+			errs = append(errs, fmt.Errorf("listen.http: %w", err))
+		}
+	}
+	if !c.Listen.GRPCOnHTTP {
+		if _, _, err := net.SplitHostPort(c.Listen.GRPC); err != nil {
+			errs = append(errs, fmt.Errorf("listen.grpc: %w", err))
+		}
+	}
+	if p := c.Listen.APIPrefix; p != "" && (!strings.HasPrefix(p, "/") || strings.HasSuffix(p, "/")) {
+		errs = append(errs, fmt.Errorf("listen.api_prefix: %q must start with a slash and not end with one", p))
+	}
+
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		errs = append(errs, errors.New("tls: cert_file and key_file must be set together"))
+	}
+	if c.TLS.ClientCAFile != "" && c.TLS.CertFile == "" {
+		errs = append(errs, errors.New("tls: client_ca_file requires cert_file"))
+	}
+	if c.TLS.Enabled() && c.Listen.GRPCOnHTTP {
+		errs = append(errs, errors.New("tls: listen.grpc_on_http serves gRPC without its own listener; use the serve command's --https"))
+	}
+
+	for _, origin := range c.CORS.AllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			errs = append(errs, fmt.Errorf("cors.allowed_origins: %q is not an origin such as https://app.example", origin))
+		}
+	}
+
+	for _, d := range []struct {
+		name string
+		d    time.Duration
+	}{
+		{"cache.bill_ttl", c.Cache.BillTTL},
+		{"cache.legislator_ttl", c.Cache.LegislatorTTL},
+		{"cache.sync_check_interval", c.Cache.SyncCheckInterval},
+	} {
+		if d.d <= 0 {
+			errs = append(errs, fmt.Errorf("%s: must be positive, got %s", d.name, d.d))
+		}
+	}
+
+	for _, s := range []struct{ name, expr string }{
+		{"scheduler.legislators", c.Scheduler.Legislators},
+		{"scheduler.bills", c.Scheduler.Bills},
+		{"scheduler.stats", c.Scheduler.Stats},
+		{"scheduler.notifications", c.Scheduler.Notifications},
+		{"scheduler.webhooks", c.Scheduler.Webhooks},
+	} {
+		if _, err := cron.NewSchedule(s.expr); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", s.name, err))
+		}
+	}
+	if c.Scheduler.Jitter < 0 {
+		errs = append(errs, fmt.Errorf("scheduler.jitter: must not be negative, got %s", c.Scheduler.Jitter))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// APIKey identifies a third-party client of the public API. Requests made
+// with it are rate limited by the key instead of by their IP address, and
+// counted towards its usage.
+type APIKey struct {
+	ID     string
+	Name   string // who the key was issued to
+	Prefix string // the start of the key, to recognise it by
+	Hash   string // hex SHA-256 of the key; the key itself isn't stored
+	// RatePerMinute and Burst override the server's default limit for
+	// keys when set.
+	RatePerMinute int
+	Burst         int
+	Revoked       bool
+	Requests      int64 // requests made with the key
+	Throttled     int64 // of which were rejected by its rate limit
+	LastUsedAt    *time.Time
+	CreatedAt     time.Time
+}
@@ -4,14 +4,18 @@ import "time"
 
 // Bill represents a Utah state bill or resolution.
 type Bill struct {
-	ID                string
-	BillNumber        string // e.g. "HB0001"
-	BillType          string // HB, SB, HCR, SCR, HJR, SJR, HR, SR
-	SessionYear       int
-	Title             string
-	Description       string
-	Status            string
-	SponsorID         string
+	ID          string
+	BillNumber  string // e.g. "HB0001"
+	BillType    string // HB, SB, HCR, SCR, HJR, SJR, HR, SR
+	SessionYear int
+	Title       string
+	Description string
+	Status      string
+	SponsorID   string
+	// SponsorUtahID is the sponsor's Utah Legislature ID as sent upstream.
+	// It is kept even while SponsorID is unresolved, so the bill can be
+	// linked once that legislator is stored.
+	SponsorUtahID     string
 	Sponsor           *Legislator
 	FullTextURL       string
 	LastAction        string
@@ -20,4 +24,16 @@ type Bill struct {
 	EffectiveDate     *time.Time
 	UtahLegislatureID string
 	LegiscanID        int
+
+	// Source identifies the upstream feed an incoming record came from.
+	// Provenance records, per field, which feed supplied the stored value.
+	Source     string
+	Provenance Provenance
+}
+
+// UnresolvedSponsor is an upstream sponsor ID that bills reference but no
+// stored legislator has been linked to.
+type UnresolvedSponsor struct {
+	SponsorUtahID string
+	Bills         int // bills sponsored under this ID
 }
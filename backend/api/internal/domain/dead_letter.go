@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// Kinds of dead-lettered record.
+const (
+	DeadLetterBill       = "bill"
+	DeadLetterLegislator = "legislator"
+)
+
+// DeadLetter is a record that ingestion failed to write, kept so it can be
+// retried on its own instead of through a full sync.
+type DeadLetter struct {
+	ID string
+	// Kind is DeadLetterBill or DeadLetterLegislator.
+	Kind string
+	// Source is the upstream source the record came from, e.g.
+	// SourceUtahLegislature.
+	Source string
+	// Key identifies the record upstream: "<session>/<bill number>" for
+	// bills (e.g. "2026GS/HB0001"), and the source's own ID for
+	// legislators.
+	Key           string
+	Payload       []byte // the record as JSON, as it was when it failed
+	Error         string
+	Attempts      int
+	FirstFailedAt time.Time
+	LastFailedAt  time.Time
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// Kinds of followed item.
+const (
+	FollowBill       = "bill"
+	FollowLegislator = "legislator"
+	FollowCommittee  = "committee"
+	FollowCodeTitle  = "code_title"
+)
+
+// Follow is an item on a user's watchlist.
+type Follow struct {
+	ID     string
+	UserID string // Supabase auth.users id
+	// Kind is one of the Follow constants.
+	Kind string
+	// TargetID identifies the followed item: the stored ID of a bill or
+	// legislator, a committee code such as "HSTHHS", or a Utah Code title
+	// such as "63G".
+	TargetID  string
+	CreatedAt time.Time
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// Job run statuses.
+const (
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+)
+
+// What started a job run.
+const (
+	TriggerSchedule = "schedule"
+	TriggerManual   = "manual"
+)
+
+// JobRun records one execution of an ingestion job.
+type JobRun struct {
+	ID         string
+	Job        string // e.g. "legislators", "bills"
+	Trigger    string // TriggerSchedule or TriggerManual
+	Status     string // JobRunning, JobSucceeded or JobFailed
+	StartedAt  time.Time
+	FinishedAt *time.Time // nil while running
+	Upserted   int
+	Failed     int
+	Error      string
+}
@@ -14,9 +14,29 @@ type Legislator struct {
 	Phone             string
 	Website           string
 	ImageURL          string
-	TermStart         *time.Time
-	TermEnd           *time.Time
-	UtahLegislatureID string // ID from glen.le.utah.gov
+	Offices           []Office
+	Twitter           string
+	Facebook          string
+	Instagram         string
+	YouTube           string
+	TermStart         *time.Time // start of the current (or most recent) term
+	TermEnd           *time.Time // nil while serving
+	Current           bool       // holds a seat today
+	Terms             []Term     // full service history, when loaded
+	UtahLegislatureID string     // ID from glen.le.utah.gov
 	LegiscanID        int
 	OpenStatesID      string
+
+	// Source identifies the upstream feed an incoming record came from.
+	// Provenance records, per field, which feed supplied the stored value.
+	Source     string
+	Provenance Provenance
+}
+
+// Office is a physical contact office for a legislator.
+type Office struct {
+	Classification string // "capitol" or "district"
+	Address        string
+	Voice          string
+	Fax            string
 }
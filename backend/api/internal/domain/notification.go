@@ -0,0 +1,98 @@
+package domain
+
+import "time"
+
+// Types of Event.
+const (
+	EventBillStatusChanged = "bill.status_changed"
+	EventBillAction        = "bill.action"      // a new LastAction
+	EventBillNewVersion    = "bill.new_version" // a new FullTextURL
+	EventLegislatorUpdated = "legislator.updated"
+)
+
+// Event is a change to a stored record, detected as ingestion writes it.
+type Event struct {
+	Type       string      // one of the Event constants
+	Bill       *Bill       // the bill as stored after the change, for bill events
+	Legislator *Legislator // the legislator as stored after the change, for legislator events
+	// Previous is the value before the change: the old Status, LastAction
+	// or FullTextURL.
+	Previous string
+	// Changed names the fields that changed, for legislator events.
+	Changed []string
+	At      time.Time
+}
+
+// Notification channels.
+const (
+	ChannelEmail   = "email"
+	ChannelWebPush = "webpush"
+	ChannelFCM     = "fcm"
+)
+
+// NotificationSubscription is a user's request to be notified of events.
+// It matches an event about a bill if the event type is one of EventTypes
+// and the bill is BillID, is sponsored by SponsorID, or (with Followed) is
+// on the user's watchlist directly or through its sponsor. A subscription
+// with none of those set matches every bill.
+type NotificationSubscription struct {
+	ID         string
+	UserID     string   // Supabase auth.users id
+	EventTypes []string // empty means every type
+	BillID     string
+	SponsorID  string
+	Followed   bool
+	Channels   []string // empty means every channel the user has set up
+	CreatedAt  time.Time
+}
+
+// NotificationSettings are a user's delivery preferences. Enabled is the
+// settings.notification_enabled flag the app already exposes.
+type NotificationSettings struct {
+	UserID  string
+	Enabled bool
+	Email   string // address for the email channel; empty disables it
+	// TimeZone is the IANA zone quiet hours are in; empty means
+	// America/Denver.
+	TimeZone string
+	// QuietStart and QuietEnd are minutes after local midnight. Nothing is
+	// sent from QuietStart until QuietEnd, which may be on the next day.
+	// Equal values mean no quiet hours.
+	QuietStart, QuietEnd int
+	// DigestInterval batches notifications into one message at most this
+	// often. Zero sends each as it happens.
+	DigestInterval time.Duration
+	LastDigestAt   *time.Time // when the last batch was sent
+}
+
+// DefaultNotificationSettings returns the settings of a user who has
+// stored none: enabled, with no quiet hours or digest.
+func DefaultNotificationSettings(userID string) NotificationSettings {
+	return NotificationSettings{UserID: userID, Enabled: true}
+}
+
+// Device is a push destination a user has registered.
+type Device struct {
+	ID      string
+	UserID  string
+	Channel string // ChannelWebPush or ChannelFCM
+	// Token is the push service endpoint URL for web push, or the
+	// registration token for FCM.
+	Token string
+	// P256dh and Auth are a web push subscription's keys, base64url
+	// encoded as browsers report them.
+	P256dh, Auth string
+	CreatedAt    time.Time
+}
+
+// Notification is a message queued for a user until it is delivered.
+type Notification struct {
+	ID        string
+	UserID    string
+	EventType string
+	BillID    string
+	Title     string
+	Body      string
+	Channels  []string // empty means every channel the user has set up
+	CreatedAt time.Time
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// Upstream source identifiers recorded in field provenance.
+const (
+	SourceUtahLegislature = "utah_legislature"
+	SourceOpenStates      = "openstates"
+	SourceLegiScan        = "legiscan"
+)
+
+// FieldSource records which upstream source supplied a field value and when.
+type FieldSource struct {
+	Source    string
+	UpdatedAt time.Time
+}
+
+// Provenance maps a stored field name (e.g. "phone") to the source that
+// supplied its current value.
+type Provenance map[string]FieldSource
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// Entity types whose sync is tracked with a SyncVersion.
+const (
+	EntityBills       = "bills"
+	EntityLegislators = "legislators"
+)
+
+// SyncVersion marks the last completed sync of an entity type. Ingestion
+// jobs bump it after writing, so API processes can tell cached reads are
+// stale without watching the data itself.
+type SyncVersion struct {
+	Entity   string
+	Version  int64     // 0 if never synced
+	SyncedAt time.Time // zero if never synced
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// Term is a seat held by a legislator for a bounded period. A person who
+// moves from the House to the Senate, or whose district is renumbered, keeps
+// one Legislator identity and gains a new Term.
+type Term struct {
+	ID             string
+	LegislatorID   string
+	Chamber        string // "house" or "senate"
+	DistrictNumber int
+	Start          time.Time  // zero when unknown
+	End            *time.Time // nil while the term is current
+}
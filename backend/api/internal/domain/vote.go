@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+// Vote is a single member's position on a roll call.
+type Vote string
+
+const (
+	VoteYea     Vote = "yea"
+	VoteNay     Vote = "nay"
+	VoteAbsent  Vote = "absent"  // absent or excused
+	VotePresent Vote = "present" // present but not voting
+)
+
+// RollCall is one recorded floor or committee vote.
+type RollCall struct {
+	ID         int // LegiScan roll_call_id
+	BillNumber string
+	Chamber    string // "house" or "senate"
+	Date       time.Time
+	Votes      map[string]Vote // legislator ID → vote
+}
+
+// LegislatorStats summarises a legislator's voting and sponsorship record
+// for one session. It is computed in bulk by the stats job, not per request.
+type LegislatorStats struct {
+	LegislatorID string
+	SessionID    int    // LegiScan session_id
+	SessionName  string // e.g. "2026 General Session"
+	SessionYear  int
+
+	EligibleVotes     int     // roll calls the member was listed on
+	VotesCast         int     // yea or nay
+	MissedVotes       int     // absent or present-not-voting
+	ParticipationRate float64 // VotesCast / EligibleVotes
+	VotesAgainstParty int     // yea/nay opposite to the majority of their party
+
+	BillsSponsored int // as primary sponsor
+	BillsPassed    int // sponsored bills that passed
+
+	Agreement []Agreement // one entry per other member with shared votes
+
+	ComputedAt  time.Time
+	DatasetHash string // LegiScan dataset_hash the stats were computed from
+}
+
+// Agreement is how often two legislators voted the same way.
+type Agreement struct {
+	LegislatorID string
+	SharedVotes  int     // roll calls where both voted yea or nay
+	Rate         float64 // fraction of SharedVotes where they agreed
+}
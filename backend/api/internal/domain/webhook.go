@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// Webhook is a URL that receives events as signed HTTP POSTs. It matches an
+// event if the event type is one of EventTypes and the event's bill is
+// BillID, its bill or legislator is (or is sponsored by) SponsorID, and its
+// bill title or description, or legislator name, contains one of Keywords.
+// Unset filters match everything.
+type Webhook struct {
+	ID         string
+	OwnerID    string // Supabase auth.users id
+	URL        string
+	Secret     string   // HMAC key for the X-Webhook-Signature header
+	EventTypes []string // empty means every type
+	BillID     string
+	SponsorID  string   // legislator id
+	Keywords   []string // matched case-insensitively
+	CreatedAt  time.Time
+}
+
+// Webhook delivery statuses.
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryFailed    = "failed" // gave up after the last retry
+)
+
+// WebhookDelivery is one event sent, or to be sent, to one webhook. The
+// deliveries of a webhook are its delivery log.
+type WebhookDelivery struct {
+	ID        string
+	WebhookID string
+	EventID   string // shared by every delivery of the same event, and by replays
+	EventType string
+	Payload   []byte // the JSON request body
+	Status    string // one of the Delivery constants
+	Attempts  int
+	// NextAttemptAt is when a pending delivery is next tried.
+	NextAttemptAt time.Time
+	LastAttemptAt *time.Time
+	// ResponseStatus is the HTTP status of the last attempt, 0 if the
+	// request itself failed.
+	ResponseStatus int
+	LastError      string
+	ReplayOf       string // the delivery this one replays, if any
+	CreatedAt      time.Time
+}
@@ -0,0 +1,50 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// Freshness is a check that fails if the last successful run of job
+// finished more than maxAge(now) ago, or if none of its recent runs
+// succeeded. maxAge takes the time so thresholds can follow the session
+// calendar. Runs are read from the shared run history, so it also sees
+// runs by other instances and the ingest command. A job that has never run
+// is StatusUnknown.
+func Freshness(runs repository.JobRunRepository, job string, maxAge func(now time.Time) time.Duration) Check {
+	return func(ctx context.Context) Result {
+		recent, err := runs.ListJobRuns(ctx, job, 0)
+		if err != nil {
+			return Result{Status: StatusFail, Error: fmt.Sprintf("list %s runs: %v", job, err)}
+		}
+		now := time.Now()
+		limit := maxAge(now)
+		res := Result{Status: StatusFail, MaxAgeSeconds: int64(limit / time.Second)}
+		if len(recent) == 0 {
+			res.Status = StatusUnknown
+			res.Error = "never run"
+			return res
+		}
+
+		for _, run := range recent {
+			if run.Status != domain.JobSucceeded || run.FinishedAt == nil {
+				continue
+			}
+			age := now.Sub(*run.FinishedAt)
+			res.LastSuccess = run.FinishedAt
+			res.AgeSeconds = int64(age / time.Second)
+			if age > limit {
+				res.Error = fmt.Sprintf("last synced %s ago, more than %s", age.Round(time.Second), limit)
+				return res
+			}
+			res.Status = StatusOK
+			return res
+		}
+		res.Error = fmt.Sprintf("none of the last %d runs succeeded", len(recent))
+		return res
+	}
+}
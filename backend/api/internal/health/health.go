@@ -0,0 +1,153 @@
+// Package health serves the API server's liveness and readiness checks to
+// load balancers and orchestrators, as /healthz and /readyz.
+//
+// A Checker runs a set of named Checks and reports each one's result as
+// JSON, with 200 OK if all pass and 503 Service Unavailable otherwise.
+// Readiness covers the gRPC server, the databases and the freshness of the
+// synced data: serving stale bills during a session is worse than serving
+// none, so a sync that has fallen behind takes the server out of rotation.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Statuses of a check.
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+	// StatusUnknown is a check that can't tell yet, e.g. the freshness of
+	// a job that has never run. It doesn't fail the Checker.
+	StatusUnknown = "unknown"
+)
+
+// Result is the outcome of a check.
+type Result struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+
+	// Set by Freshness.
+	LastSuccess   *time.Time `json:"last_success,omitempty"`
+	AgeSeconds    int64      `json:"age_seconds,omitempty"`
+	MaxAgeSeconds int64      `json:"max_age_seconds,omitempty"`
+}
+
+// A Check reports whether one part of the server is healthy.
+type Check func(ctx context.Context) Result
+
+// Report is the outcome of every check of a Checker.
+type Report struct {
+	Status string            `json:"status"` // StatusOK or StatusFail
+	Checks map[string]Result `json:"checks"`
+}
+
+// Checker runs named checks.
+type Checker struct {
+	timeout time.Duration
+	names   []string
+	checks  []Check
+}
+
+// NewChecker creates a Checker whose checks each get up to timeout.
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// Add adds a check. Add every check before serving.
+func (c *Checker) Add(name string, check Check) {
+	c.names = append(c.names, name)
+	c.checks = append(c.checks, check)
+}
+
+// Check runs the checks concurrently. The report fails if any check does.
+func (c *Checker) Check(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	results := make([]Result, len(c.checks))
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = check(ctx)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]Result, len(results))}
+	for i, res := range results {
+		report.Checks[c.names[i]] = res
+		if res.Status == StatusFail {
+			report.Status = StatusFail
+		}
+	}
+	return report
+}
+
+// Handler serves the report as JSON, with 503 Service Unavailable if it
+// failed.
+func (c *Checker) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := c.Check(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		if report.Status != StatusOK {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(report)
+	})
+}
+
+// Ping is a check that passes if ping returns no error.
+func Ping(ping func(ctx context.Context) error) Check {
+	return func(ctx context.Context) Result {
+		if err := ping(ctx); err != nil {
+			return Result{Status: StatusFail, Error: err.Error()}
+		}
+		return Result{Status: StatusOK}
+	}
+}
+
+// GRPC is a check that calls the grpc.health.v1 service over conn, so it
+// passes only if the server is accepting calls and reports SERVING.
+func GRPC(conn grpc.ClientConnInterface) Check {
+	client := healthpb.NewHealthClient(conn)
+	return func(ctx context.Context) Result {
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		if err != nil {
+			return Result{Status: StatusFail, Error: err.Error()}
+		}
+		if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			return Result{Status: StatusFail, Error: resp.GetStatus().String()}
+		}
+		return Result{Status: StatusOK}
+	}
+}
+
+// Latch is a check that passes until Fail is called, for failures that are
+// only noticed elsewhere, such as a server goroutine exiting.
+type Latch struct {
+	err atomic.Pointer[error]
+}
+
+// Fail makes the check fail from now on, reporting err.
+func (l *Latch) Fail(err error) {
+	l.err.Store(&err)
+}
+
+// Check is the Check of the latch.
+func (l *Latch) Check(context.Context) Result {
+	if err := l.err.Load(); err != nil {
+		return Result{Status: StatusFail, Error: (*err).Error()}
+	}
+	return Result{Status: StatusOK}
+}
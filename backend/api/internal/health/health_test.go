@@ -0,0 +1,132 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+
+	"api/internal/domain"
+	"api/internal/repository/memory"
+)
+
+func TestCheckerHandler(t *testing.T) {
+	var latch Latch
+	c := NewChecker(time.Second)
+	c.Add("db", Ping(func(context.Context) error { return nil }))
+	c.Add("server", latch.Check)
+	c.Add("sync", func(context.Context) Result { return Result{Status: StatusUnknown} })
+
+	get := func() (int, Report) {
+		rec := httptest.NewRecorder()
+		c.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		var report Report
+		if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+			t.Fatal(err)
+		}
+		return rec.Code, report
+	}
+
+	if code, report := get(); code != http.StatusOK || report.Status != StatusOK || len(report.Checks) != 3 {
+		t.Errorf("healthy: got %d %+v, want 200 with 3 checks", code, report)
+	}
+
+	latch.Fail(errors.New("server stopped"))
+	code, report := get()
+	if code != http.StatusServiceUnavailable || report.Status != StatusFail {
+		t.Errorf("failed latch: got %d %s, want 503 fail", code, report.Status)
+	}
+	if got := report.Checks["server"]; got.Status != StatusFail || got.Error != "server stopped" {
+		t.Errorf("server check = %+v", got)
+	}
+	if got := report.Checks["db"]; got.Status != StatusOK {
+		t.Errorf("db check = %+v, want ok", got)
+	}
+}
+
+func TestFreshness(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	at := func(ago time.Duration) *time.Time {
+		ts := now.Add(-ago)
+		return &ts
+	}
+	save := func(t *testing.T, runs *memory.JobRunRepository, status string, ago time.Duration) {
+		t.Helper()
+		run := domain.JobRun{Job: "bills", Trigger: domain.TriggerSchedule, Status: status, StartedAt: *at(ago + time.Minute), FinishedAt: at(ago)}
+		if err := runs.SaveJobRun(ctx, &run); err != nil {
+			t.Fatal(err)
+		}
+	}
+	hour := func(time.Time) time.Duration { return time.Hour }
+
+	tests := []struct {
+		name   string
+		setup  func(t *testing.T, runs *memory.JobRunRepository)
+		status string
+	}{
+		{"never run", func(*testing.T, *memory.JobRunRepository) {}, StatusUnknown},
+		{"fresh", func(t *testing.T, runs *memory.JobRunRepository) {
+			save(t, runs, domain.JobSucceeded, 10*time.Minute)
+		}, StatusOK},
+		{"fresh despite a later failure", func(t *testing.T, runs *memory.JobRunRepository) {
+			save(t, runs, domain.JobSucceeded, 30*time.Minute)
+			save(t, runs, domain.JobFailed, 5*time.Minute)
+		}, StatusOK},
+		{"stale", func(t *testing.T, runs *memory.JobRunRepository) {
+			save(t, runs, domain.JobSucceeded, 2*time.Hour)
+		}, StatusFail},
+		{"only failures", func(t *testing.T, runs *memory.JobRunRepository) {
+			save(t, runs, domain.JobFailed, 5*time.Minute)
+		}, StatusFail},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs := memory.NewJobRunRepository()
+			tt.setup(t, runs)
+			res := Freshness(runs, "bills", hour)(ctx)
+			if res.Status != tt.status {
+				t.Errorf("status = %s (%s), want %s", res.Status, res.Error, tt.status)
+			}
+			if res.MaxAgeSeconds != 3600 {
+				t.Errorf("max age = %d, want 3600", res.MaxAgeSeconds)
+			}
+		})
+	}
+}
+
+func TestGRPC(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	hs := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(srv, hs)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	check := GRPC(conn)
+	if res := check(context.Background()); res.Status != StatusOK {
+		t.Errorf("serving: got %+v, want ok", res)
+	}
+	hs.Shutdown()
+	if res := check(context.Background()); res.Status != StatusFail {
+		t.Errorf("after shutdown: got %+v, want fail", res)
+	}
+}
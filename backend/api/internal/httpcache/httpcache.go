@@ -0,0 +1,117 @@
+// Package httpcache adds conditional-request support to HTTP handlers, so
+// clients can revalidate a cached response without downloading it again.
+package httpcache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// LastModifiedFunc returns when the data behind a request last changed, or
+// the zero time if unknown.
+type LastModifiedFunc func(r *http.Request) time.Time
+
+// Handler wraps next so that successful GET and HEAD responses carry an
+// ETag (a hash of the body) and, when lastModified knows it, a
+// Last-Modified header. Requests whose If-None-Match or If-Modified-Since
+// still match get 304 Not Modified with no body.
+//
+// Responses are buffered to hash them, which is fine for API payloads but
+// not for streaming handlers. lastModified may be nil.
+func Handler(next http.Handler, lastModified LastModifiedFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &recorder{header: http.Header{}, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		h := w.Header()
+		for k, v := range rec.header {
+			h[k] = v
+		}
+		if rec.status != http.StatusOK {
+			w.WriteHeader(rec.status)
+			w.Write(rec.body.Bytes())
+			return
+		}
+
+		sum := sha256.Sum256(rec.body.Bytes())
+		etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+		h.Set("ETag", etag)
+		var modified time.Time
+		if lastModified != nil {
+			modified = lastModified(r).UTC().Truncate(time.Second)
+		}
+		if !modified.IsZero() {
+			h.Set("Last-Modified", modified.Format(http.TimeFormat))
+		}
+		if h.Get("Cache-Control") == "" {
+			// Let clients keep the response but check back every time.
+			// Responses to signed-in users may be theirs alone, so shared
+			// caches must not keep them.
+			if r.Header.Get("Authorization") != "" {
+				h.Set("Cache-Control", "private, no-cache")
+			} else {
+				h.Set("Cache-Control", "no-cache")
+			}
+		}
+
+		if notModified(r, etag, modified) {
+			h.Del("Content-Length")
+			h.Del("Content-Type")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(rec.body.Bytes())
+		}
+	})
+}
+
+// notModified evaluates the request's preconditions per RFC 9110: If-None-Match
+// takes precedence, and If-Modified-Since is only used without it.
+func notModified(r *http.Request, etag string, modified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !modified.IsZero() {
+		t, err := http.ParseTime(ims)
+		return err == nil && !modified.After(t)
+	}
+	return false
+}
+
+// recorder buffers a response so it can be hashed before it is sent.
+type recorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+	wrote  bool
+}
+
+func (r *recorder) Header() http.Header { return r.header }
+
+func (r *recorder) WriteHeader(status int) {
+	if !r.wrote {
+		r.status, r.wrote = status, true
+	}
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.wrote = true
+	return r.body.Write(b)
+}
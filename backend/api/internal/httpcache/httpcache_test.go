@@ -0,0 +1,77 @@
+package httpcache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandler(t *testing.T) {
+	modified := time.Date(2026, time.February, 1, 12, 0, 0, 0, time.UTC)
+	h := Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"bills":[]}`))
+	}), func(*http.Request) time.Time { return modified })
+
+	serve := func(method, path string, header map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := serve(http.MethodGet, "/v1/bills", nil)
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || etag == "" || first.Body.String() != `{"bills":[]}` {
+		t.Fatalf("first response = %d, ETag %q, body %q", first.Code, etag, first.Body)
+	}
+	if got := first.Header().Get("Last-Modified"); got != modified.Format(http.TimeFormat) {
+		t.Errorf("Last-Modified = %q", got)
+	}
+
+	cases := []struct {
+		name   string
+		header map[string]string
+		want   int
+	}{
+		{"matching etag", map[string]string{"If-None-Match": etag}, http.StatusNotModified},
+		{"weak etag in list", map[string]string{"If-None-Match": `"other", W/` + etag}, http.StatusNotModified},
+		{"stale etag", map[string]string{"If-None-Match": `"other"`}, http.StatusOK},
+		{"etag wins over date", map[string]string{
+			"If-None-Match":     `"other"`,
+			"If-Modified-Since": modified.Format(http.TimeFormat),
+		}, http.StatusOK},
+		{"not modified since", map[string]string{"If-Modified-Since": modified.Format(http.TimeFormat)}, http.StatusNotModified},
+		{"modified since", map[string]string{"If-Modified-Since": modified.Add(-time.Hour).Format(http.TimeFormat)}, http.StatusOK},
+	}
+	for _, c := range cases {
+		rec := serve(http.MethodGet, "/v1/bills", c.header)
+		if rec.Code != c.want {
+			t.Errorf("%s: status = %d, want %d", c.name, rec.Code, c.want)
+		}
+		if rec.Code == http.StatusNotModified && rec.Body.Len() != 0 {
+			t.Errorf("%s: 304 with body %q", c.name, rec.Body)
+		}
+	}
+
+	if rec := serve(http.MethodGet, "/missing", map[string]string{"If-None-Match": "*"}); rec.Code != http.StatusNotFound || rec.Header().Get("ETag") != "" {
+		t.Errorf("error response = %d with ETag %q; want 404 without one", rec.Code, rec.Header().Get("ETag"))
+	}
+	if got := first.Header().Get("Cache-Control"); got != "no-cache" {
+		t.Errorf("Cache-Control = %q, want no-cache", got)
+	}
+	if got := serve(http.MethodGet, "/v1/bills", map[string]string{"Authorization": "Bearer token"}).Header().Get("Cache-Control"); got != "private, no-cache" {
+		t.Errorf("signed-in Cache-Control = %q, want private, no-cache", got)
+	}
+	if rec := serve(http.MethodPost, "/v1/bills", map[string]string{"If-None-Match": etag}); rec.Code != http.StatusOK {
+		t.Errorf("POST status = %d, want 200", rec.Code)
+	}
+}
@@ -0,0 +1,170 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"api/internal/calendar"
+	"api/internal/config"
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/sources/utah_legislature"
+)
+
+func init() {
+	Register(Source{
+		Name:        "bills",
+		Description: "Sync every bill in a session from the Utah Legislature API",
+		Run: func(ctx context.Context, stores Stores, cfg config.Ingest, logger *slog.Logger) (Result, error) {
+			session := cfg.Utah.Session
+			if session == "" {
+				// Like the scheduler, sync the session whose bills are most
+				// likely to be changing, which may be a special session.
+				special, err := calendar.ParseSpecialSessions(cfg.SpecialSessions)
+				if err != nil {
+					return Result{}, err
+				}
+				session = calendar.New(special).Latest(time.Now()).Name
+			}
+			return SyncBills(ctx, stores, BillOptions{Token: cfg.Utah.Token, Session: session}, logger)
+		},
+	})
+}
+
+// BillOptions configures SyncBills.
+type BillOptions struct {
+	Token   string // Utah Legislature developer token
+	Session string // e.g. "2026GS"; defaults to the current year's general session
+}
+
+// SyncBills fetches every bill in a session and upserts them as one batch.
+//
+// Bills are keyed on (bill_number, session_year). The sponsor is resolved by
+// looking up the legislator's utah_legislature_id in the database; bills
+// whose sponsor isn't stored yet keep the upstream ID and are linked when
+// legislators are next synced, so the jobs may run in either order.
+func SyncBills(ctx context.Context, stores Stores, opts BillOptions, logger *slog.Logger) (Result, error) {
+	if opts.Token == "" {
+		return Result{}, fmt.Errorf("a Utah Legislature token is required")
+	}
+	session := opts.Session
+	if session == "" {
+		session = utah_legislature.CurrentSession()
+	}
+
+	client := utah_legislature.NewClient(opts.Token)
+
+	logger.Info("fetching Utah bills", "session", session)
+	bills, err := client.FetchBills(ctx, session)
+	if err != nil {
+		return Result{}, fmt.Errorf("fetch bills for %s: %w", session, err)
+	}
+	logger.Info("fetched bills", "count", len(bills), "session", session)
+
+	resolveSponsors(ctx, stores, bills, logger)
+
+	// The session is written as one batch so a failure part way through
+	// leaves the previous sync intact rather than a half-updated session.
+	// If the batch fails, the bills are written one at a time so a single
+	// bad record doesn't hold back the rest; those that still fail are
+	// dead-lettered for Resync.
+	res := Result{Fetched: len(bills), Upserted: len(bills)}
+	written := make(map[string]bool, len(bills))
+	if err := stores.Bills.UpsertBills(ctx, bills); err != nil {
+		logger.Warn("batch upsert failed; writing bills one at a time", "session", session, "error", err)
+		res = Result{Fetched: len(bills)}
+		for _, b := range bills {
+			if err := stores.Bills.UpsertBill(ctx, b); err != nil {
+				if ctx.Err() != nil {
+					return res, fmt.Errorf("upsert bills for %s: %w", session, ctx.Err())
+				}
+				deadLetter(ctx, stores, domain.DeadLetterBill, domain.SourceUtahLegislature, billKey(session, b.BillNumber), b, err, logger)
+				res.Failed++
+				continue
+			}
+			res.Upserted++
+			written[billKey(session, b.BillNumber)] = true
+		}
+	} else {
+		for _, b := range bills {
+			written[billKey(session, b.BillNumber)] = true
+		}
+	}
+	clearDeadLetters(ctx, stores, domain.DeadLetterBill, written, logger)
+	if res.Upserted > 0 {
+		bumpSyncVersion(ctx, stores, domain.EntityBills, logger)
+	}
+	linkSponsors(ctx, stores, logger)
+
+	logger.Info("bills sync complete", "session", session, "upserted", res.Upserted, "failed", res.Failed)
+	if res.Failed > 0 {
+		return res, fmt.Errorf("bills sync for %s: %d bills failed", session, res.Failed)
+	}
+	return res, nil
+}
+
+// resolveSponsors sets each bill's SponsorID to the stored legislator with
+// its SponsorUtahID. Bills whose sponsor isn't stored yet are written
+// without one and linked by linkSponsors once the legislator is synced.
+func resolveSponsors(ctx context.Context, stores Stores, bills []domain.Bill, logger *slog.Logger) {
+	sponsorCache, err := buildSponsorCache(ctx, stores.Legislators)
+	if err != nil {
+		logger.Warn("could not build sponsor cache; sponsors will be linked later", "error", err)
+	}
+	for i, b := range bills {
+		bills[i].SponsorID = sponsorCache[b.SponsorUtahID]
+	}
+}
+
+// linkSponsors links bills stored before their sponsor was, and reports
+// the sponsor IDs that still match no legislator as a data-quality warning.
+// It runs after every write of bills or legislators, so links don't depend
+// on which job runs first.
+func linkSponsors(ctx context.Context, stores Stores, logger *slog.Logger) {
+	if stores.Bills == nil {
+		return
+	}
+	linked, err := stores.Bills.LinkSponsors(ctx)
+	if err != nil {
+		logger.Warn("failed to link bill sponsors", "error", err)
+		return
+	}
+	if linked > 0 {
+		logger.Info("linked bill sponsors", "bills", linked)
+		bumpSyncVersion(ctx, stores, domain.EntityBills, logger)
+	}
+
+	unresolved, err := stores.Bills.UnresolvedSponsors(ctx)
+	if err != nil {
+		logger.Warn("failed to count unresolved sponsors", "error", err)
+		return
+	}
+	if len(unresolved) > 0 {
+		ids := make([]string, 0, len(unresolved))
+		bills := 0
+		for _, u := range unresolved {
+			ids = append(ids, u.SponsorUtahID)
+			bills += u.Bills
+		}
+		logger.Warn("bills with unresolved sponsors", "sponsors", len(unresolved), "bills", bills, "sponsor_ids", ids)
+	}
+}
+
+// buildSponsorCache returns a map of utah_legislature_id → stored legislator ID
+// for all legislators in the database, including former members so bills from
+// past sessions still link to their sponsors.
+func buildSponsorCache(ctx context.Context, repo repository.LegislatorRepository) (map[string]string, error) {
+	legislators, err := repo.ListLegislators(ctx, repository.LegislatorFilters{IncludeFormer: true})
+	if err != nil {
+		return nil, err
+	}
+	cache := make(map[string]string, len(legislators))
+	for _, l := range legislators {
+		if l.UtahLegislatureID != "" {
+			cache[l.UtahLegislatureID] = l.ID
+		}
+	}
+	return cache, nil
+}
@@ -0,0 +1,71 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"api/internal/domain"
+)
+
+// billKey returns the dead letter key of a bill: "<session>/<bill number>".
+func billKey(session, number string) string {
+	return session + "/" + number
+}
+
+// legislatorKey returns the dead letter key of a legislator from source:
+// the ID that source knows them by.
+func legislatorKey(source string, l domain.Legislator) string {
+	if source == domain.SourceOpenStates {
+		return l.OpenStatesID
+	}
+	return l.UtahLegislatureID
+}
+
+// deadLetter records a record that could not be written. If payload is nil,
+// record is marshalled instead. Failing to record it is logged, not fatal:
+// the failure is still counted in the sync's result.
+func deadLetter(ctx context.Context, stores Stores, kind, source, key string, record any, cause error, logger *slog.Logger) {
+	logger.Error("failed to write record", "kind", kind, "key", key, "error", cause)
+	if stores.DeadLetters == nil {
+		return
+	}
+	var payload []byte
+	if record != nil {
+		var err error
+		if payload, err = json.Marshal(record); err != nil {
+			logger.Warn("could not marshal dead letter payload", "kind", kind, "key", key, "error", err)
+		}
+	}
+	err := stores.DeadLetters.RecordDeadLetter(ctx, domain.DeadLetter{
+		Kind:    kind,
+		Source:  source,
+		Key:     key,
+		Payload: payload,
+		Error:   cause.Error(),
+	})
+	if err != nil {
+		logger.Warn("failed to record dead letter", "kind", kind, "key", key, "error", err)
+	}
+}
+
+// clearDeadLetters resolves the dead letters of kind whose records have
+// now been written.
+func clearDeadLetters(ctx context.Context, stores Stores, kind string, written map[string]bool, logger *slog.Logger) {
+	if stores.DeadLetters == nil || len(written) == 0 {
+		return
+	}
+	letters, err := stores.DeadLetters.ListDeadLetters(ctx, kind)
+	if err != nil {
+		logger.Warn("failed to list dead letters", "kind", kind, "error", err)
+		return
+	}
+	for _, dl := range letters {
+		if !written[dl.Key] {
+			continue
+		}
+		if err := stores.DeadLetters.ResolveDeadLetter(ctx, kind, dl.Key); err != nil {
+			logger.Warn("failed to resolve dead letter", "kind", kind, "key", dl.Key, "error", err)
+		}
+	}
+}
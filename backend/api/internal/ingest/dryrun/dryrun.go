@@ -0,0 +1,379 @@
+// Package dryrun wraps ingestion stores so that writes are printed as a
+// diff against the stored data instead of being made. Reads go to the real
+// stores, so a sync sees the data as it is, not as the dry run would have
+// left it.
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/ingest"
+	"api/internal/merge"
+	"api/internal/repository"
+)
+
+// Plan collects the changes a dry run would make and prints them to w as
+// they are planned.
+type Plan struct {
+	mu                           sync.Mutex
+	w                            io.Writer
+	created, updated, unchanged  int
+	retired, linked, deadLetters int
+}
+
+// New creates a Plan printing to w.
+func New(w io.Writer) *Plan {
+	return &Plan{w: w}
+}
+
+// Wrap returns stores whose writes are recorded in p instead of made.
+func (p *Plan) Wrap(stores ingest.Stores) ingest.Stores {
+	out := ingest.Stores{}
+	if stores.Legislators != nil {
+		out.Legislators = &legislators{LegislatorRepository: stores.Legislators, plan: p}
+	}
+	if stores.Bills != nil {
+		out.Bills = &bills{BillRepository: stores.Bills, legislators: stores.Legislators, plan: p}
+	}
+	if stores.Stats != nil {
+		out.Stats = &stats{StatsRepository: stores.Stats, plan: p}
+	}
+	if stores.Sync != nil {
+		out.Sync = readOnlySync{stores.Sync}
+	}
+	if stores.DeadLetters != nil {
+		out.DeadLetters = &deadLetters{DeadLetterRepository: stores.DeadLetters, plan: p}
+	}
+	return out
+}
+
+// Summary describes the planned changes in one line.
+func (p *Plan) Summary() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	s := fmt.Sprintf("dry run: %d to create, %d to update, %d unchanged", p.created, p.updated, p.unchanged)
+	if p.retired > 0 {
+		s += fmt.Sprintf(", %d to retire", p.retired)
+	}
+	if p.linked > 0 {
+		s += fmt.Sprintf(", %d sponsor links", p.linked)
+	}
+	if p.deadLetters > 0 {
+		s += fmt.Sprintf(", %d to dead-letter", p.deadLetters)
+	}
+	return s
+}
+
+// record prints the change from before to after of the record named name.
+// before is nil for a new record.
+func (p *Plan) record(name string, before, after any, skip ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if reflect.ValueOf(before).IsNil() {
+		p.created++
+		fmt.Fprintf(p.w, "+ %s\n", name)
+		for _, line := range diff(reflect.Zero(reflect.TypeOf(after)).Interface(), after, skip) {
+			fmt.Fprintf(p.w, "    %s\n", line)
+		}
+		return
+	}
+	lines := diff(reflect.ValueOf(before).Elem().Interface(), after, skip)
+	if len(lines) == 0 {
+		p.unchanged++
+		return
+	}
+	p.updated++
+	fmt.Fprintf(p.w, "~ %s\n", name)
+	for _, line := range lines {
+		fmt.Fprintf(p.w, "    %s\n", line)
+	}
+}
+
+// printf prints a line of the plan.
+func (p *Plan) printf(format string, args ...any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fmt.Fprintf(p.w, format+"\n", args...)
+}
+
+// diff lists the exported fields that differ between two structs of the
+// same type as "field: old -> new", skipping the named fields and any field
+// that is empty in both.
+func diff(before, after any, skip []string) []string {
+	bv, av := reflect.ValueOf(before), reflect.ValueOf(after)
+	var lines []string
+	for i := 0; i < av.NumField(); i++ {
+		f := av.Type().Field(i)
+		if !f.IsExported() || contains(skip, f.Name) {
+			continue
+		}
+		b, a := bv.Field(i).Interface(), av.Field(i).Interface()
+		if reflect.DeepEqual(b, a) {
+			continue
+		}
+		if bv.Field(i).IsZero() && av.Field(i).IsZero() {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s -> %s", f.Name, format(bv.Field(i)), format(av.Field(i))))
+	}
+	return lines
+}
+
+// format renders a field value for a diff line.
+func format(v reflect.Value) string {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "<none>"
+		}
+		v = v.Elem()
+	}
+	switch x := v.Interface().(type) {
+	case time.Time:
+		return x.Format(time.DateOnly)
+	case string:
+		return fmt.Sprintf("%q", x)
+	}
+	return fmt.Sprintf("%+v", v.Interface())
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// bills plans bill writes. Existing bills are loaded once per session year.
+type bills struct {
+	repository.BillRepository
+	legislators repository.LegislatorRepository
+	plan        *Plan
+
+	mu     sync.Mutex
+	byYear map[int]map[string]domain.Bill // bill number → stored bill
+}
+
+func (r *bills) UpsertBill(ctx context.Context, b domain.Bill) error {
+	return r.UpsertBills(ctx, []domain.Bill{b})
+}
+
+func (r *bills) UpsertBills(ctx context.Context, bills []domain.Bill) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now().UTC()
+	for _, b := range bills {
+		stored, err := r.stored(ctx, b.SessionYear)
+		if err != nil {
+			return err
+		}
+		var existing *domain.Bill
+		if s, ok := stored[b.BillNumber]; ok {
+			existing = &s
+		}
+		m := merge.Bill(existing, b, merge.DefaultBillRules, now)
+		r.plan.record(fmt.Sprintf("bill %s/%d %q", b.BillNumber, b.SessionYear, b.Title), existing, m,
+			"ID", "Sponsor", "Source", "Provenance")
+		stored[b.BillNumber] = m
+	}
+	return nil
+}
+
+// stored returns the stored bills of a session year. The caller holds r.mu.
+func (r *bills) stored(ctx context.Context, year int) (map[string]domain.Bill, error) {
+	if bills, ok := r.byYear[year]; ok {
+		return bills, nil
+	}
+	const pageSize = 500
+	bills := map[string]domain.Bill{}
+	for page := 1; ; page++ {
+		batch, err := r.BillRepository.ListBills(ctx, repository.BillFilters{
+			SessionYear: year, Page: page, PageSize: pageSize, OmitSponsor: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("load stored bills: %w", err)
+		}
+		for _, b := range batch {
+			bills[b.BillNumber] = b
+		}
+		if len(batch) < pageSize {
+			break
+		}
+	}
+	if r.byYear == nil {
+		r.byYear = map[int]map[string]domain.Bill{}
+	}
+	r.byYear[year] = bills
+	return bills, nil
+}
+
+// LinkSponsors reports the unresolved sponsors that match a stored
+// legislator.
+func (r *bills) LinkSponsors(ctx context.Context) (int, error) {
+	if r.legislators == nil {
+		return 0, nil
+	}
+	unresolved, err := r.BillRepository.UnresolvedSponsors(ctx)
+	if err != nil || len(unresolved) == 0 {
+		return 0, err
+	}
+	ls, err := r.legislators.ListLegislators(ctx, repository.LegislatorFilters{IncludeFormer: true})
+	if err != nil {
+		return 0, err
+	}
+	known := map[string]bool{}
+	for _, l := range ls {
+		known[l.UtahLegislatureID] = true
+	}
+	linked := 0
+	for _, u := range unresolved {
+		if known[u.SponsorUtahID] {
+			r.plan.printf("~ link %d bills to sponsor %s", u.Bills, u.SponsorUtahID)
+			linked += u.Bills
+		}
+	}
+	r.plan.mu.Lock()
+	r.plan.linked += linked
+	r.plan.mu.Unlock()
+	return 0, nil // nothing was written
+}
+
+// legislators plans legislator writes, matching incoming records to stored
+// ones by their upstream IDs.
+type legislators struct {
+	repository.LegislatorRepository
+	plan *Plan
+
+	mu     sync.Mutex
+	stored []domain.Legislator
+	loaded bool
+}
+
+func (r *legislators) UpsertLegislator(ctx context.Context, l domain.Legislator) error {
+	return r.UpsertLegislators(ctx, []domain.Legislator{l})
+}
+
+func (r *legislators) UpsertLegislators(ctx context.Context, ls []domain.Legislator) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.load(ctx); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	for _, l := range ls {
+		i := r.find(l)
+		var existing *domain.Legislator
+		if i >= 0 {
+			existing = &r.stored[i]
+		}
+		m := merge.Legislator(existing, l, merge.DefaultLegislatorRules, now)
+		name := fmt.Sprintf("legislator %s %s (%s %d)", l.FirstName, l.LastName, l.Chamber, l.DistrictNumber)
+		r.plan.record(name, existing, m, "ID", "Terms", "TermStart", "TermEnd", "Current", "Source", "Provenance")
+		if i >= 0 {
+			r.stored[i] = m
+		} else {
+			r.stored = append(r.stored, m)
+		}
+	}
+	return nil
+}
+
+// RetireLegislators reports the current legislators who would be retired.
+func (r *legislators) RetireLegislators(ctx context.Context, keep []string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.load(ctx); err != nil {
+		return 0, err
+	}
+	var names []string
+	for _, l := range r.stored {
+		if l.Current && l.UtahLegislatureID != "" && !contains(keep, l.UtahLegislatureID) {
+			names = append(names, fmt.Sprintf("%s %s (%s)", l.FirstName, l.LastName, l.UtahLegislatureID))
+		}
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		r.plan.printf("- retire legislator %s", n)
+	}
+	r.plan.mu.Lock()
+	r.plan.retired += len(names)
+	r.plan.mu.Unlock()
+	return len(names), nil
+}
+
+// load reads every stored legislator once. The caller holds r.mu.
+func (r *legislators) load(ctx context.Context) error {
+	if r.loaded {
+		return nil
+	}
+	ls, err := r.LegislatorRepository.ListLegislators(ctx, repository.LegislatorFilters{IncludeFormer: true})
+	if err != nil {
+		return fmt.Errorf("load stored legislators: %w", err)
+	}
+	r.stored, r.loaded = ls, true
+	return nil
+}
+
+// find returns the index of the stored legislator sharing an upstream ID
+// with l, or -1.
+func (r *legislators) find(l domain.Legislator) int {
+	for i, s := range r.stored {
+		switch {
+		case l.UtahLegislatureID != "" && s.UtahLegislatureID == l.UtahLegislatureID,
+			l.OpenStatesID != "" && s.OpenStatesID == l.OpenStatesID,
+			l.LegiscanID != 0 && s.LegiscanID == l.LegiscanID:
+			return i
+		}
+	}
+	return -1
+}
+
+// stats plans stats replacements.
+type stats struct {
+	repository.StatsRepository
+	plan *Plan
+}
+
+func (r *stats) ReplaceSessionStats(ctx context.Context, sessionID int, s []domain.LegislatorStats) error {
+	r.plan.printf("~ replace stats of LegiScan session %d (%d legislators)", sessionID, len(s))
+	r.plan.mu.Lock()
+	r.plan.updated++
+	r.plan.mu.Unlock()
+	return nil
+}
+
+// readOnlySync reports the current version instead of bumping it.
+type readOnlySync struct {
+	repository.SyncRepository
+}
+
+func (r readOnlySync) BumpSyncVersion(ctx context.Context, entity string) (domain.SyncVersion, error) {
+	return r.GetSyncVersion(ctx, entity)
+}
+
+// deadLetters plans dead letter writes.
+type deadLetters struct {
+	repository.DeadLetterRepository
+	plan *Plan
+}
+
+func (r *deadLetters) RecordDeadLetter(ctx context.Context, dl domain.DeadLetter) error {
+	r.plan.printf("! dead-letter %s %s: %s", dl.Kind, dl.Key, strings.TrimSpace(dl.Error))
+	r.plan.mu.Lock()
+	r.plan.deadLetters++
+	r.plan.mu.Unlock()
+	return nil
+}
+
+func (r *deadLetters) ResolveDeadLetter(ctx context.Context, kind, key string) error {
+	return nil
+}
@@ -0,0 +1,97 @@
+package dryrun
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"api/internal/domain"
+	"api/internal/ingest"
+	"api/internal/repository"
+	"api/internal/repository/memory"
+)
+
+func TestPlan(t *testing.T) {
+	ctx := context.Background()
+	legislators := memory.NewLegislatorRepository()
+	bills := memory.NewBillRepository(legislators)
+	stores := ingest.Stores{Bills: bills, Legislators: legislators, Sync: memory.NewSyncRepository()}
+
+	if err := legislators.UpsertLegislators(ctx, []domain.Legislator{
+		{UtahLegislatureID: "SMITHJ", FirstName: "Jane", LastName: "Smith", Chamber: "house", DistrictNumber: 1, Source: domain.SourceUtahLegislature},
+		{UtahLegislatureID: "DOEJ", FirstName: "John", LastName: "Doe", Chamber: "senate", DistrictNumber: 2, Source: domain.SourceUtahLegislature},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bills.UpsertBills(ctx, []domain.Bill{
+		{BillNumber: "HB0001", SessionYear: 2026, Title: "Water", Status: "introduced", Source: domain.SourceUtahLegislature},
+		{BillNumber: "HB0002", SessionYear: 2026, Title: "Roads", Status: "introduced", Source: domain.SourceUtahLegislature},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	plan := New(&out)
+	dry := plan.Wrap(stores)
+
+	if err := dry.Bills.UpsertBills(ctx, []domain.Bill{
+		{BillNumber: "HB0001", SessionYear: 2026, Title: "Water", Status: "passed", Source: domain.SourceUtahLegislature},
+		{BillNumber: "HB0002", SessionYear: 2026, Title: "Roads", Status: "introduced", Source: domain.SourceUtahLegislature},
+		{BillNumber: "HB0003", SessionYear: 2026, Title: "Schools", Source: domain.SourceUtahLegislature},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	retired, err := dry.Legislators.RetireLegislators(ctx, []string{"SMITHJ"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retired != 1 {
+		t.Errorf("retired = %d, want 1", retired)
+	}
+	v, err := dry.Sync.BumpSyncVersion(ctx, domain.EntityBills)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Version != 0 {
+		t.Errorf("sync version bumped to %d", v.Version)
+	}
+
+	got := out.String()
+	for _, want := range []string{
+		`~ bill HB0001/2026 "Water"` + "\n" + `    Status: "introduced" -> "passed"`,
+		`+ bill HB0003/2026 "Schools"`,
+		"- retire legislator John Doe (DOEJ)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("plan missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "HB0002") {
+		t.Errorf("unchanged bill printed:\n%s", got)
+	}
+	if s, want := plan.Summary(), "dry run: 1 to create, 1 to update, 1 unchanged, 1 to retire"; s != want {
+		t.Errorf("Summary() = %q, want %q", s, want)
+	}
+
+	// Nothing was written.
+	stored, err := bills.ListBills(ctx, repository.BillFilters{SessionYear: 2026, PageSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 2 {
+		t.Errorf("stored %d bills, want 2", len(stored))
+	}
+	for _, b := range stored {
+		if b.BillNumber == "HB0001" && b.Status != "introduced" {
+			t.Errorf("HB0001 status = %q, want it unchanged", b.Status)
+		}
+	}
+	current, err := legislators.ListLegislators(ctx, repository.LegislatorFilters{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(current) != 2 {
+		t.Errorf("%d current legislators, want 2", len(current))
+	}
+}
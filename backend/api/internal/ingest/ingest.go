@@ -0,0 +1,40 @@
+// Package ingest syncs upstream sources into the repositories. Each sync is
+// a plain function so it can run from the ingest command (see Register) or
+// from the scheduler embedded in the API server.
+package ingest
+
+import (
+	"context"
+	"log/slog"
+
+	"api/internal/repository"
+)
+
+// Stores are the repositories a sync reads and writes. Each sync only uses
+// the ones it needs; Sync may be nil to skip cache invalidation, and
+// DeadLetters nil to only log records that fail to write.
+type Stores struct {
+	Bills       repository.BillRepository
+	Legislators repository.LegislatorRepository
+	Stats       repository.StatsRepository
+	Sync        repository.SyncRepository
+	DeadLetters repository.DeadLetterRepository
+}
+
+// Result summarises a sync.
+type Result struct {
+	Fetched  int // records, or whole sessions, read from upstream
+	Upserted int // records written
+	Failed   int // records, or whole sessions, that could not be written
+}
+
+// bumpSyncVersion tells API servers their cached reads of entity are stale.
+// Failing to is not fatal: the data is written and caches still expire by TTL.
+func bumpSyncVersion(ctx context.Context, stores Stores, entity string, logger *slog.Logger) {
+	if stores.Sync == nil {
+		return
+	}
+	if _, err := stores.Sync.BumpSyncVersion(ctx, entity); err != nil {
+		logger.Warn("failed to bump sync version", "entity", entity, "error", err)
+	}
+}
@@ -0,0 +1,241 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/repository/memory"
+	"api/internal/sources/utah_legislature"
+)
+
+var discard = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// stubUpstream sends every request made through http.DefaultTransport, as
+// the source clients' requests are, to handler instead of the real host.
+// It returns the paths requested.
+func stubUpstream(t *testing.T, handler http.HandlerFunc) func() []string {
+	t.Helper()
+	var mu sync.Mutex
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	target, _ := url.Parse(srv.URL)
+	orig := http.DefaultTransport
+	http.DefaultTransport = roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		r = r.Clone(r.Context())
+		r.URL.Scheme, r.URL.Host = target.Scheme, target.Host
+		return srv.Client().Transport.RoundTrip(r)
+	})
+	t.Cleanup(func() { http.DefaultTransport = orig })
+
+	return func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), paths...)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+// utahAPI serves a Utah Legislature bill list and bill details for bills,
+// keyed on bill number. Bills in missing are not found.
+func utahAPI(bills map[string]string, missing ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/") // bills/<session>/<id>/<token>
+		if len(parts) != 4 || parts[0] != "bills" {
+			http.NotFound(w, r)
+			return
+		}
+		if parts[2] == "billlist" {
+			var list []map[string]string
+			for number, status := range bills {
+				list = append(list, map[string]string{"id": number, "shortTitle": number, "status": status})
+			}
+			json.NewEncoder(w).Encode(list)
+			return
+		}
+		status, ok := bills[parts[2]]
+		for _, m := range missing {
+			ok = ok && m != parts[2]
+		}
+		if !ok {
+			http.Error(w, "not found", http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id": parts[2], "shortTitle": parts[2], "status": status})
+	}
+}
+
+// flakyBills fails every batch upsert while failBatch is set, and single
+// upserts of the bills in failing.
+type flakyBills struct {
+	repository.BillRepository
+	failBatch bool
+	failing   map[string]bool
+}
+
+func (r *flakyBills) UpsertBills(ctx context.Context, bills []domain.Bill) error {
+	if r.failBatch {
+		return errors.New("batch failed")
+	}
+	return r.BillRepository.UpsertBills(ctx, bills)
+}
+
+func (r *flakyBills) UpsertBill(ctx context.Context, b domain.Bill) error {
+	if r.failing[b.BillNumber] {
+		return fmt.Errorf("bad bill %s", b.BillNumber)
+	}
+	return r.BillRepository.UpsertBill(ctx, b)
+}
+
+func newStores() (Stores, *flakyBills, *memory.DeadLetterRepository) {
+	legislators := memory.NewLegislatorRepository()
+	bills := &flakyBills{BillRepository: memory.NewBillRepository(legislators), failing: map[string]bool{}}
+	deadLetters := memory.NewDeadLetterRepository()
+	return Stores{
+		Bills:       bills,
+		Legislators: legislators,
+		Sync:        memory.NewSyncRepository(),
+		DeadLetters: deadLetters,
+	}, bills, deadLetters
+}
+
+func deadLetters(t *testing.T, repo repository.DeadLetterRepository) []domain.DeadLetter {
+	t.Helper()
+	letters, err := repo.ListDeadLetters(context.Background(), domain.DeadLetterBill)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return letters
+}
+
+func TestSyncBillsFallback(t *testing.T) {
+	ctx := context.Background()
+	stubUpstream(t, utahAPI(map[string]string{"HB0001": "introduced", "HB0002": "introduced", "HB0003": "passed"}))
+	stores, bills, dl := newStores()
+	opts := BillOptions{Token: "token", Session: "2026GS"}
+
+	// The batch fails, so bills are written one at a time and the one that
+	// still fails is dead-lettered.
+	bills.failBatch, bills.failing["HB0002"] = true, true
+	res, err := SyncBills(ctx, stores, opts, discard)
+	if err == nil || res != (Result{Fetched: 3, Upserted: 2, Failed: 1}) {
+		t.Fatalf("SyncBills = %+v, %v; want 2 written and 1 failed", res, err)
+	}
+	stored, _ := stores.Bills.ListBills(ctx, repository.BillFilters{})
+	if len(stored) != 2 {
+		t.Errorf("stored %d bills, want the 2 that could be written", len(stored))
+	}
+	letters := deadLetters(t, dl)
+	if len(letters) != 1 {
+		t.Fatalf("dead letters = %+v, want HB0002", letters)
+	}
+	var payload domain.Bill
+	if err := json.Unmarshal(letters[0].Payload, &payload); err != nil {
+		t.Fatalf("payload: %v", err)
+	}
+	l := letters[0]
+	if l.Key != "2026GS/HB0002" || l.Source != domain.SourceUtahLegislature || l.Attempts != 1 ||
+		l.Error != "bad bill HB0002" || payload.BillNumber != "HB0002" || payload.SessionYear != 2026 {
+		t.Errorf("dead letter = %+v, payload %+v", l, payload)
+	}
+
+	// Failing again counts another attempt.
+	if _, err := SyncBills(ctx, stores, opts, discard); err == nil {
+		t.Fatal("SyncBills succeeded with a bad bill")
+	}
+	if letters := deadLetters(t, dl); len(letters) != 1 || letters[0].Attempts != 2 {
+		t.Errorf("dead letters after a second failure = %+v, want 2 attempts", letters)
+	}
+
+	// A later good sync clears it.
+	bills.failBatch, bills.failing = false, map[string]bool{}
+	if res, err := SyncBills(ctx, stores, opts, discard); err != nil || res.Upserted != 3 {
+		t.Fatalf("SyncBills = %+v, %v", res, err)
+	}
+	if letters := deadLetters(t, dl); len(letters) != 0 {
+		t.Errorf("dead letters after a good sync = %+v, want none", letters)
+	}
+}
+
+func TestResync(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("KeepsPayloadWhenFetchFails", func(t *testing.T) {
+		stubUpstream(t, utahAPI(map[string]string{"HB0001": "passed"}, "HB0001"))
+		stores, _, dl := newStores()
+		original := json.RawMessage(`{"BillNumber":"HB0001","Status":"introduced"}`)
+		err := dl.RecordDeadLetter(ctx, domain.DeadLetter{
+			Kind: domain.DeadLetterBill, Source: domain.SourceUtahLegislature, Key: "2026GS/HB0001", Payload: original, Error: "bad",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		outcomes, err := Resync(ctx, stores, ResyncOptions{Token: "token"}, discard)
+		if err != nil || len(outcomes) != 1 || outcomes[0].Err == nil {
+			t.Fatalf("Resync = %+v, %v; want the bill to fail", outcomes, err)
+		}
+		letters := deadLetters(t, dl)
+		if len(letters) != 1 || letters[0].Attempts != 2 || string(letters[0].Payload) != string(original) {
+			t.Errorf("dead letters = %+v, want the original payload and 2 attempts", letters)
+		}
+	})
+
+	t.Run("WritesAndResolves", func(t *testing.T) {
+		stubUpstream(t, utahAPI(map[string]string{"HB0001": "passed"}))
+		stores, _, dl := newStores()
+		err := dl.RecordDeadLetter(ctx, domain.DeadLetter{
+			Kind: domain.DeadLetterBill, Source: domain.SourceUtahLegislature, Key: "2026GS/HB0001", Error: "bad",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		outcomes, err := Resync(ctx, stores, ResyncOptions{Token: "token"}, discard)
+		if err != nil || len(outcomes) != 1 || outcomes[0].Err != nil {
+			t.Fatalf("Resync = %+v, %v", outcomes, err)
+		}
+		if letters := deadLetters(t, dl); len(letters) != 0 {
+			t.Errorf("dead letters = %+v, want none", letters)
+		}
+		stored, _ := stores.Bills.ListBills(ctx, repository.BillFilters{})
+		if len(stored) != 1 || stored[0].Status != "passed" {
+			t.Errorf("stored = %+v", stored)
+		}
+	})
+
+	t.Run("BareNumberIsCurrentSession", func(t *testing.T) {
+		paths := stubUpstream(t, utahAPI(map[string]string{"HB0003": "introduced"}))
+		stores, _, _ := newStores()
+
+		outcomes, err := Resync(ctx, stores, ResyncOptions{Token: "token", Bills: []string{"HB0003"}}, discard)
+		session := utah_legislature.CurrentSession()
+		if err != nil || len(outcomes) != 1 || outcomes[0].Err != nil || outcomes[0].Key != session+"/HB0003" {
+			t.Fatalf("Resync = %+v, %v; want %s/HB0003 written", outcomes, err, session)
+		}
+		if got := paths(); len(got) != 1 || got[0] != "/bills/"+session+"/HB0003/token" {
+			t.Errorf("requested %v", got)
+		}
+	})
+}
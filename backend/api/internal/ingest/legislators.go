@@ -0,0 +1,149 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"api/internal/config"
+	"api/internal/domain"
+	"api/internal/repository/events"
+	"api/internal/sources/openstates"
+	"api/internal/sources/utah_legislature"
+)
+
+func init() {
+	Register(Source{
+		Name:        "legislators",
+		Description: "Sync the current legislators from the Utah Legislature API and/or OpenStates",
+		Run: func(ctx context.Context, stores Stores, cfg config.Ingest, logger *slog.Logger) (Result, error) {
+			return SyncLegislators(ctx, stores, LegislatorOptions{Source: cfg.LegislatorsSource, Token: cfg.Utah.Token}, logger)
+		},
+	})
+}
+
+// Legislator source modes.
+const (
+	SourceUtah       = "utah"            // official Utah Legislature API only
+	SourceOpenStates = "openstates"      // OpenStates bulk CSV only; no token required
+	SourceMerged     = "utah+openstates" // Utah API first, then OpenStates fills gaps
+)
+
+// LegislatorOptions configures SyncLegislators.
+type LegislatorOptions struct {
+	Source string // one of the Source constants; defaults to SourceUtah
+	Token  string // Utah Legislature developer token; not needed for SourceOpenStates
+}
+
+// SyncLegislators fetches the current legislators and upserts them, one
+// batch per source. Legislators absent from the Utah API roster have their
+// current term ended but are kept as former members, so historic bills
+// still link to them. Bills stored before their sponsor are linked
+// afterwards if stores.Bills is set.
+func SyncLegislators(ctx context.Context, stores Stores, opts LegislatorOptions, logger *slog.Logger) (Result, error) {
+	source := opts.Source
+	if source == "" {
+		source = SourceUtah
+	}
+	if source != SourceUtah && source != SourceOpenStates && source != SourceMerged {
+		return Result{}, fmt.Errorf("unknown legislators source %q", source)
+	}
+	if opts.Token == "" && source != SourceOpenStates {
+		return Result{}, fmt.Errorf("a Utah Legislature token is required for source %q", source)
+	}
+
+	sources := []string{source}
+	if source == SourceMerged {
+		sources = []string{SourceUtah, SourceOpenStates}
+	}
+
+	var res Result
+	for _, src := range sources {
+		logger.Info("fetching Utah legislators", "source", src)
+		legislators, err := fetchLegislators(ctx, src, opts.Token)
+		if err != nil {
+			return res, fmt.Errorf("fetch legislators from %s: %w", src, err)
+		}
+		logger.Info("fetched legislators", "count", len(legislators), "source", src)
+		res.Fetched += len(legislators)
+
+		// Each source is written as one batch so a failure leaves the roster
+		// as it was instead of half-updated. If the batch fails, legislators
+		// are written one at a time and those that still fail dead-lettered.
+		sourceName := legislatorSource(src)
+		written := make(map[string]bool, len(legislators))
+		if err := stores.Legislators.UpsertLegislators(ctx, legislators); err != nil {
+			logger.Warn("batch upsert failed; writing legislators one at a time", "source", src, "error", err)
+			// Changes are detected once across the writes rather than per
+			// legislator.
+			batchCtx, flush := events.Batch(ctx)
+			for _, l := range legislators {
+				key := legislatorKey(sourceName, l)
+				if err := stores.Legislators.UpsertLegislator(batchCtx, l); err != nil {
+					if ctx.Err() != nil {
+						flush()
+						return res, fmt.Errorf("upsert legislators from %s: %w", src, ctx.Err())
+					}
+					deadLetter(ctx, stores, domain.DeadLetterLegislator, sourceName, key, l, err, logger)
+					res.Failed++
+					continue
+				}
+				res.Upserted++
+				written[key] = true
+			}
+			flush()
+		} else {
+			res.Upserted += len(legislators)
+			for _, l := range legislators {
+				written[legislatorKey(sourceName, l)] = true
+			}
+		}
+		clearDeadLetters(ctx, stores, domain.DeadLetterLegislator, written, logger)
+
+		var utahIDs []string
+		for _, l := range legislators {
+			if l.UtahLegislatureID != "" {
+				utahIDs = append(utahIDs, l.UtahLegislatureID)
+			}
+		}
+
+		// The Utah API lists exactly the sitting members, so anyone missing
+		// from it has left office. Their records are kept as former members.
+		if src == SourceUtah {
+			retired, err := stores.Legislators.RetireLegislators(ctx, utahIDs)
+			if err != nil {
+				logger.Error("failed to retire former legislators", "error", err)
+				res.Failed++
+			} else if retired > 0 {
+				logger.Info("retired former legislators", "count", retired)
+			}
+		}
+	}
+
+	if res.Upserted > 0 {
+		bumpSyncVersion(ctx, stores, domain.EntityLegislators, logger)
+		linkSponsors(ctx, stores, logger)
+	}
+
+	logger.Info("legislators sync complete", "source", source, "upserted", res.Upserted, "failed", res.Failed)
+	if res.Failed > 0 {
+		return res, fmt.Errorf("legislators sync: %d failed", res.Failed)
+	}
+	return res, nil
+}
+
+// legislatorSource returns the domain source constant of a source mode.
+func legislatorSource(src string) string {
+	if src == SourceOpenStates {
+		return domain.SourceOpenStates
+	}
+	return domain.SourceUtahLegislature
+}
+
+// fetchLegislators loads legislators from a single source.
+func fetchLegislators(ctx context.Context, source, token string) ([]domain.Legislator, error) {
+	if source == SourceOpenStates {
+		return openstates.NewClient().FetchLegislators(ctx)
+	}
+	return utah_legislature.NewClient(token).FetchLegislators(ctx)
+}
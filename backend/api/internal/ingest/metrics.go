@@ -0,0 +1,47 @@
+package ingest
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Metrics of job runs, in the default Prometheus registry. The API server
+// serves them with the rest; the ingest command pushes them after its run.
+// The job is labelled job_name, as Prometheus reserves job for the scrape
+// target.
+var (
+	jobRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ingest_job_runs_total",
+		Help: "Ingestion job runs, by job and outcome (succeeded or failed).",
+	}, []string{"job_name", "status"})
+	jobDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ingest_job_duration_seconds",
+		Help:    "How long ingestion job runs take.",
+		Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800},
+	}, []string{"job_name"})
+	jobRecords = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ingest_job_records_total",
+		Help: "Records handled by ingestion jobs, by job and outcome (fetched, upserted or failed).",
+	}, []string{"job_name", "outcome"})
+	jobLastSuccess = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ingest_job_last_success_timestamp_seconds",
+		Help: "When each ingestion job last finished without error, in Unix seconds.",
+	}, []string{"job_name"})
+)
+
+// ObserveRun records the metrics of a run of job.
+func ObserveRun(job string, started, finished time.Time, res Result, err error) {
+	status := "succeeded"
+	if err != nil {
+		status = "failed"
+	} else {
+		jobLastSuccess.WithLabelValues(job).Set(float64(finished.Unix()))
+	}
+	jobRuns.WithLabelValues(job, status).Inc()
+	jobDuration.WithLabelValues(job).Observe(finished.Sub(started).Seconds())
+	jobRecords.WithLabelValues(job, "fetched").Add(float64(res.Fetched))
+	jobRecords.WithLabelValues(job, "upserted").Add(float64(res.Upserted))
+	jobRecords.WithLabelValues(job, "failed").Add(float64(res.Failed))
+}
@@ -0,0 +1,55 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+
+	"api/internal/config"
+)
+
+// A Source is a sync the ingest command can run by name. Adding a source
+// means writing its sync function and registering it from an init func in
+// the same file.
+type Source struct {
+	Name        string // subcommand and job name, e.g. "bills"
+	Description string // one line, shown in help
+	Run         func(ctx context.Context, stores Stores, cfg config.Ingest, logger *slog.Logger) (Result, error)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Source{}
+)
+
+// Register adds a source. It panics if the name is already taken.
+func Register(s Source) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[s.Name]; ok {
+		panic(fmt.Sprintf("ingest: source %q registered twice", s.Name))
+	}
+	registry[s.Name] = s
+}
+
+// Sources returns the registered sources, sorted by name.
+func Sources() []Source {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	out := make([]Source, 0, len(registry))
+	for _, s := range registry {
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Lookup returns the source registered under name.
+func Lookup(name string) (Source, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	s, ok := registry[name]
+	return s, ok
+}
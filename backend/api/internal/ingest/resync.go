@@ -0,0 +1,210 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"api/internal/domain"
+	"api/internal/sources/utah_legislature"
+)
+
+// ResyncOptions configures Resync. With no bills or legislators named,
+// every dead-lettered record is retried.
+type ResyncOptions struct {
+	Token string // Utah Legislature developer token
+	// Bills are "<session>/<bill number>" keys such as "2026GS/HB0001"; a
+	// bare bill number is taken to be in the current General Session.
+	Bills []string
+	// Legislators are Utah Legislature IDs.
+	Legislators []string
+}
+
+// Outcome is the result of re-syncing one record.
+type Outcome struct {
+	Kind string // domain.DeadLetterBill or domain.DeadLetterLegislator
+	Key  string // the record's dead letter key
+	Err  error  // nil if the record was written
+}
+
+// resyncTarget is one record to re-fetch.
+type resyncTarget struct {
+	kind, source, key string
+	payload           []byte // kept if the record can't be fetched again
+}
+
+// Resync re-fetches individual bills and legislators from upstream and
+// writes them, so a bad record can be fixed without a full sync. Bills are
+// fetched one by one through the Utah Legislature's bill detail endpoint;
+// legislators are picked out of their source's roster. Records written are
+// removed from the dead letters, and records that fail again have their
+// attempt count incremented.
+//
+// The returned error is for failures that stop the whole resync; failures
+// of individual records are reported in their Outcome.
+func Resync(ctx context.Context, stores Stores, opts ResyncOptions, logger *slog.Logger) ([]Outcome, error) {
+	var targets []resyncTarget
+	for _, b := range opts.Bills {
+		key := b
+		if !strings.Contains(key, "/") {
+			key = billKey(utah_legislature.CurrentSession(), key)
+		}
+		targets = append(targets, resyncTarget{kind: domain.DeadLetterBill, source: domain.SourceUtahLegislature, key: key})
+	}
+	for _, id := range opts.Legislators {
+		targets = append(targets, resyncTarget{kind: domain.DeadLetterLegislator, source: domain.SourceUtahLegislature, key: id})
+	}
+	if len(targets) == 0 {
+		if stores.DeadLetters == nil {
+			return nil, fmt.Errorf("no records named and no dead letters to retry")
+		}
+		letters, err := stores.DeadLetters.ListDeadLetters(ctx, "")
+		if err != nil {
+			return nil, fmt.Errorf("list dead letters: %w", err)
+		}
+		for _, dl := range letters {
+			targets = append(targets, resyncTarget{kind: dl.Kind, source: dl.Source, key: dl.Key, payload: dl.Payload})
+		}
+	}
+	logger.Info("resyncing records", "count", len(targets))
+
+	// Legislators go first so resynced bills can link to them.
+	var outcomes []Outcome
+	written := map[string]bool{}
+	finish := func(t resyncTarget, record any, err error) {
+		outcomes = append(outcomes, Outcome{Kind: t.kind, Key: t.key, Err: err})
+		if err == nil {
+			written[t.kind] = true
+			if stores.DeadLetters != nil {
+				if err := stores.DeadLetters.ResolveDeadLetter(ctx, t.kind, t.key); err != nil {
+					logger.Warn("failed to resolve dead letter", "kind", t.kind, "key", t.key, "error", err)
+				}
+			}
+			return
+		}
+		// A record that couldn't be fetched again keeps the payload it was
+		// dead-lettered with.
+		if record == nil && t.payload != nil {
+			record = json.RawMessage(t.payload)
+		}
+		deadLetter(ctx, stores, t.kind, t.source, t.key, record, err, logger)
+	}
+
+	rosters := map[string]map[string]domain.Legislator{}
+	for _, t := range targets {
+		if t.kind != domain.DeadLetterLegislator {
+			continue
+		}
+		if l, err := resyncLegislator(ctx, stores, opts.Token, t, rosters); l != nil {
+			finish(t, l, err)
+		} else {
+			finish(t, nil, err)
+		}
+	}
+
+	var sponsors map[string]string
+	for _, t := range targets {
+		if t.kind != domain.DeadLetterBill {
+			continue
+		}
+		if sponsors == nil {
+			var err error
+			if sponsors, err = buildSponsorCache(ctx, stores.Legislators); err != nil {
+				logger.Warn("could not build sponsor cache; sponsor links may be missing", "error", err)
+				sponsors = map[string]string{}
+			}
+		}
+		if b, err := resyncBill(ctx, stores, opts.Token, t, sponsors); b != nil {
+			finish(t, b, err)
+		} else {
+			finish(t, nil, err)
+		}
+	}
+
+	if written[domain.DeadLetterLegislator] {
+		bumpSyncVersion(ctx, stores, domain.EntityLegislators, logger)
+		linkSponsors(ctx, stores, logger)
+	}
+	if written[domain.DeadLetterBill] {
+		bumpSyncVersion(ctx, stores, domain.EntityBills, logger)
+	}
+	return outcomes, nil
+}
+
+// resyncBill fetches and writes one bill. The bill is returned if it was
+// fetched, whether or not it could be written.
+func resyncBill(ctx context.Context, stores Stores, token string, t resyncTarget, sponsors map[string]string) (*domain.Bill, error) {
+	if token == "" {
+		return nil, fmt.Errorf("a Utah Legislature token is required")
+	}
+	session, number, ok := strings.Cut(t.key, "/")
+	if !ok || session == "" || number == "" {
+		return nil, fmt.Errorf("invalid bill key %q, want <session>/<bill number>", t.key)
+	}
+
+	b, err := utah_legislature.NewClient(token).FetchBill(ctx, session, number)
+	if err != nil {
+		return nil, err
+	}
+	b.SponsorID = sponsors[b.SponsorUtahID] // linked later if not stored yet
+	if err := stores.Bills.UpsertBill(ctx, *b); err != nil {
+		return b, fmt.Errorf("upsert bill %s: %w", t.key, err)
+	}
+	return b, nil
+}
+
+// resyncLegislator finds one legislator in their source's roster, fetching
+// it into rosters the first time, and writes them. The legislator is
+// returned if they were found, whether or not they could be written.
+func resyncLegislator(ctx context.Context, stores Stores, token string, t resyncTarget, rosters map[string]map[string]domain.Legislator) (*domain.Legislator, error) {
+	src := SourceUtah
+	if t.source == domain.SourceOpenStates {
+		src = SourceOpenStates
+	}
+	roster, ok := rosters[src]
+	if !ok {
+		if token == "" && src == SourceUtah {
+			return nil, fmt.Errorf("a Utah Legislature token is required")
+		}
+		legislators, err := fetchLegislators(ctx, src, token)
+		if err != nil {
+			return nil, fmt.Errorf("fetch legislators from %s: %w", src, err)
+		}
+		roster = make(map[string]domain.Legislator, len(legislators))
+		for _, l := range legislators {
+			roster[legislatorKey(t.source, l)] = l
+		}
+		rosters[src] = roster
+	}
+
+	l, ok := roster[t.key]
+	if !ok {
+		return nil, fmt.Errorf("legislator %s not in the current %s roster", t.key, src)
+	}
+	if err := stores.Legislators.UpsertLegislator(ctx, l); err != nil {
+		return &l, fmt.Errorf("upsert legislator %s: %w", t.key, err)
+	}
+	return &l, nil
+}
+
+// Resyncer runs Resync with fixed stores and credentials, for callers such
+// as the admin API that only choose the records.
+type Resyncer struct {
+	stores Stores
+	token  string
+	logger *slog.Logger
+}
+
+// NewResyncer creates a Resyncer.
+func NewResyncer(stores Stores, token string, logger *slog.Logger) *Resyncer {
+	return &Resyncer{stores: stores, token: token, logger: logger}
+}
+
+// Resync re-syncs the named bills and legislators, or every dead letter if
+// none are named.
+func (r *Resyncer) Resync(ctx context.Context, bills, legislators []string) ([]Outcome, error) {
+	opts := ResyncOptions{Token: r.token, Bills: bills, Legislators: legislators}
+	return Resync(ctx, r.stores, opts, r.logger)
+}
@@ -0,0 +1,213 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"api/internal/analytics"
+	"api/internal/config"
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/sources/legiscan"
+)
+
+func init() {
+	Register(Source{
+		Name:        "legislator_stats",
+		Description: "Compute per-session voting statistics from LegiScan roll calls",
+		Run: func(ctx context.Context, stores Stores, cfg config.Ingest, logger *slog.Logger) (Result, error) {
+			return SyncLegislatorStats(ctx, stores, StatsOptions{
+				APIKey: cfg.LegiScan.APIKey, SessionYear: cfg.LegiScan.SessionYear, Force: cfg.LegiScan.Force,
+			}, logger)
+		},
+	})
+}
+
+// StatsOptions configures SyncLegislatorStats.
+type StatsOptions struct {
+	APIKey string // LegiScan API key
+
+	// SessionYear only recomputes sessions starting in this year. Zero
+	// recomputes the sessions still running this year.
+	SessionYear int
+
+	// Force recomputes sessions whose dataset hasn't changed since their
+	// stats were stored. Otherwise they are skipped without downloading.
+	Force bool
+}
+
+// SyncLegislatorStats computes per-session voting statistics from LegiScan
+// roll calls and replaces the stored stats for each session.
+//
+// LegiScan members are matched to our legislators through LegiscanID. For
+// sessions that are still running, members not yet linked are matched to
+// the current holder of their seat (by last name) and their LegiscanID
+// recorded.
+//
+// Each session's stats record the LegiScan dataset hash they were computed
+// from, and sessions whose dataset still has that hash are skipped, since
+// every dataset download counts against the LegiScan query quota.
+func SyncLegislatorStats(ctx context.Context, stores Stores, opts StatsOptions, logger *slog.Logger) (Result, error) {
+	if opts.APIKey == "" {
+		return Result{}, fmt.Errorf("a LegiScan API key is required")
+	}
+	now := time.Now().UTC()
+	client := legiscan.NewClient(opts.APIKey)
+
+	datasets, err := client.FetchDatasets(ctx)
+	if err != nil {
+		return Result{}, fmt.Errorf("fetch datasets: %w", err)
+	}
+
+	var res Result
+	for _, ds := range datasets {
+		running := ds.YearEnd >= now.Year()
+		if opts.SessionYear != 0 && ds.YearStart != opts.SessionYear {
+			continue
+		}
+		if opts.SessionYear == 0 && !running {
+			continue
+		}
+
+		if !opts.Force {
+			hash, err := stores.Stats.SessionDatasetHash(ctx, ds.SessionID)
+			if err != nil {
+				logger.Warn("failed to load stored dataset hash", "session", ds.SessionName, "error", err)
+			} else if hash != "" && hash == ds.Hash {
+				logger.Info("session dataset unchanged", "session", ds.SessionName)
+				continue
+			}
+		}
+
+		logger.Info("fetching session dataset", "session", ds.SessionName)
+		session, err := client.FetchSession(ctx, ds)
+		if err != nil {
+			logger.Error("failed to fetch session", "session", ds.SessionName, "error", err)
+			res.Failed++
+			continue
+		}
+		res.Fetched++
+
+		if running {
+			linked := linkMembers(ctx, stores.Legislators, session.People, logger)
+			logger.Info("linked LegiScan members", "session", ds.SessionName, "count", linked)
+			if linked > 0 {
+				bumpSyncVersion(ctx, stores, domain.EntityLegislators, logger)
+			}
+		}
+
+		stats, err := sessionStats(ctx, stores.Legislators, session, now)
+		if err != nil {
+			logger.Error("failed to compute stats", "session", ds.SessionName, "error", err)
+			res.Failed++
+			continue
+		}
+		if err := stores.Stats.ReplaceSessionStats(ctx, session.ID, stats); err != nil {
+			logger.Error("failed to store stats", "session", ds.SessionName, "error", err)
+			res.Failed++
+			continue
+		}
+		logger.Info("stored session stats", "session", ds.SessionName, "legislators", len(stats))
+		res.Upserted++
+	}
+
+	logger.Info("legislator stats complete", "sessions", res.Upserted, "failed", res.Failed)
+	if res.Failed > 0 {
+		return res, fmt.Errorf("legislator stats: %d sessions failed", res.Failed)
+	}
+	return res, nil
+}
+
+// linkMembers records the LegiscanID of each member not yet linked, if the
+// current holder of their seat has the same last name. Anyone else is left
+// alone rather than created: the Utah roster is the source of membership.
+func linkMembers(ctx context.Context, repo repository.LegislatorRepository, people []legiscan.Person, logger *slog.Logger) int {
+	var links []domain.Legislator
+	for _, p := range people {
+		holder, err := repo.GetLegislatorByDistrict(ctx, p.Chamber, p.DistrictNumber)
+		if err != nil {
+			logger.Error("failed to find seat holder", "people_id", p.PeopleID, "error", err)
+			continue
+		}
+		if holder == nil || holder.LegiscanID == p.PeopleID || !strings.EqualFold(holder.LastName, p.LastName) {
+			continue
+		}
+
+		links = append(links, domain.Legislator{
+			Chamber:        p.Chamber,
+			DistrictNumber: p.DistrictNumber,
+			FirstName:      p.FirstName,
+			LastName:       p.LastName,
+			LegiscanID:     p.PeopleID,
+			Source:         domain.SourceLegiScan,
+		})
+	}
+
+	if err := repo.UpsertLegislators(ctx, links); err != nil {
+		logger.Error("failed to link legislators", "count", len(links), "error", err)
+		return 0
+	}
+	return len(links)
+}
+
+// sessionStats computes stats for a session and maps LegiScan members to
+// legislator IDs. Unlinked members still count towards party majorities but
+// get no stats of their own, and are left out of agreement scores.
+func sessionStats(ctx context.Context, repo repository.LegislatorRepository, s *legiscan.Session, now time.Time) ([]domain.LegislatorStats, error) {
+	legislators, err := repo.ListLegislators(ctx, repository.LegislatorFilters{IncludeFormer: true})
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]string, len(legislators))
+	for _, l := range legislators {
+		if l.LegiscanID != 0 {
+			ids[legiscan.PeopleKey(l.LegiscanID)] = l.ID
+		}
+	}
+
+	in := analytics.Session{
+		ID:        s.ID,
+		Name:      s.Name,
+		Year:      s.Year,
+		RollCalls: s.RollCalls,
+		Party:     make(map[string]string, len(s.People)),
+	}
+	for _, p := range s.People {
+		in.Party[legiscan.PeopleKey(p.PeopleID)] = p.Party
+	}
+	for _, b := range s.Bills {
+		sponsor := ""
+		if b.PrimarySponsorID != 0 {
+			sponsor = legiscan.PeopleKey(b.PrimarySponsorID)
+		}
+		in.Bills = append(in.Bills, analytics.Sponsorship{SponsorID: sponsor, Passed: b.Passed})
+	}
+
+	var out []domain.LegislatorStats
+	for _, st := range analytics.ComputeSessionStats(in, now) {
+		id, ok := ids[st.LegislatorID]
+		if !ok {
+			continue
+		}
+		st.LegislatorID = id
+		st.DatasetHash = s.FetchedHash
+
+		agreement := st.Agreement[:0]
+		for _, a := range st.Agreement {
+			if other, ok := ids[a.LegislatorID]; ok {
+				a.LegislatorID = other
+				agreement = append(agreement, a)
+			}
+		}
+		sort.Slice(agreement, func(i, j int) bool {
+			return agreement[i].LegislatorID < agreement[j].LegislatorID
+		})
+		st.Agreement = agreement
+		out = append(out, st)
+	}
+	return out, nil
+}
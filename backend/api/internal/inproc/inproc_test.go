@@ -0,0 +1,46 @@
+package inproc
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestDial(t *testing.T) {
+	var isPeer bool
+	// Network clients would need a certificate the server doesn't have.
+	creds := ServerCredentials(credentials.NewTLS(&tls.Config{}))
+	srv := grpc.NewServer(grpc.Creds(creds), grpc.UnaryInterceptor(func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		isPeer = IsPeer(ctx)
+		return handler(ctx, req)
+	}))
+	healthpb.RegisterHealthServer(srv, grpchealth.NewServer())
+	lis := Listen()
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := lis.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("status = %s, want SERVING", resp.GetStatus())
+	}
+	if !isPeer {
+		t.Error("IsPeer = false for a call over the listener")
+	}
+	if IsPeer(context.Background()) {
+		t.Error("IsPeer = true without a peer")
+	}
+}
@@ -0,0 +1,261 @@
+// Command ingest runs the ingestion jobs by hand or from an external cron:
+//
+//	ingest legislators              sync the legislators
+//	ingest bills                    sync every bill in a session
+//	ingest legislator_stats         recompute voting statistics from LegiScan
+//	ingest bill 2026GS/HB0001 ...   re-fetch individual bills
+//	ingest resync                   retry every dead-lettered record
+//	ingest status                   show the session mode, sync versions,
+//	                                recent runs and data problems
+//	ingest config print             show the settings, secrets redacted
+//
+// Each sync source registered with the ingest package gets a subcommand.
+// Settings are read from the defaults, then the JSON file named by --config,
+// then the environment variables the API server uses (DATABASE_URL,
+// UTAH_LEGISLATURE_TOKEN, ...), then flags; run "ingest --help" for the
+// list.
+//
+// With --dry-run the upstream sources are fetched and compared with the
+// stored data, and the changes that would be made are printed as a diff
+// on stdout instead of being written. Logs then go to stderr.
+//
+// Recommended cadences: legislators daily, bills hourly in session and every
+// 15 minutes on its final night, stats weekly. The API server runs the jobs
+// on these cadences itself (see main.go and internal/scheduler).
+//
+// Runs are traced when OTEL_EXPORTER_OTLP_ENDPOINT is set, and their
+// metrics are pushed to --pushgateway-url when it is.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"api/internal/config"
+	"api/internal/domain"
+	"api/internal/ingest"
+	"api/internal/ingest/dryrun"
+	"api/internal/telemetry"
+)
+
+var tracer = otel.Tracer("api/internal/jobs/ingest")
+
+// app holds the state shared by the subcommands.
+type app struct {
+	cfg        config.Ingest
+	configPath string
+	dryRun     bool
+	logger     *slog.Logger
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	a := &app{
+		cfg:    config.DefaultIngest(),
+		logger: slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+	}
+	shutdownTracing, err := telemetry.SetupTracing(ctx, "ingest")
+	if err != nil {
+		a.logger.Warn("tracing disabled", "error", err)
+	}
+	err = a.command().ExecuteContext(ctx)
+	if shutdownTracing != nil {
+		// Flush the spans of this run before exiting.
+		if err := shutdownTracing(context.WithoutCancel(ctx)); err != nil {
+			a.logger.Warn("failed to flush traces", "error", err)
+		}
+	}
+	if err != nil {
+		a.logger.Error("ingest failed", "error", err)
+		stop()
+		os.Exit(1)
+	}
+}
+
+// command builds the root command and its subcommands.
+func (a *app) command() *cobra.Command {
+	root := &cobra.Command{
+		Use:           "ingest",
+		Short:         "Sync upstream legislative data into the database",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if a.dryRun {
+				a.logger = slog.New(slog.NewJSONHandler(cmd.ErrOrStderr(), nil))
+			}
+			if err := config.Load(&a.cfg, a.configPath, cmd.Flags()); err != nil {
+				return err
+			}
+			return a.cfg.Validate()
+		},
+	}
+	flags := root.PersistentFlags()
+	flags.StringVar(&a.configPath, "config", "", "JSON config file")
+	flags.BoolVar(&a.dryRun, "dry-run", false, "print the changes as a diff instead of writing them")
+	config.BindFlags(flags, &a.cfg)
+
+	for _, src := range ingest.Sources() {
+		root.AddCommand(a.sourceCommand(src))
+	}
+	root.AddCommand(a.billCommand(), a.resyncCommand(), a.statusCommand(), a.configCommand())
+	return root
+}
+
+// sourceCommand runs a registered source.
+func (a *app) sourceCommand(src ingest.Source) *cobra.Command {
+	return &cobra.Command{
+		Use:   src.Name,
+		Short: src.Description,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.withStores(cmd, func(ctx context.Context, s *storage) error {
+				logger := a.logger.With("job", src.Name)
+				run := &domain.JobRun{Job: src.Name, Trigger: domain.TriggerManual, Status: domain.JobRunning, StartedAt: time.Now().UTC()}
+				s.saveRun(ctx, run, logger)
+
+				spanCtx, span := tracer.Start(ctx, "job "+src.Name, trace.WithAttributes(attribute.String("job.trigger", run.Trigger)))
+				res, err := src.Run(spanCtx, s.stores, a.cfg, logger)
+				if err != nil {
+					span.RecordError(err)
+					span.SetStatus(codes.Error, "job failed")
+				}
+				span.End()
+
+				finished := time.Now().UTC()
+				ingest.ObserveRun(src.Name, run.StartedAt, finished, res, err)
+				a.pushMetrics(src.Name, logger)
+				run.FinishedAt, run.Upserted, run.Failed = &finished, res.Upserted, res.Failed
+				run.Status = domain.JobSucceeded
+				if err != nil {
+					run.Status, run.Error = domain.JobFailed, err.Error()
+				}
+				s.saveRun(ctx, run, logger)
+				if err != nil {
+					return fmt.Errorf("%s: %w", src.Name, err)
+				}
+				return nil
+			})
+		},
+	}
+}
+
+// configCommand prints the loaded settings, redacting secrets.
+func (a *app) configCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the ingestion settings",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "print",
+		Short: "Print the settings as a JSON config file, with secrets redacted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return config.Print(cmd.OutOrStdout(), &a.cfg)
+		},
+	})
+	return cmd
+}
+
+// billCommand re-fetches the named bills.
+func (a *app) billCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "bill <id>...",
+		Short: "Re-fetch individual bills, e.g. 2026GS/HB0001 or HB0001 in the current General Session",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.resync(cmd, ingest.ResyncOptions{Bills: args})
+		},
+	}
+}
+
+// resyncCommand re-fetches the named records, or retries the dead letters.
+func (a *app) resyncCommand() *cobra.Command {
+	var opts ingest.ResyncOptions
+	cmd := &cobra.Command{
+		Use:   "resync",
+		Short: "Re-fetch individual records, or retry every dead-lettered record if none are named",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.resync(cmd, opts)
+		},
+	}
+	cmd.Flags().StringSliceVar(&opts.Bills, "bills", nil, "bills to resync, e.g. 2026GS/HB0001")
+	cmd.Flags().StringSliceVar(&opts.Legislators, "legislators", nil, "Utah Legislature IDs of legislators to resync")
+	return cmd
+}
+
+// resync runs ingest.Resync and fails if any record did.
+func (a *app) resync(cmd *cobra.Command, opts ingest.ResyncOptions) error {
+	opts.Token = a.cfg.Utah.Token
+	return a.withStores(cmd, func(ctx context.Context, s *storage) error {
+		logger := a.logger.With("job", "resync")
+		outcomes, err := ingest.Resync(ctx, s.stores, opts, logger)
+		if err != nil {
+			return fmt.Errorf("resync: %w", err)
+		}
+		failed := 0
+		for _, o := range outcomes {
+			if o.Err != nil {
+				failed++
+				logger.Error("record failed", "kind", o.Kind, "key", o.Key, "error", o.Err)
+			}
+		}
+		logger.Info("resync complete", "records", len(outcomes), "failed", failed)
+		if failed > 0 {
+			return fmt.Errorf("resync: %d of %d records failed", failed, len(outcomes))
+		}
+		return nil
+	})
+}
+
+// pushMetrics pushes the job metrics to the configured Pushgateway, under a
+// group per source so each source's last run is kept. Failing to is not
+// fatal.
+func (a *app) pushMetrics(job string, logger *slog.Logger) {
+	if a.cfg.PushgatewayURL == "" || a.dryRun {
+		return
+	}
+	err := push.New(a.cfg.PushgatewayURL, "ingest_"+job).
+		Gatherer(prometheus.DefaultGatherer).
+		Push()
+	if err != nil {
+		logger.Warn("failed to push metrics", "error", err)
+	}
+}
+
+// withStores opens the configured database and runs fn against it. Under
+// --dry-run fn gets stores that print their writes, and a summary of the
+// plan is printed after it.
+func (a *app) withStores(cmd *cobra.Command, fn func(ctx context.Context, s *storage) error) error {
+	ctx := cmd.Context()
+	s, err := openStorage(ctx, a.cfg.Store, a.logger)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+
+	if !a.dryRun {
+		return fn(ctx, s)
+	}
+	plan := dryrun.New(cmd.OutOrStdout())
+	s.stores = plan.Wrap(s.stores)
+	s.runs = nil
+	err = fn(ctx, s)
+	fmt.Fprintln(cmd.OutOrStdout(), plan.Summary())
+	return err
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"api/internal/calendar"
+	"api/internal/domain"
+)
+
+// statusCommand prints the state of ingestion.
+func (a *app) statusCommand() *cobra.Command {
+	var runs int
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the session mode, sync versions, recent job runs and data problems",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			special, err := calendar.ParseSpecialSessions(a.cfg.SpecialSessions)
+			if err != nil {
+				return err
+			}
+			return a.withStores(cmd, func(ctx context.Context, s *storage) error {
+				return printStatus(ctx, cmd.OutOrStdout(), s, calendar.New(special), runs)
+			})
+		},
+	}
+	cmd.Flags().IntVar(&runs, "runs", 10, "number of recent job runs to show")
+	return cmd
+}
+
+// printStatus writes the status report to w.
+func printStatus(ctx context.Context, w io.Writer, s *storage, sessions *calendar.Calendar, runs int) error {
+	now := time.Now()
+	mode := sessions.Mode(now)
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Mode:\t%s (bills polled every %s)\n", mode, calendar.PollInterval(mode))
+	fmt.Fprintf(tw, "Latest session:\t%s\n", describeSession(sessions.Latest(now)))
+	fmt.Fprintf(tw, "Next session:\t%s\n", describeSession(sessions.Next(now)))
+
+	for _, entity := range []string{domain.EntityLegislators, domain.EntityBills} {
+		v, err := s.stores.Sync.GetSyncVersion(ctx, entity)
+		if err != nil {
+			return fmt.Errorf("get %s sync version: %w", entity, err)
+		}
+		synced := "never"
+		if v.Version > 0 {
+			synced = fmt.Sprintf("version %d at %s", v.Version, v.SyncedAt.Format(time.RFC3339))
+		}
+		fmt.Fprintf(tw, "Last %s sync:\t%s\n", entity, synced)
+	}
+
+	for _, kind := range []string{domain.DeadLetterLegislator, domain.DeadLetterBill} {
+		letters, err := s.stores.DeadLetters.ListDeadLetters(ctx, kind)
+		if err != nil {
+			return fmt.Errorf("list dead letters: %w", err)
+		}
+		fmt.Fprintf(tw, "Dead-lettered %ss:\t%d\n", kind, len(letters))
+	}
+
+	unresolved, err := s.stores.Bills.UnresolvedSponsors(ctx)
+	if err != nil {
+		return fmt.Errorf("list unresolved sponsors: %w", err)
+	}
+	bills := 0
+	for _, u := range unresolved {
+		bills += u.Bills
+	}
+	fmt.Fprintf(tw, "Unresolved sponsors:\t%d (%d bills)\n", len(unresolved), bills)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if s.runs == nil || runs <= 0 {
+		return nil
+	}
+	recent, err := s.runs.ListJobRuns(ctx, "", runs)
+	if err != nil {
+		return fmt.Errorf("list job runs: %w", err)
+	}
+	fmt.Fprintln(w, "\nRecent job runs:")
+	tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "JOB\tTRIGGER\tSTATUS\tSTARTED\tUPSERTED\tFAILED\tERROR")
+	for _, r := range recent {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%d\t%s\n",
+			r.Job, r.Trigger, r.Status, r.StartedAt.Format(time.RFC3339), r.Upserted, r.Failed, r.Error)
+	}
+	return tw.Flush()
+}
+
+// describeSession formats a session with its dates.
+func describeSession(s calendar.Session) string {
+	return fmt.Sprintf("%s (%s to %s)", s.Name, s.Start.Format(time.DateOnly), s.End.Format(time.DateOnly))
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	pocketbaseSDK "github.com/pocketbase/pocketbase"
+
+	"api/internal/config"
+	"api/internal/domain"
+	"api/internal/ingest"
+	"api/internal/notify"
+	"api/internal/repository"
+	"api/internal/repository/events"
+	pbrepo "api/internal/repository/pocketbase"
+	"api/internal/repository/postgres"
+	"api/internal/repository/tracing"
+	"api/internal/webhook"
+)
+
+// storage is an open database.
+type storage struct {
+	stores ingest.Stores
+	runs   repository.JobRunRepository // nil under --dry-run
+	close  func()
+}
+
+// openStorage opens Postgres if a database URL is configured, and the
+// PocketBase data directory otherwise. Bill and legislator changes are
+// queued as notifications and webhook deliveries for the API server to
+// send. Calls to the stores are traced.
+func openStorage(ctx context.Context, cfg config.Store, logger *slog.Logger) (*storage, error) {
+	if cfg.DatabaseURL != "" {
+		pool, err := postgres.Connect(ctx, cfg.DatabaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("connect to postgres: %w", err)
+		}
+		db := tracing.SystemPostgres
+		sink := events.Sinks{
+			notify.NewNotifier(postgres.NewNotificationRepository(pool), postgres.NewWatchlistRepository(pool), logger),
+			webhook.NewPublisher(postgres.NewWebhookRepository(pool), logger),
+		}
+		return &storage{
+			stores: ingest.Stores{
+				Bills:       events.NewBillRepository(tracing.NewBillRepository(postgres.NewBillRepository(pool), db), sink, logger),
+				Legislators: events.NewLegislatorRepository(tracing.NewLegislatorRepository(postgres.NewLegislatorRepository(pool), db), sink, logger),
+				Stats:       tracing.NewStatsRepository(postgres.NewStatsRepository(pool), db),
+				Sync:        tracing.NewSyncRepository(postgres.NewSyncRepository(pool), db),
+				DeadLetters: tracing.NewDeadLetterRepository(postgres.NewDeadLetterRepository(pool), db),
+			},
+			runs:  tracing.NewJobRunRepository(postgres.NewJobRunRepository(pool), db),
+			close: pool.Close,
+		}, nil
+	}
+
+	app := pocketbaseSDK.NewWithConfig(pocketbaseSDK.Config{
+		DefaultDataDir: cfg.PocketBaseDataDir,
+	})
+	if err := app.Bootstrap(); err != nil {
+		return nil, fmt.Errorf("bootstrap pocketbase: %w", err)
+	}
+	db := tracing.SystemPocketBase
+	sink := events.Sinks{
+		notify.NewNotifier(pbrepo.NewNotificationRepository(app), pbrepo.NewWatchlistRepository(app), logger),
+		webhook.NewPublisher(pbrepo.NewWebhookRepository(app), logger),
+	}
+	return &storage{
+		stores: ingest.Stores{
+			Bills:       events.NewBillRepository(tracing.NewBillRepository(pbrepo.NewBillRepository(app), db), sink, logger),
+			Legislators: events.NewLegislatorRepository(tracing.NewLegislatorRepository(pbrepo.NewLegislatorRepository(app), db), sink, logger),
+			Stats:       tracing.NewStatsRepository(pbrepo.NewStatsRepository(app), db),
+			Sync:        tracing.NewSyncRepository(pbrepo.NewSyncRepository(app), db),
+			DeadLetters: tracing.NewDeadLetterRepository(pbrepo.NewDeadLetterRepository(app), db),
+		},
+		runs:  tracing.NewJobRunRepository(pbrepo.NewJobRunRepository(app), db),
+		close: func() { app.ResetBootstrapState() },
+	}, nil
+}
+
+// saveRun records a job run so it shows up alongside the scheduler's runs.
+// Failing to is not fatal.
+func (s *storage) saveRun(ctx context.Context, run *domain.JobRun, logger *slog.Logger) {
+	if s.runs == nil {
+		return
+	}
+	if err := s.runs.SaveJobRun(ctx, run); err != nil {
+		logger.Warn("failed to record job run", "error", err)
+	}
+}
@@ -0,0 +1,206 @@
+// Package merge combines records for the same legislator or bill arriving
+// from several upstream sources.
+//
+// Each stored field remembers which source supplied it (domain.Provenance).
+// When a new record arrives, a field is only replaced if the incoming source
+// ranks at least as high as the field's current source in the priority
+// Rules. This stops a lower-quality feed from clobbering authoritative values
+// while still letting it fill gaps.
+package merge
+
+import (
+	"slices"
+	"time"
+
+	"api/internal/domain"
+)
+
+// Rules maps a field name to its sources in priority order, highest first.
+// The "*" entry applies to fields without their own rule. Sources missing
+// from a field's list rank below every listed source.
+type Rules map[string][]string
+
+// defaultRule is the key for the fallback priority list.
+const defaultRule = "*"
+
+// DefaultLegislatorRules trusts the official Utah API for identity and
+// contact fields, and OpenStates for the fields only it publishes.
+var DefaultLegislatorRules = Rules{
+	defaultRule:     {domain.SourceUtahLegislature, domain.SourceOpenStates, domain.SourceLegiScan},
+	"offices":       {domain.SourceOpenStates, domain.SourceUtahLegislature},
+	"twitter":       {domain.SourceOpenStates},
+	"facebook":      {domain.SourceOpenStates},
+	"instagram":     {domain.SourceOpenStates},
+	"youtube":       {domain.SourceOpenStates},
+	"openstates_id": {domain.SourceOpenStates},
+	"legiscan_id":   {domain.SourceLegiScan},
+}
+
+// DefaultBillRules trusts the official Utah API over LegiScan.
+var DefaultBillRules = Rules{
+	defaultRule:   {domain.SourceUtahLegislature, domain.SourceLegiScan},
+	"legiscan_id": {domain.SourceLegiScan},
+}
+
+// ConflictingIDs reports whether a and b carry different IDs from the same
+// upstream source, which proves they are different people.
+func ConflictingIDs(a, b domain.Legislator) bool {
+	differ := func(x, y string) bool { return x != "" && y != "" && x != y }
+	return differ(a.UtahLegislatureID, b.UtahLegislatureID) ||
+		differ(a.OpenStatesID, b.OpenStatesID) ||
+		(a.LegiscanID != 0 && b.LegiscanID != 0 && a.LegiscanID != b.LegiscanID)
+}
+
+// rank returns the priority of source for field; lower is stronger.
+func (r Rules) rank(field, source string) int {
+	order, ok := r[field]
+	if !ok {
+		order = r[defaultRule]
+	}
+	if i := slices.Index(order, source); i >= 0 {
+		return i
+	}
+	return len(order)
+}
+
+// Legislator merges incoming into existing and returns the result with its
+// updated provenance. existing may be nil for a brand-new record.
+func Legislator(existing *domain.Legislator, incoming domain.Legislator, rules Rules, now time.Time) domain.Legislator {
+	if existing == nil {
+		existing = &domain.Legislator{}
+	}
+	out := *existing
+	out.Source = incoming.Source
+	out.Provenance = apply(legislatorFields(&out, &incoming), existing.Provenance, incoming.Source, rules, now)
+	return out
+}
+
+// Bill merges incoming into existing and returns the result with its updated
+// provenance. existing may be nil for a brand-new record.
+func Bill(existing *domain.Bill, incoming domain.Bill, rules Rules, now time.Time) domain.Bill {
+	if existing == nil {
+		existing = &domain.Bill{}
+	}
+	out := *existing
+	out.Source = incoming.Source
+	out.Provenance = apply(billFields(&out, &incoming), existing.Provenance, incoming.Source, rules, now)
+	return out
+}
+
+// field pairs a stored value with the incoming value for the same field.
+type field struct {
+	name  string
+	empty func() bool // reports whether the incoming value is empty
+	take  func()      // copies the incoming value into the stored one
+	clear func()      // resets the stored value; nil if the field is never cleared
+}
+
+// apply walks fields and takes each incoming value that wins under rules.
+//
+// An empty incoming value never overrides another source, but it does clear
+// a field the same source supplied previously, so upstream deletions
+// propagate.
+func apply(fields []field, prev domain.Provenance, source string, rules Rules, now time.Time) domain.Provenance {
+	prov := make(domain.Provenance, len(fields))
+	for k, v := range prev {
+		prov[k] = v
+	}
+
+	for _, f := range fields {
+		cur, known := prov[f.name]
+		if f.empty() {
+			if known && cur.Source == source && f.clear != nil {
+				f.clear()
+				delete(prov, f.name)
+			}
+			continue
+		}
+		// Fields without provenance predate the merge layer; any source wins.
+		if known && rules.rank(f.name, source) > rules.rank(f.name, cur.Source) {
+			continue
+		}
+		f.take()
+		prov[f.name] = domain.FieldSource{Source: source, UpdatedAt: now}
+	}
+	return prov
+}
+
+func str(name string, dst, src *string) field {
+	return field{
+		name:  name,
+		empty: func() bool { return *src == "" },
+		take:  func() { *dst = *src },
+		clear: func() { *dst = "" },
+	}
+}
+
+func num(name string, dst, src *int) field {
+	return field{
+		name:  name,
+		empty: func() bool { return *src == 0 },
+		take:  func() { *dst = *src },
+		clear: func() { *dst = 0 },
+	}
+}
+
+func date(name string, dst, src **time.Time) field {
+	return field{
+		name:  name,
+		empty: func() bool { return *src == nil },
+		take:  func() { *dst = *src },
+		clear: func() { *dst = nil },
+	}
+}
+
+func legislatorFields(dst, src *domain.Legislator) []field {
+	return []field{
+		str("chamber", &dst.Chamber, &src.Chamber),
+		num("district_number", &dst.DistrictNumber, &src.DistrictNumber),
+		str("first_name", &dst.FirstName, &src.FirstName),
+		str("last_name", &dst.LastName, &src.LastName),
+		str("party", &dst.Party, &src.Party),
+		str("email", &dst.Email, &src.Email),
+		str("phone", &dst.Phone, &src.Phone),
+		str("website", &dst.Website, &src.Website),
+		str("image_url", &dst.ImageURL, &src.ImageURL),
+		{
+			name:  "offices",
+			empty: func() bool { return len(src.Offices) == 0 },
+			take:  func() { dst.Offices = src.Offices },
+			clear: func() { dst.Offices = nil },
+		},
+		str("twitter", &dst.Twitter, &src.Twitter),
+		str("facebook", &dst.Facebook, &src.Facebook),
+		str("instagram", &dst.Instagram, &src.Instagram),
+		str("youtube", &dst.YouTube, &src.YouTube),
+		str("utah_legislature_id", &dst.UtahLegislatureID, &src.UtahLegislatureID),
+		num("legiscan_id", &dst.LegiscanID, &src.LegiscanID),
+		str("openstates_id", &dst.OpenStatesID, &src.OpenStatesID),
+	}
+}
+
+func billFields(dst, src *domain.Bill) []field {
+	return []field{
+		str("bill_number", &dst.BillNumber, &src.BillNumber),
+		str("bill_type", &dst.BillType, &src.BillType),
+		num("session_year", &dst.SessionYear, &src.SessionYear),
+		str("title", &dst.Title, &src.Title),
+		str("description", &dst.Description, &src.Description),
+		str("status", &dst.Status, &src.Status),
+		// An empty sponsor means "not resolved yet", not "no sponsor", so a
+		// resolved link is never cleared by a later unresolved sync.
+		{
+			name:  "sponsor",
+			empty: func() bool { return src.SponsorID == "" },
+			take:  func() { dst.SponsorID = src.SponsorID },
+		},
+		str("sponsor_utah_id", &dst.SponsorUtahID, &src.SponsorUtahID),
+		str("full_text_url", &dst.FullTextURL, &src.FullTextURL),
+		str("last_action", &dst.LastAction, &src.LastAction),
+		date("last_action_date", &dst.LastActionDate, &src.LastActionDate),
+		str("fiscal_note_url", &dst.FiscalNoteURL, &src.FiscalNoteURL),
+		date("effective_date", &dst.EffectiveDate, &src.EffectiveDate),
+		str("utah_legislature_id", &dst.UtahLegislatureID, &src.UtahLegislatureID),
+		num("legiscan_id", &dst.LegiscanID, &src.LegiscanID),
+	}
+}
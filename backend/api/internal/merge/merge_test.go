@@ -0,0 +1,238 @@
+package merge
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"api/internal/domain"
+)
+
+var (
+	earlier = time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+	now     = time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+)
+
+const (
+	utah       = domain.SourceUtahLegislature
+	openStates = domain.SourceOpenStates
+	legiScan   = domain.SourceLegiScan
+)
+
+// stored returns a legislator whose fields were supplied by the given
+// sources at earlier.
+func stored(l domain.Legislator, sources map[string]string) *domain.Legislator {
+	l.Provenance = domain.Provenance{}
+	for f, s := range sources {
+		l.Provenance[f] = domain.FieldSource{Source: s, UpdatedAt: earlier}
+	}
+	return &l
+}
+
+func TestLegislator(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		existing *domain.Legislator
+		incoming domain.Legislator
+		want     domain.Legislator
+		// wantSources is the provenance source of each field afterwards, ""
+		// for none; fields not listed are not checked.
+		wantSources map[string]string
+		// updated lists the fields whose provenance is stamped now.
+		updated []string
+	}{
+		{
+			name:        "new record",
+			incoming:    domain.Legislator{Source: openStates, LastName: "Smith", Party: "R"},
+			want:        domain.Legislator{LastName: "Smith", Party: "R"},
+			wantSources: map[string]string{"last_name": openStates, "party": openStates, "email": ""},
+			updated:     []string{"last_name", "party"},
+		},
+		{
+			name:        "higher priority source overwrites",
+			existing:    stored(domain.Legislator{Email: "old@example.com"}, map[string]string{"email": openStates}),
+			incoming:    domain.Legislator{Source: utah, Email: "new@example.com"},
+			want:        domain.Legislator{Email: "new@example.com"},
+			wantSources: map[string]string{"email": utah},
+			updated:     []string{"email"},
+		},
+		{
+			name:        "same source overwrites",
+			existing:    stored(domain.Legislator{Phone: "1"}, map[string]string{"phone": openStates}),
+			incoming:    domain.Legislator{Source: openStates, Phone: "2"},
+			want:        domain.Legislator{Phone: "2"},
+			wantSources: map[string]string{"phone": openStates},
+			updated:     []string{"phone"},
+		},
+		{
+			name:        "lower priority source is ignored",
+			existing:    stored(domain.Legislator{Email: "utah@example.com"}, map[string]string{"email": utah}),
+			incoming:    domain.Legislator{Source: openStates, Email: "os@example.com"},
+			want:        domain.Legislator{Email: "utah@example.com"},
+			wantSources: map[string]string{"email": utah},
+		},
+		{
+			name:        "lower priority source fills gaps",
+			existing:    stored(domain.Legislator{Email: "utah@example.com"}, map[string]string{"email": utah}),
+			incoming:    domain.Legislator{Source: openStates, Email: "os@example.com", Phone: "555"},
+			want:        domain.Legislator{Email: "utah@example.com", Phone: "555"},
+			wantSources: map[string]string{"email": utah, "phone": openStates},
+			updated:     []string{"phone"},
+		},
+		{
+			name:        "field rule overrides the default order",
+			existing:    stored(domain.Legislator{Twitter: "@utah"}, map[string]string{"twitter": utah}),
+			incoming:    domain.Legislator{Source: openStates, Twitter: "@os"},
+			want:        domain.Legislator{Twitter: "@os"},
+			wantSources: map[string]string{"twitter": openStates},
+			updated:     []string{"twitter"},
+		},
+		{
+			name:        "unlisted source ranks below listed ones",
+			existing:    stored(domain.Legislator{Twitter: "@os"}, map[string]string{"twitter": openStates}),
+			incoming:    domain.Legislator{Source: utah, Twitter: "@utah"},
+			want:        domain.Legislator{Twitter: "@os"},
+			wantSources: map[string]string{"twitter": openStates},
+		},
+		{
+			name:        "field without provenance is taken by any source",
+			existing:    stored(domain.Legislator{Party: "D"}, nil),
+			incoming:    domain.Legislator{Source: legiScan, Party: "R"},
+			want:        domain.Legislator{Party: "R"},
+			wantSources: map[string]string{"party": legiScan},
+			updated:     []string{"party"},
+		},
+		{
+			name:        "empty clears a field the same source supplied",
+			existing:    stored(domain.Legislator{Email: "a@example.com", Offices: []domain.Office{{Address: "Capitol"}}}, map[string]string{"email": utah, "offices": utah}),
+			incoming:    domain.Legislator{Source: utah},
+			want:        domain.Legislator{},
+			wantSources: map[string]string{"email": "", "offices": ""},
+		},
+		{
+			name:        "empty leaves another source's field",
+			existing:    stored(domain.Legislator{Email: "os@example.com", DistrictNumber: 4}, map[string]string{"email": openStates, "district_number": openStates}),
+			incoming:    domain.Legislator{Source: utah},
+			want:        domain.Legislator{Email: "os@example.com", DistrictNumber: 4},
+			wantSources: map[string]string{"email": openStates, "district_number": openStates},
+		},
+		{
+			name:        "empty leaves a field without provenance",
+			existing:    stored(domain.Legislator{Email: "old@example.com"}, nil),
+			incoming:    domain.Legislator{Source: utah},
+			want:        domain.Legislator{Email: "old@example.com"},
+			wantSources: map[string]string{"email": ""},
+		},
+	} {
+		got := Legislator(tc.existing, tc.incoming, DefaultLegislatorRules, now)
+		if got.Source != tc.incoming.Source {
+			t.Errorf("%s: Source = %q, want %q", tc.name, got.Source, tc.incoming.Source)
+		}
+		prov := got.Provenance
+		got.Source, got.Provenance = "", nil
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: merged = %+v, want %+v", tc.name, got, tc.want)
+		}
+		for f, want := range tc.wantSources {
+			if src := prov[f].Source; src != want {
+				t.Errorf("%s: %s source = %q, want %q", tc.name, f, src, want)
+			}
+		}
+		for f, fs := range prov {
+			stamped := fs.UpdatedAt.Equal(now)
+			if want := slices.Contains(tc.updated, f); stamped != want {
+				t.Errorf("%s: %s UpdatedAt = %v, want updated %v", tc.name, f, fs.UpdatedAt, want)
+			}
+		}
+	}
+}
+
+func TestLegislatorKeepsExistingProvenance(t *testing.T) {
+	existing := stored(domain.Legislator{Email: "a@example.com"}, map[string]string{"email": utah})
+	Legislator(existing, domain.Legislator{Source: openStates, Phone: "555"}, DefaultLegislatorRules, now)
+	if len(existing.Provenance) != 1 {
+		t.Errorf("merging modified the existing provenance: %+v", existing.Provenance)
+	}
+}
+
+func TestBill(t *testing.T) {
+	date := func(day int) *time.Time {
+		d := time.Date(2026, time.February, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	storedBill := func(b domain.Bill, sources map[string]string) *domain.Bill {
+		b.Provenance = domain.Provenance{}
+		for f, s := range sources {
+			b.Provenance[f] = domain.FieldSource{Source: s, UpdatedAt: earlier}
+		}
+		return &b
+	}
+
+	for _, tc := range []struct {
+		name        string
+		existing    *domain.Bill
+		incoming    domain.Bill
+		check       func(domain.Bill) bool
+		wantSources map[string]string
+	}{
+		{
+			name:     "LegiScan fills gaps but doesn't override Utah",
+			existing: storedBill(domain.Bill{Status: "introduced"}, map[string]string{"status": utah}),
+			incoming: domain.Bill{Source: legiScan, Status: "passed", LegiscanID: 12, LastActionDate: date(3)},
+			check: func(b domain.Bill) bool {
+				return b.Status == "introduced" && b.LegiscanID == 12 && b.LastActionDate.Equal(*date(3))
+			},
+			wantSources: map[string]string{"status": utah, "legiscan_id": legiScan, "last_action_date": legiScan},
+		},
+		{
+			name:        "dates clear when the same source drops them",
+			existing:    storedBill(domain.Bill{EffectiveDate: date(1)}, map[string]string{"effective_date": utah}),
+			incoming:    domain.Bill{Source: utah},
+			check:       func(b domain.Bill) bool { return b.EffectiveDate == nil },
+			wantSources: map[string]string{"effective_date": ""},
+		},
+		{
+			name:        "sponsor is never cleared",
+			existing:    storedBill(domain.Bill{SponsorID: "leg1", SponsorUtahID: "SMITHJ"}, map[string]string{"sponsor": utah, "sponsor_utah_id": utah}),
+			incoming:    domain.Bill{Source: utah, SponsorUtahID: "SMITHJ"},
+			check:       func(b domain.Bill) bool { return b.SponsorID == "leg1" && b.SponsorUtahID == "SMITHJ" },
+			wantSources: map[string]string{"sponsor": utah},
+		},
+		{
+			name:        "sponsor is replaced when resolved",
+			existing:    storedBill(domain.Bill{SponsorID: "leg1"}, map[string]string{"sponsor": utah}),
+			incoming:    domain.Bill{Source: utah, SponsorID: "leg2"},
+			check:       func(b domain.Bill) bool { return b.SponsorID == "leg2" },
+			wantSources: map[string]string{"sponsor": utah},
+		},
+	} {
+		got := Bill(tc.existing, tc.incoming, DefaultBillRules, now)
+		if !tc.check(got) {
+			t.Errorf("%s: merged = %+v", tc.name, got)
+		}
+		for f, want := range tc.wantSources {
+			if src := got.Provenance[f].Source; src != want {
+				t.Errorf("%s: %s source = %q, want %q", tc.name, f, src, want)
+			}
+		}
+	}
+}
+
+func TestConflictingIDs(t *testing.T) {
+	for _, tc := range []struct {
+		a, b domain.Legislator
+		want bool
+	}{
+		{domain.Legislator{UtahLegislatureID: "A"}, domain.Legislator{UtahLegislatureID: "A"}, false},
+		{domain.Legislator{UtahLegislatureID: "A"}, domain.Legislator{UtahLegislatureID: "B"}, true},
+		{domain.Legislator{UtahLegislatureID: "A"}, domain.Legislator{OpenStatesID: "ocd-person/1"}, false},
+		{domain.Legislator{OpenStatesID: "ocd-person/1"}, domain.Legislator{OpenStatesID: "ocd-person/2"}, true},
+		{domain.Legislator{LegiscanID: 1}, domain.Legislator{LegiscanID: 2}, true},
+		{domain.Legislator{LegiscanID: 1}, domain.Legislator{}, false},
+	} {
+		if got := ConflictingIDs(tc.a, tc.b); got != tc.want {
+			t.Errorf("ConflictingIDs(%+v, %+v) = %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
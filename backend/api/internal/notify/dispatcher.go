@@ -0,0 +1,260 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"api/internal/calendar"
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// maxPendingAge is how long a notification may go undelivered after it
+// became deliverable (its user's digest came due and quiet hours ended)
+// before it is dropped as stale.
+const maxPendingAge = 24 * time.Hour
+
+// Dispatcher delivers the notifications in the outbox.
+type Dispatcher struct {
+	repo     repository.NotificationRepository
+	channels map[string]Channel // by domain.Channel constant
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewDispatcher creates a Dispatcher that delivers through channels, keyed
+// by domain.ChannelEmail, ChannelWebPush and ChannelFCM. Destinations on a
+// channel that isn't configured are skipped.
+func NewDispatcher(repo repository.NotificationRepository, channels map[string]Channel, logger *slog.Logger) *Dispatcher {
+	return &Dispatcher{repo: repo, channels: channels, logger: logger, now: time.Now}
+}
+
+// Dispatch sends every pending notification that is due and returns how
+// many it took out of the outbox.
+//
+// For each user: notifications are dropped if the user has disabled them,
+// held during their quiet hours, and, if they asked for a digest, held
+// until the interval since their last one has passed and then sent as one
+// message. A notification stays queued for the next run if every attempt
+// to deliver it failed.
+func (d *Dispatcher) Dispatch(ctx context.Context) (int, error) {
+	pending, err := d.repo.PendingNotifications(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("pending notifications: %w", err)
+	}
+
+	var users []string
+	byUser := map[string][]domain.Notification{}
+	for _, n := range pending {
+		if _, ok := byUser[n.UserID]; !ok {
+			users = append(users, n.UserID)
+		}
+		byUser[n.UserID] = append(byUser[n.UserID], n)
+	}
+
+	done := 0
+	for _, user := range users {
+		if ctx.Err() != nil {
+			return done, ctx.Err()
+		}
+		n, err := d.dispatchUser(ctx, user, byUser[user])
+		done += n
+		if err != nil {
+			d.logger.Error("failed to dispatch notifications", "user", user, "error", err)
+		}
+	}
+	return done, nil
+}
+
+// dispatchUser delivers one user's pending notifications, oldest first.
+func (d *Dispatcher) dispatchUser(ctx context.Context, user string, ns []domain.Notification) (int, error) {
+	now := d.now().UTC()
+	settings, err := d.repo.GetNotificationSettings(ctx, user)
+	if err != nil {
+		return 0, fmt.Errorf("get settings: %w", err)
+	}
+
+	// Drop what the user doesn't want or what is too old to be news.
+	var drop, due []domain.Notification
+	for _, n := range ns {
+		if !settings.Enabled || now.Sub(deliverableAt(settings, n)) > maxPendingAge {
+			drop = append(drop, n)
+		} else {
+			due = append(due, n)
+		}
+	}
+	if err := d.markSent(ctx, drop, now); err != nil {
+		return 0, err
+	}
+	done := len(drop)
+	if len(due) == 0 || quiet(settings, now) {
+		return done, nil
+	}
+	digest := settings.DigestInterval > 0
+	if digest && settings.LastDigestAt != nil && now.Sub(*settings.LastDigestAt) < settings.DigestInterval {
+		return done, nil
+	}
+
+	dests, err := d.destinations(ctx, settings)
+	if err != nil {
+		return done, err
+	}
+	batches := [][]domain.Notification{}
+	if digest {
+		batches = append(batches, due)
+	} else {
+		for _, n := range due {
+			batches = append(batches, []domain.Notification{n})
+		}
+	}
+
+	for _, batch := range batches {
+		msg, channels := compose(batch)
+		if !d.send(ctx, dests, channels, msg) {
+			continue // retried next run
+		}
+		if err := d.markSent(ctx, batch, now); err != nil {
+			return done, err
+		}
+		done += len(batch)
+	}
+
+	if digest {
+		settings.LastDigestAt = &now
+		if err := d.repo.SaveNotificationSettings(ctx, settings); err != nil {
+			return done, fmt.Errorf("record digest: %w", err)
+		}
+	}
+	return done, nil
+}
+
+// destinations returns where a user's notifications can go: their email
+// address and registered devices.
+func (d *Dispatcher) destinations(ctx context.Context, s domain.NotificationSettings) ([]domain.Device, error) {
+	devices, err := d.repo.ListDevices(ctx, s.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("list devices: %w", err)
+	}
+	if s.Email != "" {
+		devices = append([]domain.Device{{UserID: s.UserID, Channel: domain.ChannelEmail, Token: s.Email}}, devices...)
+	}
+	return devices, nil
+}
+
+// send delivers msg to each destination on an allowed, configured channel.
+// It reports false only if there was a destination and every attempt
+// failed; with nowhere to send, the message is done with.
+func (d *Dispatcher) send(ctx context.Context, dests []domain.Device, channels []string, msg Message) bool {
+	attempted, delivered := 0, 0
+	for _, dest := range dests {
+		ch, ok := d.channels[dest.Channel]
+		if !ok || len(channels) > 0 && !slices.Contains(channels, dest.Channel) {
+			continue
+		}
+		attempted++
+		err := ch.Send(ctx, dest, msg)
+		switch {
+		case err == nil:
+			delivered++
+		case errors.Is(err, ErrGone):
+			d.logger.Info("unregistering gone device", "user", dest.UserID, "channel", dest.Channel)
+			if err := d.repo.DeleteDevice(ctx, dest.UserID, dest.Channel, dest.Token); err != nil {
+				d.logger.Warn("failed to unregister device", "user", dest.UserID, "error", err)
+			}
+		default:
+			d.logger.Warn("failed to send notification", "user", dest.UserID, "channel", dest.Channel, "error", err)
+		}
+	}
+	return attempted == 0 || delivered > 0
+}
+
+func (d *Dispatcher) markSent(ctx context.Context, ns []domain.Notification, at time.Time) error {
+	if len(ns) == 0 {
+		return nil
+	}
+	ids := make([]string, len(ns))
+	for i, n := range ns {
+		ids[i] = n.ID
+	}
+	if err := d.repo.MarkNotificationsSent(ctx, ids, at); err != nil {
+		return fmt.Errorf("mark sent: %w", err)
+	}
+	return nil
+}
+
+// compose returns the message for a batch of notifications and the
+// channels it may go to. A batch of several is a digest listing each.
+func compose(batch []domain.Notification) (Message, []string) {
+	channels := batch[0].Channels
+	for _, n := range batch[1:] {
+		channels = unionChannels(channels, n.Channels)
+	}
+	if len(batch) == 1 {
+		return Message{Title: batch[0].Title, Body: batch[0].Body}, channels
+	}
+	var body strings.Builder
+	for _, n := range batch {
+		fmt.Fprintf(&body, "%s\n%s\n\n", n.Title, n.Body)
+	}
+	return Message{
+		Title: fmt.Sprintf("%d bill updates", len(batch)),
+		Body:  strings.TrimSpace(body.String()),
+	}, channels
+}
+
+// deliverableAt returns when n could first have been sent: once it was
+// created, the user's next digest was due and their quiet hours were over.
+// Staleness is measured from then, so a weekly digest keeps a week of
+// updates.
+func deliverableAt(s domain.NotificationSettings, n domain.Notification) time.Time {
+	at := n.CreatedAt
+	if s.DigestInterval > 0 && s.LastDigestAt != nil {
+		if due := s.LastDigestAt.Add(s.DigestInterval); due.After(at) {
+			at = due
+		}
+	}
+	return quietEnd(s, at)
+}
+
+// quietEnd returns the end of the quiet hours t falls in, or t if it
+// falls outside them.
+func quietEnd(s domain.NotificationSettings, t time.Time) time.Time {
+	if !quiet(s, t) {
+		return t
+	}
+	local := t.In(location(s))
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location())
+	end := midnight.Add(time.Duration(s.QuietEnd) * time.Minute)
+	if !end.After(local) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return end
+}
+
+// quiet reports whether t falls in the user's quiet hours.
+func quiet(s domain.NotificationSettings, t time.Time) bool {
+	if s.QuietStart == s.QuietEnd {
+		return false
+	}
+	local := t.In(location(s))
+	m := local.Hour()*60 + local.Minute()
+	if s.QuietStart < s.QuietEnd {
+		return m >= s.QuietStart && m < s.QuietEnd
+	}
+	return m >= s.QuietStart || m < s.QuietEnd // spans midnight
+}
+
+// location returns the user's time zone, Mountain time if unset or unknown.
+func location(s domain.NotificationSettings) *time.Location {
+	if s.TimeZone != "" {
+		if l, err := time.LoadLocation(s.TimeZone); err == nil {
+			return l
+		}
+	}
+	return calendar.Mountain
+}
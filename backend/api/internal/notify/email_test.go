@@ -0,0 +1,113 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"mime"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"api/internal/domain"
+)
+
+// captured is a message received by the capture server.
+type captured struct {
+	from, to string
+	data     string
+}
+
+// captureSMTP runs a minimal SMTP server on localhost that accepts every
+// message and sends it on the returned channel.
+func captureSMTP(t *testing.T) (string, <-chan captured) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lis.Close() })
+
+	out := make(chan captured, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		reply := func(s string) { conn.Write([]byte(s + "\r\n")) }
+
+		var msg captured
+		reply("220 capture ready")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.TrimSpace(line)
+			switch upper := strings.ToUpper(cmd); {
+			case strings.HasPrefix(upper, "EHLO"), strings.HasPrefix(upper, "HELO"):
+				reply("250 capture")
+			case strings.HasPrefix(upper, "MAIL FROM:"):
+				msg.from = strings.Trim(cmd[len("MAIL FROM:"):], "<>")
+				reply("250 ok")
+			case strings.HasPrefix(upper, "RCPT TO:"):
+				msg.to = strings.Trim(cmd[len("RCPT TO:"):], "<>")
+				reply("250 ok")
+			case upper == "DATA":
+				reply("354 go ahead")
+				var data strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if l == ".\r\n" {
+						break
+					}
+					data.WriteString(l)
+				}
+				msg.data = data.String()
+				reply("250 queued")
+				out <- msg
+			case upper == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("502 not implemented")
+			}
+		}
+	}()
+	return lis.Addr().String(), out
+}
+
+func TestEmail(t *testing.T) {
+	addr, received := captureSMTP(t)
+	email, err := NewEmail(EmailConfig{Addr: addr, From: "Utah Bills <alerts@example.org>"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	to := domain.Device{Channel: domain.ChannelEmail, Token: "voter@example.com"}
+	msg := Message{Title: "HB0001: Café funding", Body: "Status changed\nfrom introduced to passed."}
+	if err := email.Send(context.Background(), to, msg); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	got := <-received
+	if got.from != "alerts@example.org" || got.to != "voter@example.com" {
+		t.Errorf("envelope from %q to %q", got.from, got.to)
+	}
+	m, err := mail.ReadMessage(strings.NewReader(got.data))
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	if err != nil || subject != msg.Title {
+		t.Errorf("Subject = %q, %v; want %q", subject, err, msg.Title)
+	}
+	if !strings.Contains(got.data, "Status changed\r\nfrom introduced to passed.") {
+		t.Errorf("body = %q", got.data)
+	}
+}
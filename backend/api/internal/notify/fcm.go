@@ -0,0 +1,126 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/jwt"
+
+	"api/internal/domain"
+)
+
+const (
+	fcmScope   = "https://www.googleapis.com/auth/firebase.messaging"
+	fcmBaseURL = "https://fcm.googleapis.com"
+)
+
+// FCMConfig configures the Firebase Cloud Messaging channel.
+type FCMConfig struct {
+	// Credentials is the JSON key of a Google service account allowed to
+	// send messages for the Firebase project.
+	Credentials []byte
+	BaseURL     string // defaults to the FCM API; set by tests
+}
+
+// FCM sends notifications to the mobile app through the FCM HTTP v1 API.
+type FCM struct {
+	client  *http.Client // adds OAuth tokens for the service account
+	sendURL string
+}
+
+// serviceAccount is the part of a service account key FCM needs.
+type serviceAccount struct {
+	ProjectID    string `json:"project_id"`
+	ClientEmail  string `json:"client_email"`
+	PrivateKey   string `json:"private_key"`
+	PrivateKeyID string `json:"private_key_id"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// NewFCM creates the FCM channel.
+func NewFCM(cfg FCMConfig) (*FCM, error) {
+	var sa serviceAccount
+	if err := json.Unmarshal(cfg.Credentials, &sa); err != nil {
+		return nil, fmt.Errorf("parse FCM credentials: %w", err)
+	}
+	if sa.ProjectID == "" || sa.ClientEmail == "" || sa.PrivateKey == "" {
+		return nil, fmt.Errorf("FCM credentials need project_id, client_email and private_key")
+	}
+	if sa.TokenURI == "" {
+		sa.TokenURI = "https://oauth2.googleapis.com/token"
+	}
+	base := cfg.BaseURL
+	if base == "" {
+		base = fcmBaseURL
+	}
+
+	jc := &jwt.Config{
+		Email:        sa.ClientEmail,
+		PrivateKey:   []byte(sa.PrivateKey),
+		PrivateKeyID: sa.PrivateKeyID,
+		Scopes:       []string{fcmScope},
+		TokenURL:     sa.TokenURI,
+	}
+	return &FCM{
+		client:  oauth2.NewClient(context.Background(), jc.TokenSource(context.Background())),
+		sendURL: strings.TrimSuffix(base, "/") + "/v1/projects/" + sa.ProjectID + "/messages:send",
+	}, nil
+}
+
+// fcmError is the error body of the FCM API.
+type fcmError struct {
+	Error struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+		Details []struct {
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+// Send delivers msg to the registration token in to. It returns ErrGone if
+// FCM reports the app was uninstalled or the token expired.
+func (f *FCM) Send(ctx context.Context, to domain.Device, msg Message) error {
+	body, err := json.Marshal(map[string]any{
+		"message": map[string]any{
+			"token":        to.Token,
+			"notification": map[string]string{"title": msg.Title, "body": msg.Body},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.sendURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("fcm: %w", err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 300 {
+		return nil
+	}
+
+	var e fcmError
+	_ = json.Unmarshal(data, &e)
+	for _, d := range e.Error.Details {
+		if d.ErrorCode == "UNREGISTERED" {
+			return ErrGone
+		}
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrGone
+	}
+	return fmt.Errorf("fcm returned %s: %s", resp.Status, e.Error.Message)
+}
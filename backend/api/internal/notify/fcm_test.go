@@ -0,0 +1,72 @@
+package notify
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"api/internal/domain"
+)
+
+func TestFCM(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, _ := x509.MarshalPKCS8PrivateKey(key)
+
+	var sent map[string]map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" || r.FormValue("assertion") == "" {
+			t.Errorf("token request = %v", r.Form)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "access", "token_type": "Bearer", "expires_in": 3600}`))
+	})
+	mux.HandleFunc("POST /v1/projects/utah-bills/messages:send", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access" {
+			t.Errorf("Authorization = %q", r.Header.Get("Authorization"))
+		}
+		sent = nil
+		json.NewDecoder(r.Body).Decode(&sent)
+		if sent["message"]["token"] == "uninstalled" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"status": "NOT_FOUND", "details": [{"errorCode": "UNREGISTERED"}]}}`))
+			return
+		}
+		w.Write([]byte(`{"name": "projects/utah-bills/messages/1"}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	creds, _ := json.Marshal(map[string]string{
+		"project_id":   "utah-bills",
+		"client_email": "fcm@utah-bills.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":    srv.URL + "/token",
+	})
+	fcm, err := NewFCM(FCMConfig{Credentials: creds, BaseURL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if err := fcm.Send(ctx, domain.Device{Token: "phone"}, Message{Title: "HB0001", Body: "passed"}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	notification, _ := sent["message"]["notification"].(map[string]any)
+	if sent["message"]["token"] != "phone" || notification["title"] != "HB0001" || notification["body"] != "passed" {
+		t.Errorf("sent %v", sent)
+	}
+	if err := fcm.Send(ctx, domain.Device{Token: "uninstalled"}, Message{Title: "HB0001"}); !errors.Is(err, ErrGone) {
+		t.Errorf("Send to uninstalled app = %v, want ErrGone", err)
+	}
+}
@@ -0,0 +1,181 @@
+// Package notify turns bill events into notifications for the users who
+// subscribed to them and delivers those notifications by email, web push
+// and Firebase Cloud Messaging.
+//
+// Delivery is in two steps so a slow or failing channel never holds up
+// ingestion: the Notifier, a sink for the events repositories, matches
+// events to subscriptions and queues notifications in the outbox, and the
+// Dispatcher, run every minute, sends what is due under each user's quiet
+// hours and digest settings.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// ErrGone is returned by a Channel when the destination no longer exists,
+// such as an expired push subscription. The device is then unregistered.
+var ErrGone = errors.New("destination gone")
+
+// Message is what a Channel delivers.
+type Message struct {
+	Title string
+	Body  string
+}
+
+// Channel delivers messages to one kind of destination. Email destinations
+// are passed as a Device whose Token is the address.
+type Channel interface {
+	Send(ctx context.Context, to domain.Device, msg Message) error
+}
+
+// Notifier matches events against the stored subscriptions and queues a
+// notification for each user with a match.
+type Notifier struct {
+	repo    repository.NotificationRepository
+	follows repository.WatchlistRepository
+	logger  *slog.Logger
+}
+
+// NewNotifier creates a Notifier. follows resolves subscriptions to
+// followed bills; it may be nil if there are no watchlists.
+func NewNotifier(repo repository.NotificationRepository, follows repository.WatchlistRepository, logger *slog.Logger) *Notifier {
+	return &Notifier{repo: repo, follows: follows, logger: logger}
+}
+
+// Publish queues notifications for events. A user with several matching
+// subscriptions gets one notification per event, on every channel any of
+// them asked for.
+func (n *Notifier) Publish(ctx context.Context, events []domain.Event) error {
+	subs, err := n.repo.ListSubscriptions(ctx, "")
+	if err != nil {
+		return fmt.Errorf("list subscriptions: %w", err)
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	follows := map[string][]domain.Follow{} // by user, loaded on first use
+	var out []domain.Notification
+	for _, e := range events {
+		// Notifications cover status changes and actions; other events
+		// are for webhooks.
+		if e.Bill == nil || e.Type != domain.EventBillStatusChanged && e.Type != domain.EventBillAction {
+			continue
+		}
+		byUser := map[string]int{} // index into out
+		for _, s := range subs {
+			if !n.matches(ctx, s, e, follows) {
+				continue
+			}
+			if i, ok := byUser[s.UserID]; ok {
+				out[i].Channels = unionChannels(out[i].Channels, s.Channels)
+				continue
+			}
+			byUser[s.UserID] = len(out)
+			title, body := describe(e)
+			out = append(out, domain.Notification{
+				UserID:    s.UserID,
+				EventType: e.Type,
+				BillID:    e.Bill.ID,
+				Title:     title,
+				Body:      body,
+				Channels:  slices.Clone(s.Channels),
+			})
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	if err := n.repo.EnqueueNotifications(ctx, out); err != nil {
+		return fmt.Errorf("enqueue notifications: %w", err)
+	}
+	n.logger.Info("queued notifications", "events", len(events), "notifications", len(out))
+	return nil
+}
+
+// matches reports whether s asks to be notified of e. follows caches the
+// users' watchlists.
+func (n *Notifier) matches(ctx context.Context, s domain.NotificationSubscription, e domain.Event, follows map[string][]domain.Follow) bool {
+	if len(s.EventTypes) > 0 && !slices.Contains(s.EventTypes, e.Type) {
+		return false
+	}
+	b := e.Bill
+	switch {
+	case s.BillID == "" && s.SponsorID == "" && !s.Followed:
+		return true
+	case s.BillID != "" && s.BillID == b.ID:
+		return true
+	case s.SponsorID != "" && s.SponsorID == b.SponsorID:
+		return true
+	case s.Followed:
+		return n.followed(ctx, s.UserID, b, follows)
+	}
+	return false
+}
+
+// followed reports whether a user follows b or its sponsor.
+func (n *Notifier) followed(ctx context.Context, userID string, b *domain.Bill, cache map[string][]domain.Follow) bool {
+	if n.follows == nil {
+		return false
+	}
+	follows, ok := cache[userID]
+	if !ok {
+		var err error
+		if follows, err = n.follows.ListFollows(ctx, userID, ""); err != nil {
+			n.logger.Warn("failed to load follows", "user", userID, "error", err)
+		}
+		cache[userID] = follows
+	}
+	for _, f := range follows {
+		if f.Kind == domain.FollowBill && f.TargetID == b.ID ||
+			f.Kind == domain.FollowLegislator && b.SponsorID != "" && f.TargetID == b.SponsorID {
+			return true
+		}
+	}
+	return false
+}
+
+// unionChannels merges the channels of two subscriptions, where empty means
+// every channel.
+func unionChannels(a, b []string) []string {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	out := slices.Clone(a)
+	for _, c := range b {
+		if !slices.Contains(out, c) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// describe returns the title and body of the notification for e.
+func describe(e domain.Event) (title, body string) {
+	b := e.Bill
+	title = b.BillNumber
+	if b.Title != "" {
+		title += ": " + b.Title
+	}
+	switch e.Type {
+	case domain.EventBillStatusChanged:
+		if e.Previous == "" {
+			return title, "Status is now " + b.Status + "."
+		}
+		return title, fmt.Sprintf("Status changed from %s to %s.", e.Previous, b.Status)
+	default:
+		body = b.LastAction
+		if b.LastActionDate != nil {
+			body += " (" + b.LastActionDate.Format("Jan 2") + ")"
+		}
+		return title, body
+	}
+}
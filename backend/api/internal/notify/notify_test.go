@@ -0,0 +1,265 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository/memory"
+)
+
+var discard = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// fakeChannel records what it sends and fails with err.
+type fakeChannel struct {
+	sent []string // "token: title"
+	err  error
+}
+
+func (c *fakeChannel) Send(ctx context.Context, to domain.Device, msg Message) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.sent = append(c.sent, to.Token+": "+msg.Title)
+	return nil
+}
+
+func statusEvent(id, number, sponsor string) domain.Event {
+	return domain.Event{
+		Type:     domain.EventBillStatusChanged,
+		Bill:     &domain.Bill{ID: id, BillNumber: number, Title: "Title", Status: "passed", SponsorID: sponsor},
+		Previous: "introduced",
+	}
+}
+
+func TestNotifierMatches(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewNotificationRepository()
+	follows := memory.NewWatchlistRepository()
+	for _, s := range []domain.NotificationSubscription{
+		{UserID: "bill", BillID: "b1", Channels: []string{domain.ChannelEmail}},
+		{UserID: "bill", BillID: "b1", Channels: []string{domain.ChannelFCM}}, // merged with the first
+		{UserID: "sponsor", SponsorID: "l1"},
+		{UserID: "followed", Followed: true},
+		{UserID: "all-actions", EventTypes: []string{domain.EventBillAction}},
+		{UserID: "everything"},
+	} {
+		if _, err := repo.SaveSubscription(ctx, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := follows.Follow(ctx, domain.Follow{UserID: "followed", Kind: domain.FollowLegislator, TargetID: "l2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	n := NewNotifier(repo, follows, discard)
+	err := n.Publish(ctx, []domain.Event{
+		statusEvent("b1", "HB0001", "l1"),
+		statusEvent("b2", "HB0002", "l2"),
+		{Type: domain.EventBillNewVersion, Bill: &domain.Bill{ID: "b1"}}, // webhooks only
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pending, err := repo.PendingNotifications(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, p := range pending {
+		got = append(got, p.UserID+"/"+p.BillID)
+	}
+	want := "bill/b1 sponsor/b1 everything/b1 followed/b2 everything/b2"
+	if strings.Join(got, " ") != want {
+		t.Errorf("notifications = %v, want %s", got, want)
+	}
+	first := pending[0]
+	if first.Title != "HB0001: Title" || first.Body != "Status changed from introduced to passed." ||
+		strings.Join(first.Channels, ",") != "email,fcm" {
+		t.Errorf("notification = %+v", first)
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	ctx := context.Background()
+	// 2026-03-02 18:00 in Denver.
+	now := time.Date(2026, time.March, 3, 1, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T, settings domain.NotificationSettings, titles ...string) (*memory.NotificationRepository, *Dispatcher, *fakeChannel, *fakeChannel) {
+		t.Helper()
+		repo := memory.NewNotificationRepository()
+		if err := repo.SaveNotificationSettings(ctx, settings); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := repo.SaveDevice(ctx, domain.Device{UserID: settings.UserID, Channel: domain.ChannelFCM, Token: "phone"}); err != nil {
+			t.Fatal(err)
+		}
+		for _, title := range titles {
+			err := repo.EnqueueNotifications(ctx, []domain.Notification{{UserID: settings.UserID, Title: title}})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		email, fcm := &fakeChannel{}, &fakeChannel{}
+		d := NewDispatcher(repo, map[string]Channel{domain.ChannelEmail: email, domain.ChannelFCM: fcm}, discard)
+		d.now = func() time.Time { return now }
+		return repo, d, email, fcm
+	}
+	pending := func(t *testing.T, repo *memory.NotificationRepository) int {
+		t.Helper()
+		ns, err := repo.PendingNotifications(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(ns)
+	}
+
+	t.Run("Immediate", func(t *testing.T) {
+		settings := domain.DefaultNotificationSettings("u")
+		settings.Email = "u@example.com"
+		repo, d, email, fcm := setup(t, settings, "one", "two")
+		if n, err := d.Dispatch(ctx); err != nil || n != 2 {
+			t.Fatalf("Dispatch = %d, %v; want 2", n, err)
+		}
+		if strings.Join(email.sent, "|") != "u@example.com: one|u@example.com: two" || len(fcm.sent) != 2 {
+			t.Errorf("sent email %v, fcm %v", email.sent, fcm.sent)
+		}
+		if pending(t, repo) != 0 {
+			t.Error("notifications still pending")
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		repo, d, _, fcm := setup(t, domain.NotificationSettings{UserID: "u"}, "one")
+		if n, _ := d.Dispatch(ctx); n != 1 || len(fcm.sent) != 0 || pending(t, repo) != 0 {
+			t.Errorf("Dispatch = %d, sent %v; want the notification dropped", n, fcm.sent)
+		}
+	})
+
+	t.Run("QuietHours", func(t *testing.T) {
+		settings := domain.DefaultNotificationSettings("u")
+		settings.QuietStart, settings.QuietEnd = 17*60, 8*60 // 5pm to 8am
+		repo, d, _, fcm := setup(t, settings, "one")
+		if n, _ := d.Dispatch(ctx); n != 0 || len(fcm.sent) != 0 || pending(t, repo) != 1 {
+			t.Errorf("Dispatch in quiet hours = %d, sent %v; want it held", n, fcm.sent)
+		}
+		d.now = func() time.Time { return now.Add(15 * time.Hour) } // 9am
+		if n, _ := d.Dispatch(ctx); n != 1 || len(fcm.sent) != 1 {
+			t.Errorf("Dispatch after quiet hours = %d, sent %v", n, fcm.sent)
+		}
+	})
+
+	t.Run("Digest", func(t *testing.T) {
+		settings := domain.DefaultNotificationSettings("u")
+		settings.DigestInterval = time.Hour
+		last := now.Add(-30 * time.Minute)
+		settings.LastDigestAt = &last
+		repo, d, _, fcm := setup(t, settings, "one", "two")
+		if n, _ := d.Dispatch(ctx); n != 0 || pending(t, repo) != 2 {
+			t.Errorf("Dispatch before the interval = %d; want both held", n)
+		}
+		d.now = func() time.Time { return now.Add(time.Hour) }
+		if n, _ := d.Dispatch(ctx); n != 2 || strings.Join(fcm.sent, "|") != "phone: 2 bill updates" {
+			t.Errorf("Dispatch after the interval = %d, sent %v; want one digest", n, fcm.sent)
+		}
+		s, _ := repo.GetNotificationSettings(ctx, "u")
+		if s.LastDigestAt == nil || !s.LastDigestAt.Equal(now.Add(time.Hour)) {
+			t.Errorf("LastDigestAt = %v", s.LastDigestAt)
+		}
+	})
+
+	t.Run("DigestAge", func(t *testing.T) {
+		for _, interval := range []time.Duration{24 * time.Hour, 7 * 24 * time.Hour} {
+			settings := domain.DefaultNotificationSettings("u")
+			settings.DigestInterval = interval
+			last := time.Now().UTC()
+			settings.LastDigestAt = &last
+			repo, d, _, fcm := setup(t, settings, "one", "two")
+			// The digest fires just after the interval, when the updates
+			// queued since the last one are older than maxPendingAge.
+			d.now = func() time.Time { return last.Add(interval + time.Minute) }
+			if n, _ := d.Dispatch(ctx); n != 2 || strings.Join(fcm.sent, "|") != "phone: 2 bill updates" {
+				t.Errorf("%v digest = %d, sent %v; want both updates", interval, n, fcm.sent)
+			}
+
+			// Left undelivered for maxPendingAge after the digest came due,
+			// they are stale.
+			repo, d, _, fcm = setup(t, settings, "one")
+			d.now = func() time.Time { return last.Add(interval + maxPendingAge + time.Minute) }
+			if n, _ := d.Dispatch(ctx); n != 1 || len(fcm.sent) != 0 || pending(t, repo) != 0 {
+				t.Errorf("%v digest overdue = %d, sent %v; want the update dropped", interval, n, fcm.sent)
+			}
+		}
+	})
+
+	t.Run("Failures", func(t *testing.T) {
+		repo, d, _, fcm := setup(t, domain.DefaultNotificationSettings("u"), "one")
+		fcm.err = errors.New("unavailable")
+		if n, _ := d.Dispatch(ctx); n != 0 || pending(t, repo) != 1 {
+			t.Errorf("Dispatch with failing channel = %d; want the notification kept", n)
+		}
+		fcm.err = ErrGone
+		if n, _ := d.Dispatch(ctx); n != 0 {
+			t.Errorf("Dispatch to a gone device = %d", n)
+		}
+		if devices, _ := repo.ListDevices(ctx, "u"); len(devices) != 0 {
+			t.Errorf("gone device still registered: %+v", devices)
+		}
+		// With nowhere left to send, the notification is done with.
+		if n, _ := d.Dispatch(ctx); n != 1 || pending(t, repo) != 0 {
+			t.Errorf("Dispatch with no destinations = %d", n)
+		}
+	})
+}
+
+func TestDeliverableAt(t *testing.T) {
+	created := time.Date(2026, time.July, 1, 4, 0, 0, 0, time.UTC) // 10pm MDT
+	last := created.Add(-time.Hour)
+	for _, tc := range []struct {
+		name string
+		s    domain.NotificationSettings
+		want time.Time
+	}{
+		{"immediate", domain.NotificationSettings{}, created},
+		{"digest", domain.NotificationSettings{DigestInterval: 48 * time.Hour, LastDigestAt: &last}, last.Add(48 * time.Hour)},
+		{"first digest", domain.NotificationSettings{DigestInterval: 48 * time.Hour}, created},
+		{"quiet hours", domain.NotificationSettings{QuietStart: 21 * 60, QuietEnd: 7 * 60}, created.Add(9 * time.Hour)},
+		{"digest due in quiet hours", domain.NotificationSettings{
+			DigestInterval: 24 * time.Hour, LastDigestAt: &last, QuietStart: 21 * 60, QuietEnd: 7 * 60,
+		}, created.Add(33 * time.Hour)},
+	} {
+		if got := deliverableAt(tc.s, domain.Notification{CreatedAt: created}); !got.Equal(tc.want) {
+			t.Errorf("%s: deliverableAt = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestQuiet(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2026, time.July, 1, hour+6, 0, 0, 0, time.UTC) } // MDT
+	for _, tc := range []struct {
+		start, end, hour int
+		want             bool
+	}{
+		{0, 0, 3, false},
+		{22 * 60, 7 * 60, 23, true},
+		{22 * 60, 7 * 60, 3, true},
+		{22 * 60, 7 * 60, 7, false},
+		{9 * 60, 17 * 60, 12, true},
+		{9 * 60, 17 * 60, 17, false},
+	} {
+		s := domain.NotificationSettings{QuietStart: tc.start, QuietEnd: tc.end}
+		if got := quiet(s, at(tc.hour)); got != tc.want {
+			t.Errorf("quiet(%d-%d) at %d:00 = %v, want %v", tc.start, tc.end, tc.hour, got, tc.want)
+		}
+	}
+	s := domain.NotificationSettings{QuietStart: 22 * 60, QuietEnd: 7 * 60, TimeZone: "America/New_York"}
+	if !quiet(s, at(21)) { // 11pm Eastern
+		t.Error("quiet hours ignored the time zone")
+	}
+}
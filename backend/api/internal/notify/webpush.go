@@ -0,0 +1,228 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"api/internal/domain"
+	"api/internal/safehttp"
+)
+
+// Web push settings: how long the push service holds a message for an
+// offline browser, and the record size declared in the encrypted body.
+const (
+	webPushTTL        = 24 * time.Hour
+	webPushRecordSize = 4096
+)
+
+// WebPushConfig configures the web push channel.
+type WebPushConfig struct {
+	// PrivateKey is the VAPID key: a P-256 private scalar, base64url
+	// encoded, as generated by common web push libraries. Browsers
+	// subscribe with the matching PublicKey as their applicationServerKey.
+	PrivateKey string
+	// Subject is a contact URI for the push service operator, e.g.
+	// "mailto:ops@example.org".
+	Subject string
+	// Client defaults to a client with a 30 second timeout that refuses
+	// private addresses, as endpoints are registered by users.
+	Client *http.Client
+}
+
+// WebPush sends notifications to browsers through the Web Push protocol
+// (RFC 8030), with payloads encrypted per RFC 8291 and the server
+// identified with VAPID (RFC 8292).
+type WebPush struct {
+	key       *ecdsa.PrivateKey
+	publicKey []byte // uncompressed point
+	subject   string
+	client    *http.Client
+}
+
+// NewWebPush creates the web push channel.
+func NewWebPush(cfg WebPushConfig) (*WebPush, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cfg.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode VAPID private key: %w", err)
+	}
+	priv, err := ecdh.P256().NewPrivateKey(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+	pub := priv.PublicKey().Bytes()
+	key := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(pub[1:33]),
+			Y:     new(big.Int).SetBytes(pub[33:]),
+		},
+		D: new(big.Int).SetBytes(raw),
+	}
+	client := cfg.Client
+	if client == nil {
+		client = safehttp.NewClient(30 * time.Second)
+	}
+	return &WebPush{key: key, publicKey: pub, subject: cfg.Subject, client: client}, nil
+}
+
+// PublicKey returns the VAPID public key, base64url encoded, for browsers'
+// applicationServerKey.
+func (w *WebPush) PublicKey() string {
+	return base64.RawURLEncoding.EncodeToString(w.publicKey)
+}
+
+// Send pushes msg, as JSON with "title" and "body", to the subscription in
+// to. It returns ErrGone if the push service reports the subscription
+// expired.
+func (w *WebPush) Send(ctx context.Context, to domain.Device, msg Message) error {
+	endpoint, err := url.Parse(to.Token)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return fmt.Errorf("invalid push endpoint %q", to.Token)
+	}
+	payload, err := json.Marshal(map[string]string{"title": msg.Title, "body": msg.Body})
+	if err != nil {
+		return err
+	}
+	body, err := encryptWebPush(payload, to.P256dh, to.Auth)
+	if err != nil {
+		return fmt.Errorf("encrypt push payload: %w", err)
+	}
+	token, err := w.vapidToken(endpoint.Scheme + "://" + endpoint.Host)
+	if err != nil {
+		return fmt.Errorf("sign VAPID token: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, to.Token, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("TTL", fmt.Sprint(int(webPushTTL.Seconds())))
+	req.Header.Set("Authorization", "vapid t="+token+", k="+w.PublicKey())
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("push: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrGone
+	case resp.StatusCode >= 300:
+		return fmt.Errorf("push service returned %s", resp.Status)
+	}
+	return nil
+}
+
+// vapidToken signs the VAPID JWT for a push service origin.
+func (w *WebPush) vapidToken(audience string) (string, error) {
+	claims := jwt.MapClaims{
+		"aud": audience,
+		"exp": time.Now().Add(12 * time.Hour).Unix(),
+	}
+	if w.subject != "" {
+		claims["sub"] = w.subject
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(w.key)
+}
+
+// encryptWebPush encrypts payload for a subscription with the aes128gcm
+// content coding (RFC 8188), keyed as RFC 8291 describes. p256dh and auth
+// are the subscription's keys, base64url encoded.
+func encryptWebPush(payload []byte, p256dh, auth string) ([]byte, error) {
+	uaPublic, err := decodeBase64URL(p256dh)
+	if err != nil {
+		return nil, fmt.Errorf("decode p256dh: %w", err)
+	}
+	authSecret, err := decodeBase64URL(auth)
+	if err != nil {
+		return nil, fmt.Errorf("decode auth: %w", err)
+	}
+	uaKey, err := ecdh.P256().NewPublicKey(uaPublic)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p256dh: %w", err)
+	}
+
+	asKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	asPublic := asKey.PublicKey().Bytes()
+	shared, err := asKey.ECDH(uaKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keyInfo := "WebPush: info\x00" + string(uaPublic) + string(asPublic)
+	ikm, err := hkdf.Key(sha256.New, shared, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, err
+	}
+	cek, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	// One record, ended by the last-record delimiter and no padding.
+	plaintext := append(append([]byte(nil), payload...), 0x02)
+	if len(plaintext)+gcm.Overhead() > webPushRecordSize {
+		return nil, fmt.Errorf("payload of %d bytes is too large", len(payload))
+	}
+
+	header := make([]byte, 0, 16+4+1+len(asPublic))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, webPushRecordSize)
+	header = append(header, byte(len(asPublic)))
+	header = append(header, asPublic...)
+	return gcm.Seal(header, nonce, plaintext, nil), nil
+}
+
+// decodeBase64URL decodes base64url with or without padding, as browsers
+// and libraries differ.
+func decodeBase64URL(s string) ([]byte, error) {
+	if n := len(s) % 4; n != 0 {
+		s += "===="[n:]
+	}
+	return base64.URLEncoding.DecodeString(s)
+}
@@ -0,0 +1,171 @@
+package notify
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"api/internal/domain"
+)
+
+// decryptWebPush is the user agent's side of RFC 8291.
+func decryptWebPush(t *testing.T, body []byte, ua *ecdh.PrivateKey, authSecret []byte) []byte {
+	t.Helper()
+	salt, rs, idlen := body[:16], binary.BigEndian.Uint32(body[16:20]), int(body[20])
+	asPublic, ciphertext := body[21:21+idlen], body[21+idlen:]
+	if rs != webPushRecordSize {
+		t.Errorf("record size = %d", rs)
+	}
+	asKey, err := ecdh.P256().NewPublicKey(asPublic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shared, err := ua.ECDH(asKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ikm, _ := hkdf.Key(sha256.New, shared, authSecret, "WebPush: info\x00"+string(ua.PublicKey().Bytes())+string(asPublic), 32)
+	prk, _ := hkdf.Extract(sha256.New, ikm, salt)
+	cek, _ := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	nonce, _ := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	block, _ := aes.NewCipher(cek)
+	gcm, _ := cipher.NewGCM(block)
+	plain, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		t.Fatalf("decrypt: %v", err)
+	}
+	if plain[len(plain)-1] != 0x02 {
+		t.Errorf("missing last record delimiter")
+	}
+	return plain[:len(plain)-1]
+}
+
+func TestWebPush(t *testing.T) {
+	vapid, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ua, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authSecret := make([]byte, 16)
+	rand.Read(authSecret)
+
+	var got map[string]string
+	gone := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if gone {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		if r.Header.Get("Content-Encoding") != "aes128gcm" || r.Header.Get("TTL") == "" {
+			t.Errorf("headers = %v", r.Header)
+		}
+
+		// The VAPID token must verify with the advertised key.
+		token, key, ok := strings.Cut(strings.TrimPrefix(r.Header.Get("Authorization"), "vapid t="), ", k=")
+		if !ok {
+			t.Fatalf("Authorization = %q", r.Header.Get("Authorization"))
+		}
+		pub, _ := base64.RawURLEncoding.DecodeString(key)
+		verify := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(pub[1:33]), Y: new(big.Int).SetBytes(pub[33:])}
+		claims := jwt.MapClaims{}
+		if _, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) { return verify, nil },
+			jwt.WithValidMethods([]string{"ES256"})); err != nil {
+			t.Errorf("VAPID token: %v", err)
+		}
+		if claims["aud"] != "http://"+r.Host || claims["sub"] != "mailto:ops@example.org" {
+			t.Errorf("VAPID claims = %v", claims)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(decryptWebPush(t, body, ua, authSecret), &got); err != nil {
+			t.Errorf("payload: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	push, err := NewWebPush(WebPushConfig{
+		PrivateKey: base64.RawURLEncoding.EncodeToString(vapid.Bytes()),
+		Subject:    "mailto:ops@example.org",
+		Client:     srv.Client(), // the default refuses loopback
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if push.PublicKey() != base64.RawURLEncoding.EncodeToString(vapid.PublicKey().Bytes()) {
+		t.Errorf("PublicKey doesn't match the private key")
+	}
+
+	to := domain.Device{
+		Channel: domain.ChannelWebPush,
+		Token:   srv.URL + "/push/abc",
+		P256dh:  base64.RawURLEncoding.EncodeToString(ua.PublicKey().Bytes()),
+		Auth:    base64.URLEncoding.EncodeToString(authSecret), // padded, as some browsers send
+	}
+	if err := push.Send(context.Background(), to, Message{Title: "HB0001", Body: "passed"}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if got["title"] != "HB0001" || got["body"] != "passed" {
+		t.Errorf("decrypted payload = %v", got)
+	}
+
+	gone = true
+	if err := push.Send(context.Background(), to, Message{Title: "HB0001"}); !errors.Is(err, ErrGone) {
+		t.Errorf("Send to expired subscription = %v, want ErrGone", err)
+	}
+}
+
+func TestWebPushRefusesPrivateEndpoints(t *testing.T) {
+	vapid, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ua, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reached := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	push, err := NewWebPush(WebPushConfig{PrivateKey: base64.RawURLEncoding.EncodeToString(vapid.Bytes())})
+	if err != nil {
+		t.Fatal(err)
+	}
+	to := domain.Device{
+		Channel: domain.ChannelWebPush,
+		Token:   srv.URL + "/push/abc",
+		P256dh:  base64.RawURLEncoding.EncodeToString(ua.PublicKey().Bytes()),
+		Auth:    base64.RawURLEncoding.EncodeToString(make([]byte, 16)),
+	}
+	if err := push.Send(context.Background(), to, Message{Title: "HB0001"}); err == nil || !strings.Contains(err.Error(), "refusing") {
+		t.Errorf("Send to loopback = %v, want refused", err)
+	}
+	if reached {
+		t.Error("loopback endpoint was reached")
+	}
+}
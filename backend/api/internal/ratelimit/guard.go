@@ -0,0 +1,301 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"api/internal/domain"
+	"api/internal/inproc"
+	"api/internal/repository"
+)
+
+// Header is the HTTP header clients send their API key in. Over gRPC it is
+// the lowercase metadata key.
+const Header = "X-API-Key"
+
+// Looked-up keys, and keys found not to exist, are cached for keyCacheTTL,
+// so revoking a key takes up to that long. The cache is emptied when it
+// reaches maxCachedKeys, which only clients guessing keys should cause.
+const (
+	keyCacheTTL   = time.Minute
+	maxCachedKeys = 10000
+)
+
+// errInvalidKey rejects requests with unknown or revoked keys.
+var errInvalidKey = errors.New("invalid or revoked API key")
+
+// limitedError rejects requests that found their bucket empty.
+type limitedError struct {
+	retryAfter time.Duration
+}
+
+func (e *limitedError) Error() string {
+	return fmt.Sprintf("rate limit exceeded; retry after %d seconds", e.seconds())
+}
+
+// seconds returns retryAfter in whole seconds, rounded up, as Retry-After
+// takes it.
+func (e *limitedError) seconds() int {
+	return max(int(math.Ceil(e.retryAfter.Seconds())), 1)
+}
+
+// Config sets the limits a Guard enforces.
+type Config struct {
+	IP  Limit // per client IP address, for requests without an API key
+	Key Limit // per API key, unless the key sets its own
+}
+
+// Guard enforces rate limits on the API and counts the usage of API keys.
+type Guard struct {
+	keys    repository.APIKeyRepository
+	cfg     Config
+	buckets *Buckets
+	logger  *slog.Logger
+	now     func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedKey // by hash
+	usage map[string]*usage    // by key ID, since the last Flush
+}
+
+type cachedKey struct {
+	key     *domain.APIKey // nil if there is no such key
+	expires time.Time
+}
+
+type usage struct {
+	requests, throttled int64
+	at                  time.Time // of the last request
+}
+
+// NewGuard creates a Guard that looks API keys up in keys.
+func NewGuard(keys repository.APIKeyRepository, cfg Config, logger *slog.Logger) *Guard {
+	return &Guard{
+		keys:    keys,
+		cfg:     cfg,
+		buckets: NewBuckets(),
+		logger:  logger,
+		now:     time.Now,
+		cache:   make(map[string]cachedKey),
+		usage:   make(map[string]*usage),
+	}
+}
+
+// check decides whether to serve a request made with apiKey, empty if it
+// has none, from the client IP address ip.
+func (g *Guard) check(ctx context.Context, apiKey, ip string) error {
+	now := g.now()
+	if apiKey == "" {
+		return g.take("ip:"+ip, g.cfg.IP, now)
+	}
+
+	k, err := g.lookup(ctx, apiKey, now)
+	if err != nil {
+		return err
+	}
+	if k == nil || k.Revoked {
+		// Bad keys still count against the IP address, so guessing them
+		// is limited too.
+		if err := g.take("ip:"+ip, g.cfg.IP, now); err != nil {
+			return err
+		}
+		return errInvalidKey
+	}
+	limit := g.cfg.Key
+	if k.RatePerMinute > 0 {
+		limit.PerMinute = k.RatePerMinute
+	}
+	if k.Burst > 0 {
+		limit.Burst = k.Burst
+	}
+	err = g.take("key:"+k.ID, limit, now)
+	g.count(k.ID, err != nil, now)
+	return err
+}
+
+func (g *Guard) take(bucket string, l Limit, now time.Time) error {
+	if ok, retryAfter := g.buckets.Take(bucket, l, now); !ok {
+		return &limitedError{retryAfter: retryAfter}
+	}
+	return nil
+}
+
+// lookup returns the stored key for apiKey, or nil if there is none.
+func (g *Guard) lookup(ctx context.Context, apiKey string, now time.Time) (*domain.APIKey, error) {
+	hash := HashKey(apiKey)
+	g.mu.Lock()
+	c, ok := g.cache[hash]
+	g.mu.Unlock()
+	if ok && now.Before(c.expires) {
+		return c.key, nil
+	}
+
+	k, err := g.keys.GetAPIKeyByHash(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("look up api key: %w", err)
+	}
+	g.mu.Lock()
+	if len(g.cache) >= maxCachedKeys {
+		clear(g.cache)
+	}
+	g.cache[hash] = cachedKey{key: k, expires: now.Add(keyCacheTTL)}
+	g.mu.Unlock()
+	return k, nil
+}
+
+func (g *Guard) count(id string, throttled bool, now time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	u := g.usage[id]
+	if u == nil {
+		u = &usage{}
+		g.usage[id] = u
+	}
+	u.requests++
+	if throttled {
+		u.throttled++
+	}
+	u.at = now
+}
+
+// Flush adds the usage counted since the last Flush to the stored keys.
+// Counts that fail to store are kept for the next Flush.
+func (g *Guard) Flush(ctx context.Context) error {
+	g.mu.Lock()
+	pending := g.usage
+	g.usage = make(map[string]*usage)
+	g.mu.Unlock()
+
+	var errs []error
+	for id, u := range pending {
+		if err := g.keys.AddAPIKeyUsage(ctx, id, u.requests, u.throttled, u.at); err != nil {
+			errs = append(errs, err)
+			g.mu.Lock()
+			if cur := g.usage[id]; cur != nil {
+				cur.requests += u.requests
+				cur.throttled += u.throttled
+			} else {
+				g.usage[id] = u
+			}
+			g.mu.Unlock()
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Unary returns the interceptor for unary RPCs.
+func (g *Guard) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := g.checkCall(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the interceptor for streaming RPCs. A stream takes one
+// token when it opens.
+func (g *Guard) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := g.checkCall(ss.Context()); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+type clientIPKey struct{}
+
+// WithClientIP records the client IP address of a gRPC call served over
+// HTTP, as the HTTP server determined it, so the call is limited by that
+// address rather than its peer's: behind a proxy, the peer is the proxy.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+// checkCall applies the limits to a gRPC call, returning its status error
+// if it is rejected.
+func (g *Guard) checkCall(ctx context.Context) error {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	if p, ok := peer.FromContext(ctx); ip == "" && ok && p.Addr != nil {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+	if inproc.IsPeer(ctx) {
+		return nil // the gateway, already limited by Handler
+	}
+	apiKey := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(strings.ToLower(Header)); len(vals) > 0 {
+			apiKey = strings.TrimSpace(vals[0])
+		}
+	}
+
+	err := g.check(ctx, apiKey, ip)
+	var limited *limitedError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &limited):
+		_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(limited.seconds())))
+		st := status.New(codes.ResourceExhausted, limited.Error())
+		if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(limited.retryAfter)}); err == nil {
+			st = detailed
+		}
+		return st.Err()
+	case errors.Is(err, errInvalidKey):
+		return status.Error(codes.Unauthenticated, err.Error())
+	default:
+		g.logger.Error("rate limit check failed", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+// Handler applies the limits to HTTP requests before passing them to next.
+// clientIP returns a request's client IP address, which depends on the
+// proxies in front of the server.
+func (g *Guard) Handler(next http.Handler, clientIP func(*http.Request) string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := g.check(r.Context(), strings.TrimSpace(r.Header.Get(Header)), clientIP(r))
+		var limited *limitedError
+		switch {
+		case err == nil:
+			next.ServeHTTP(w, r)
+		case errors.As(err, &limited):
+			w.Header().Set("Retry-After", strconv.Itoa(limited.seconds()))
+			writeError(w, http.StatusTooManyRequests, codes.ResourceExhausted, limited.Error())
+		case errors.Is(err, errInvalidKey):
+			writeError(w, http.StatusUnauthorized, codes.Unauthenticated, err.Error())
+		default:
+			g.logger.Error("rate limit check failed", "error", err)
+			writeError(w, http.StatusInternalServerError, codes.Internal, "internal error")
+		}
+	})
+}
+
+// writeError writes an error in the gateway's format.
+func writeError(w http.ResponseWriter, httpStatus int, code codes.Code, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(map[string]any{"code": code, "message": msg, "details": []any{}})
+}
@@ -0,0 +1,129 @@
+// Package ratelimit keeps clients of the public API from starving each
+// other of it.
+//
+// Every request draws a token from a bucket: its API key's if it sends one
+// (the X-API-Key header, or x-api-key metadata over gRPC), else its client
+// IP address's. A request that finds its bucket empty is rejected with
+// RESOURCE_EXHAUSTED, or 429 over HTTP, and told when to retry. Requests
+// with unknown or revoked keys are rejected outright.
+//
+// Guard enforces the limits for both the gateway, as an HTTP handler, and
+// direct gRPC callers, as interceptors. Calls the gateway forwards in
+// process were limited by the handler already, so the interceptors let
+// them through. Guard also counts each key's requests and throttled
+// requests, and Flush adds them to the stored keys.
+package ratelimit
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Limit is a token bucket: a client can make Burst requests at once, then
+// PerMinute requests a minute. The zero Limit doesn't limit anything.
+type Limit struct {
+	PerMinute int
+	Burst     int // at least 1
+}
+
+// perSecond is the rate the bucket refills at.
+func (l Limit) perSecond() float64 {
+	return float64(l.PerMinute) / 60
+}
+
+func (l Limit) burst() float64 {
+	return float64(max(l.Burst, 1))
+}
+
+// sweepInterval is how often Buckets drops the buckets of idle clients.
+const sweepInterval = time.Minute
+
+// Buckets holds the token bucket of each client.
+type Buckets struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	swept   time.Time
+}
+
+type bucket struct {
+	limit  Limit
+	tokens float64
+	at     time.Time // when tokens was counted
+}
+
+// refilled returns the bucket's tokens at now.
+func (b *bucket) refilled(now time.Time) float64 {
+	return min(b.limit.burst(), b.tokens+max(now.Sub(b.at).Seconds(), 0)*b.limit.perSecond())
+}
+
+// NewBuckets creates an empty Buckets.
+func NewBuckets() *Buckets {
+	return &Buckets{buckets: make(map[string]*bucket)}
+}
+
+// Take takes a token from key's bucket, which starts full and refills at
+// l's rate, and reports whether there was one. If not, retryAfter is how
+// long until there is.
+func (b *Buckets) Take(key string, l Limit, now time.Time) (ok bool, retryAfter time.Duration) {
+	if l.PerMinute <= 0 {
+		return true, 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sweep(now)
+
+	bk := b.buckets[key]
+	if bk == nil {
+		bk = &bucket{tokens: l.burst(), at: now}
+		b.buckets[key] = bk
+	}
+	bk.limit = l // a key's limit may have changed
+	bk.tokens, bk.at = bk.refilled(now), now
+	if bk.tokens >= 1 {
+		bk.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - bk.tokens) / l.perSecond() * float64(time.Second))
+}
+
+// sweep drops, at most once every sweepInterval, the buckets that have
+// refilled, which are no different from new ones.
+func (b *Buckets) sweep(now time.Time) {
+	if now.Sub(b.swept) < sweepInterval {
+		return
+	}
+	b.swept = now
+	for key, bk := range b.buckets {
+		if bk.refilled(now) >= bk.limit.burst() {
+			delete(b.buckets, key)
+		}
+	}
+}
+
+// keyPrefixLen is how much of a key is stored as its prefix: "ick_" and
+// eight hex digits.
+const keyPrefixLen = 12
+
+// NewKey returns a random API key.
+func NewKey() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate api key: %w", err)
+	}
+	return "ick_" + hex.EncodeToString(b), nil
+}
+
+// HashKey returns the hash a key is stored and looked up by.
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// KeyPrefix returns the start of a key, stored to recognise it by.
+func KeyPrefix(key string) string {
+	return key[:min(len(key), keyPrefixLen)]
+}
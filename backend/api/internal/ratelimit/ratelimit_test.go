@@ -0,0 +1,195 @@
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"api/internal/domain"
+	"api/internal/inproc"
+	"api/internal/repository/memory"
+)
+
+func TestBuckets(t *testing.T) {
+	b := NewBuckets()
+	now := time.Date(2026, time.March, 5, 23, 0, 0, 0, time.UTC)
+	l := Limit{PerMinute: 60, Burst: 2}
+
+	for i := range 2 {
+		if ok, _ := b.Take("a", l, now); !ok {
+			t.Fatalf("request %d within the burst was limited", i+1)
+		}
+	}
+	ok, retryAfter := b.Take("a", l, now)
+	if ok || retryAfter != time.Second {
+		t.Errorf("Take on an empty bucket = %v, %v; want false, 1s", ok, retryAfter)
+	}
+	if ok, _ := b.Take("b", l, now); !ok {
+		t.Errorf("another client's bucket was drained")
+	}
+	if ok, _ := b.Take("a", l, now.Add(time.Second)); !ok {
+		t.Errorf("bucket didn't refill")
+	}
+	if ok, _ := b.Take("a", Limit{}, now.Add(time.Second)); !ok {
+		t.Errorf("the zero Limit limited a request")
+	}
+
+	// Idle buckets are dropped once they have refilled.
+	b.Take("a", l, now.Add(2*time.Minute))
+	if _, kept := b.buckets["b"]; kept || len(b.buckets) != 1 {
+		t.Errorf("buckets after sweep = %v", b.buckets)
+	}
+}
+
+func newTestGuard(t *testing.T) (*Guard, *memory.APIKeyRepository, string, *time.Time) {
+	t.Helper()
+	keys := memory.NewAPIKeyRepository()
+	key, err := NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := keys.CreateAPIKey(context.Background(), domain.APIKey{Name: "Civic App", Prefix: KeyPrefix(key), Hash: HashKey(key), Burst: 3}); err != nil {
+		t.Fatal(err)
+	}
+	g := NewGuard(keys, Config{IP: Limit{PerMinute: 60, Burst: 1}, Key: Limit{PerMinute: 60, Burst: 1}}, slog.Default())
+	now := time.Date(2026, time.March, 5, 23, 0, 0, 0, time.UTC)
+	g.now = func() time.Time { return now }
+	return g, keys, key, &now
+}
+
+func TestHandler(t *testing.T) {
+	g, keys, key, _ := newTestGuard(t)
+	h := g.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), func(r *http.Request) string {
+		return r.Header.Get("X-Real-IP")
+	})
+	get := func(ip, apiKey string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/bills", nil)
+		r.Header.Set("X-Real-IP", ip)
+		if apiKey != "" {
+			r.Header.Set(Header, apiKey)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	if w := get("203.0.113.1", ""); w.Code != http.StatusOK {
+		t.Fatalf("first anonymous request = %d", w.Code)
+	}
+	w := get("203.0.113.1", "")
+	if w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") != "1" {
+		t.Errorf("anonymous request over the limit = %d, Retry-After %q; want 429, 1", w.Code, w.Header().Get("Retry-After"))
+	}
+
+	// The key has its own, larger burst, whatever the IP address.
+	for i := range 3 {
+		if w := get("203.0.113.1", key); w.Code != http.StatusOK {
+			t.Fatalf("keyed request %d = %d", i+1, w.Code)
+		}
+	}
+	if w := get("203.0.113.2", key); w.Code != http.StatusTooManyRequests {
+		t.Errorf("keyed request over the limit = %d, want 429", w.Code)
+	}
+	if w := get("203.0.113.3", "ick_unknown"); w.Code != http.StatusUnauthorized {
+		t.Errorf("request with an unknown key = %d, want 401", w.Code)
+	}
+
+	if err := g.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	stored, _ := keys.ListAPIKeys(context.Background())
+	if stored[0].Requests != 4 || stored[0].Throttled != 1 || stored[0].LastUsedAt == nil {
+		t.Errorf("usage = %+v, want 4 requests, 1 throttled", stored[0])
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	g, _, _, _ := newTestGuard(t)
+	unary := g.Unary()
+	call := func(addr net.Addr, md metadata.MD) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		ctx = metadata.NewIncomingContext(ctx, md)
+		_, err := unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/api.v1.BillService/ListBills"},
+			func(ctx context.Context, req any) (any, error) { return nil, nil })
+		return err
+	}
+
+	tcp := func(ip string) net.Addr { return &net.TCPAddr{IP: net.ParseIP(ip), Port: 40000} }
+
+	if err := call(tcp("203.0.113.1"), nil); err != nil {
+		t.Fatal(err)
+	}
+	err := call(tcp("203.0.113.1"), nil)
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted || len(st.Details()) != 1 {
+		t.Fatalf("call over the limit = %v, want ResourceExhausted with RetryInfo", err)
+	}
+	if info, ok := st.Details()[0].(*errdetails.RetryInfo); !ok || info.RetryDelay.AsDuration() != time.Second {
+		t.Errorf("details = %v, want a retry delay of 1s", st.Details())
+	}
+	if err := call(tcp("203.0.113.2"), metadata.Pairs("x-api-key", "ick_unknown")); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("call with an unknown key = %v, want Unauthenticated", err)
+	}
+
+	// The gateway's calls were limited by the HTTP handler. Calls over
+	// loopback are not the gateway's.
+	for range 3 {
+		if err := call(inproc.Addr{}, nil); err != nil {
+			t.Errorf("in-process call = %v", err)
+		}
+	}
+	call(tcp("127.0.0.1"), nil)
+	if err := call(tcp("127.0.0.1"), nil); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("call over loopback over the limit = %v, want ResourceExhausted", err)
+	}
+
+	// Calls served over HTTP behind a proxy share its peer address, but are
+	// limited by the client IP the HTTP server found.
+	proxied := func(client string) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: tcp("10.0.0.1")})
+		ctx = WithClientIP(ctx, client)
+		_, err := unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/api.v1.BillService/ListBills"},
+			func(ctx context.Context, req any) (any, error) { return nil, nil })
+		return err
+	}
+	if err := proxied("198.51.100.1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := proxied("198.51.100.2"); err != nil {
+		t.Errorf("proxied call from another client = %v, want it served", err)
+	}
+	if err := proxied("198.51.100.1"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("proxied call over the limit = %v, want ResourceExhausted", err)
+	}
+}
+
+func TestRevokedKey(t *testing.T) {
+	g, keys, key, now := newTestGuard(t)
+	ctx := context.Background()
+	if err := g.check(ctx, key, "203.0.113.1"); err != nil {
+		t.Fatal(err)
+	}
+	stored, _ := keys.ListAPIKeys(ctx)
+	stored[0].Revoked = true
+	keys.UpdateAPIKey(ctx, stored[0])
+
+	// Revocation takes effect when the cached key expires.
+	if err := g.check(ctx, key, "203.0.113.1"); err != nil {
+		t.Errorf("check with a cached key = %v", err)
+	}
+	*now = now.Add(keyCacheTTL)
+	if err := g.check(ctx, key, "203.0.113.1"); err != errInvalidKey {
+		t.Errorf("check with a revoked key = %v, want errInvalidKey", err)
+	}
+}
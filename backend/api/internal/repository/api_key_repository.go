@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"api/internal/domain"
+)
+
+// APIKeyRepository stores the API keys issued to third-party clients and
+// their usage.
+type APIKeyRepository interface {
+	// CreateAPIKey stores a new key and returns it with its ID and
+	// CreatedAt set.
+	CreateAPIKey(ctx context.Context, k domain.APIKey) (domain.APIKey, error)
+	// GetAPIKey returns a key, or nil if it doesn't exist.
+	GetAPIKey(ctx context.Context, id string) (*domain.APIKey, error)
+	// GetAPIKeyByHash returns the key with the given Hash, or nil if there
+	// is none.
+	GetAPIKeyByHash(ctx context.Context, hash string) (*domain.APIKey, error)
+	// ListAPIKeys returns every key, oldest first.
+	ListAPIKeys(ctx context.Context) ([]domain.APIKey, error)
+	// UpdateAPIKey stores a key's Name, RatePerMinute, Burst and Revoked.
+	// It is a no-op if the key doesn't exist.
+	UpdateAPIKey(ctx context.Context, k domain.APIKey) error
+	// DeleteAPIKey removes a key, if it exists.
+	DeleteAPIKey(ctx context.Context, id string) error
+	// AddAPIKeyUsage adds to a key's Requests and Throttled counts, and
+	// moves its LastUsedAt up to at. It is a no-op if the key doesn't
+	// exist.
+	AddAPIKeyUsage(ctx context.Context, id string, requests, throttled int64, at time.Time) error
+}
@@ -12,8 +12,24 @@ type BillFilters struct {
 	Status      string
 	Chamber     string // filter by sponsor's chamber: "house" or "senate"
 	SponsorID   string
+	// IDs, if non-nil, limits the results to these bills; an empty slice
+	// matches none.
+	IDs []string
+	// BillNumbers, if non-nil, limits the results to bills with these
+	// numbers; an empty slice matches none. With SessionYear it looks bills
+	// up by their natural key.
+	BillNumbers []string
 	Page        int
 	PageSize    int
+
+	// ByActivity orders bills by LastActionDate, most recent first (bills
+	// with no action last), instead of newest session first and then by
+	// bill number.
+	ByActivity bool
+
+	// OmitSponsor leaves Bill.Sponsor nil, skipping the sponsor lookup.
+	// SponsorID is still set.
+	OmitSponsor bool
 }
 
 // BillRepository defines the operations on the bills store.
@@ -22,4 +38,16 @@ type BillRepository interface {
 	ListBills(ctx context.Context, filters BillFilters) ([]domain.Bill, error)
 	GetBill(ctx context.Context, id string) (*domain.Bill, error)
 	UpsertBill(ctx context.Context, bill domain.Bill) error
+	// UpsertBills upserts a batch of bills atomically: if any bill fails,
+	// none of the batch is stored. Later entries win over earlier ones with
+	// the same key.
+	UpsertBills(ctx context.Context, bills []domain.Bill) error
+	// LinkSponsors links every bill with no sponsor to the stored legislator
+	// whose UtahLegislatureID matches its SponsorUtahID, returning how many
+	// bills were linked. It is how bills written before their sponsor get
+	// linked once the sponsor is stored.
+	LinkSponsors(ctx context.Context) (int, error)
+	// UnresolvedSponsors returns the SponsorUtahIDs of bills that still have
+	// no sponsor linked, most bills first.
+	UnresolvedSponsors(ctx context.Context) ([]domain.UnresolvedSponsor, error)
 }
@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// BillRepository caches reads from another repository.BillRepository.
+// Bills embed their sponsor, so entries are also dropped when legislators
+// are synced.
+type BillRepository struct {
+	inner repository.BillRepository
+	cache *store
+}
+
+// NewBillRepository wraps inner with a cache whose entries live for ttl.
+func NewBillRepository(inner repository.BillRepository, versions *Versions, ttl time.Duration) *BillRepository {
+	return &BillRepository{
+		inner: inner,
+		cache: newStore(ttl, versions, domain.EntityBills, domain.EntityLegislators),
+	}
+}
+
+// ListBills returns bills filtered by the given criteria.
+func (r *BillRepository) ListBills(ctx context.Context, f repository.BillFilters) ([]domain.Bill, error) {
+	key := fmt.Sprintf("list:%+v", f)
+	if f.IDs != nil {
+		key += ":ids" // %+v prints nil and empty IDs alike
+	}
+	if f.BillNumbers != nil {
+		key += ":numbers"
+	}
+	return read(ctx, r.cache, key, cloneBills, func() ([]domain.Bill, error) {
+		return r.inner.ListBills(ctx, f)
+	})
+}
+
+// GetBill returns a single bill by its ID.
+func (r *BillRepository) GetBill(ctx context.Context, id string) (*domain.Bill, error) {
+	return read(ctx, r.cache, "get:"+id, cloneBillPtr, func() (*domain.Bill, error) {
+		return r.inner.GetBill(ctx, id)
+	})
+}
+
+// UpsertBill writes through to the wrapped repository.
+func (r *BillRepository) UpsertBill(ctx context.Context, b domain.Bill) error {
+	defer r.cache.invalidate()
+	return r.inner.UpsertBill(ctx, b)
+}
+
+// UpsertBills writes through to the wrapped repository.
+func (r *BillRepository) UpsertBills(ctx context.Context, bills []domain.Bill) error {
+	defer r.cache.invalidate()
+	return r.inner.UpsertBills(ctx, bills)
+}
+
+// LinkSponsors writes through to the wrapped repository.
+func (r *BillRepository) LinkSponsors(ctx context.Context) (int, error) {
+	defer r.cache.invalidate()
+	return r.inner.LinkSponsors(ctx)
+}
+
+// UnresolvedSponsors is not cached: it is an operator report.
+func (r *BillRepository) UnresolvedSponsors(ctx context.Context) ([]domain.UnresolvedSponsor, error) {
+	return r.inner.UnresolvedSponsors(ctx)
+}
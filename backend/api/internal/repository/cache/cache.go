@@ -0,0 +1,159 @@
+// Package cache provides read-through caching decorators for the
+// repository interfaces.
+//
+// Cached reads expire after a per-entity TTL, and are dropped early when
+// the entity's SyncVersion changes. Ingestion jobs bump that version after
+// each sync, so an API server sees new data within one check interval even
+// though the jobs run in other processes. Writes made through a decorator
+// invalidate its own entries immediately.
+package cache
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// maxEntries bounds each store. Keys include filters and page numbers, so
+// a store that fills up is simply emptied rather than tracked for LRU.
+const maxEntries = 10_000
+
+// Versions tracks SyncVersions, re-reading each from the store at most once
+// per interval. It is shared by every decorator in a process.
+type Versions struct {
+	repo     repository.SyncRepository
+	interval time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	current map[string]domain.SyncVersion
+	checked map[string]time.Time
+}
+
+// NewVersions creates a Versions that polls repo every interval. A nil repo
+// disables cross-process invalidation; entries then only expire by TTL.
+func NewVersions(repo repository.SyncRepository, interval time.Duration) *Versions {
+	return &Versions{
+		repo:     repo,
+		interval: interval,
+		now:      time.Now,
+		current:  map[string]domain.SyncVersion{},
+		checked:  map[string]time.Time{},
+	}
+}
+
+// Get returns the latest known SyncVersion for entity. If the store can't
+// be read the last known version is returned, so a database blip degrades
+// to TTL expiry instead of failing reads.
+func (v *Versions) Get(ctx context.Context, entity string) domain.SyncVersion {
+	if v == nil || v.repo == nil {
+		return domain.SyncVersion{Entity: entity}
+	}
+
+	v.mu.Lock()
+	current, ok := v.current[entity]
+	fresh := ok && v.now().Sub(v.checked[entity]) < v.interval
+	v.mu.Unlock()
+	if fresh {
+		return current
+	}
+
+	latest, err := v.repo.GetSyncVersion(ctx, entity)
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.checked[entity] = v.now()
+	if err != nil {
+		return v.current[entity]
+	}
+	v.current[entity] = latest
+	return latest
+}
+
+// store is a TTL cache whose entries are also invalidated when any of the
+// entities they depend on gets a new SyncVersion.
+type store struct {
+	ttl      time.Duration
+	versions *Versions
+	entities []string
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+type entry struct {
+	value    any
+	expires  time.Time
+	versions []int64
+}
+
+func newStore(ttl time.Duration, versions *Versions, entities ...string) *store {
+	return &store{
+		ttl:      ttl,
+		versions: versions,
+		entities: entities,
+		now:      time.Now,
+		entries:  map[string]entry{},
+	}
+}
+
+// snapshot returns the current version of each dependency.
+func (s *store) snapshot(ctx context.Context) []int64 {
+	out := make([]int64, len(s.entities))
+	for i, e := range s.entities {
+		out[i] = s.versions.Get(ctx, e).Version
+	}
+	return out
+}
+
+// get returns the cached value for key if it is unexpired and was stored
+// under the current versions.
+func (s *store) get(key string, versions []int64) (any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !s.now().Before(e.expires) || !slices.Equal(e.versions, versions) {
+		delete(s.entries, key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+func (s *store) put(key string, value any, versions []int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.entries) >= maxEntries {
+		clear(s.entries)
+	}
+	s.entries[key] = entry{value: value, expires: s.now().Add(s.ttl), versions: versions}
+}
+
+// invalidate drops every entry.
+func (s *store) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.entries)
+}
+
+// read returns the cached value for key, or loads, caches and returns it.
+// Values are copied with clone on the way in and out so callers can't
+// mutate cached state. Errors are not cached.
+func read[T any](ctx context.Context, s *store, key string, clone func(T) T, load func() (T, error)) (T, error) {
+	versions := s.snapshot(ctx)
+	if v, ok := s.get(key, versions); ok {
+		return clone(v.(T)), nil
+	}
+	v, err := load()
+	if err != nil {
+		return v, err
+	}
+	s.put(key, clone(v), versions)
+	return v, nil
+}
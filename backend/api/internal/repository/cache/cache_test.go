@@ -0,0 +1,120 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/repository/memory"
+	"api/internal/repository/repositorytest"
+)
+
+// countingBills counts ListBills calls that reach the wrapped repository.
+type countingBills struct {
+	repository.BillRepository
+	lists int
+}
+
+func (c *countingBills) ListBills(ctx context.Context, f repository.BillFilters) ([]domain.Bill, error) {
+	c.lists++
+	return c.BillRepository.ListBills(ctx, f)
+}
+
+func newStores(t *testing.T) repositorytest.Stores {
+	legislators := memory.NewLegislatorRepository()
+	versions := NewVersions(memory.NewSyncRepository(), time.Minute)
+	return repositorytest.Stores{
+		Bills:       NewBillRepository(memory.NewBillRepository(legislators), versions, time.Minute),
+		Legislators: NewLegislatorRepository(legislators, versions, time.Minute),
+	}
+}
+
+// The decorators must not change observable behaviour.
+func TestLegislatorRepository(t *testing.T) {
+	repositorytest.TestLegislatorRepository(t, newStores)
+}
+
+func TestBillRepository(t *testing.T) {
+	repositorytest.TestBillRepository(t, newStores)
+}
+
+func TestInvalidation(t *testing.T) {
+	ctx := context.Background()
+	inner := &countingBills{BillRepository: memory.NewBillRepository(nil)}
+	syncRepo := memory.NewSyncRepository()
+	versions := NewVersions(syncRepo, time.Minute)
+	repo := NewBillRepository(inner, versions, time.Hour)
+
+	now := time.Date(2026, time.February, 1, 12, 0, 0, 0, time.UTC)
+	versions.now = func() time.Time { return now }
+	repo.cache.now = func() time.Time { return now }
+
+	list := func() []domain.Bill {
+		t.Helper()
+		bills, err := repo.ListBills(ctx, repository.BillFilters{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return bills
+	}
+	expectLoads := func(want int) {
+		t.Helper()
+		if inner.lists != want {
+			t.Fatalf("inner ListBills called %d times, want %d", inner.lists, want)
+		}
+	}
+
+	list()
+	list()
+	expectLoads(1)
+
+	// A write through the decorator invalidates immediately.
+	bill := domain.Bill{BillNumber: "HB0001", BillType: "HB", SessionYear: 2026, Title: "T", Status: "introduced"}
+	if err := repo.UpsertBill(ctx, bill); err != nil {
+		t.Fatal(err)
+	}
+	if got := list(); len(got) != 1 {
+		t.Fatalf("got %d bills after upsert, want 1", len(got))
+	}
+	expectLoads(2)
+
+	// Cached values are copies.
+	list()[0].Title = "mutated"
+	if got := list(); got[0].Title != "T" {
+		t.Errorf("cached title = %q, want T", got[0].Title)
+	}
+	expectLoads(2)
+
+	// A sync elsewhere is noticed once the check interval has passed.
+	if err := inner.UpsertBill(ctx, domain.Bill{BillNumber: "HB0002", BillType: "HB", SessionYear: 2026, Title: "U", Status: "introduced"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := syncRepo.BumpSyncVersion(ctx, domain.EntityBills); err != nil {
+		t.Fatal(err)
+	}
+	list()
+	expectLoads(2)
+	now = now.Add(time.Minute)
+	if got := list(); len(got) != 2 {
+		t.Fatalf("got %d bills after sync, want 2", len(got))
+	}
+	expectLoads(3)
+
+	// Sponsors are embedded, so a legislator sync invalidates bills too.
+	if _, err := syncRepo.BumpSyncVersion(ctx, domain.EntityLegislators); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(time.Minute)
+	list()
+	expectLoads(4)
+
+	// Entries expire after the TTL regardless.
+	now = now.Add(59 * time.Minute)
+	list()
+	expectLoads(4)
+	now = now.Add(2 * time.Minute)
+	list()
+	expectLoads(5)
+}
@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"maps"
+	"slices"
+
+	"api/internal/domain"
+)
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func cloneLegislator(l domain.Legislator) domain.Legislator {
+	l.Offices = slices.Clone(l.Offices)
+	l.TermStart = clonePtr(l.TermStart)
+	l.TermEnd = clonePtr(l.TermEnd)
+	l.Terms = cloneTerms(l.Terms)
+	l.Provenance = maps.Clone(l.Provenance)
+	return l
+}
+
+func cloneLegislatorPtr(l *domain.Legislator) *domain.Legislator {
+	if l == nil {
+		return nil
+	}
+	c := cloneLegislator(*l)
+	return &c
+}
+
+func cloneLegislators(ls []domain.Legislator) []domain.Legislator {
+	if ls == nil {
+		return nil
+	}
+	out := make([]domain.Legislator, len(ls))
+	for i, l := range ls {
+		out[i] = cloneLegislator(l)
+	}
+	return out
+}
+
+func cloneTerms(ts []domain.Term) []domain.Term {
+	if ts == nil {
+		return nil
+	}
+	out := make([]domain.Term, len(ts))
+	for i, t := range ts {
+		t.End = clonePtr(t.End)
+		out[i] = t
+	}
+	return out
+}
+
+func cloneBill(b domain.Bill) domain.Bill {
+	b.Sponsor = cloneLegislatorPtr(b.Sponsor)
+	b.LastActionDate = clonePtr(b.LastActionDate)
+	b.EffectiveDate = clonePtr(b.EffectiveDate)
+	b.Provenance = maps.Clone(b.Provenance)
+	return b
+}
+
+func cloneBillPtr(b *domain.Bill) *domain.Bill {
+	if b == nil {
+		return nil
+	}
+	c := cloneBill(*b)
+	return &c
+}
+
+func cloneBills(bs []domain.Bill) []domain.Bill {
+	if bs == nil {
+		return nil
+	}
+	out := make([]domain.Bill, len(bs))
+	for i, b := range bs {
+		out[i] = cloneBill(b)
+	}
+	return out
+}
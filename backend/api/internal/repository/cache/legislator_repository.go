@@ -0,0 +1,77 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// LegislatorRepository caches reads from another repository.LegislatorRepository.
+type LegislatorRepository struct {
+	inner repository.LegislatorRepository
+	cache *store
+}
+
+// NewLegislatorRepository wraps inner with a cache whose entries live for ttl.
+func NewLegislatorRepository(inner repository.LegislatorRepository, versions *Versions, ttl time.Duration) *LegislatorRepository {
+	return &LegislatorRepository{
+		inner: inner,
+		cache: newStore(ttl, versions, domain.EntityLegislators),
+	}
+}
+
+// ListLegislators returns legislators matching the filters.
+func (r *LegislatorRepository) ListLegislators(ctx context.Context, f repository.LegislatorFilters) ([]domain.Legislator, error) {
+	asOf := ""
+	if f.AsOf != nil {
+		asOf = f.AsOf.UTC().Format(time.RFC3339Nano)
+	}
+	key := fmt.Sprintf("list:%s:%t:%s", f.Chamber, f.IncludeFormer, asOf)
+	return read(ctx, r.cache, key, cloneLegislators, func() ([]domain.Legislator, error) {
+		return r.inner.ListLegislators(ctx, f)
+	})
+}
+
+// GetLegislator returns a single legislator by its ID.
+func (r *LegislatorRepository) GetLegislator(ctx context.Context, id string) (*domain.Legislator, error) {
+	return read(ctx, r.cache, "get:"+id, cloneLegislatorPtr, func() (*domain.Legislator, error) {
+		return r.inner.GetLegislator(ctx, id)
+	})
+}
+
+// GetLegislatorByDistrict returns the current holder of a seat.
+func (r *LegislatorRepository) GetLegislatorByDistrict(ctx context.Context, chamber string, districtNumber int) (*domain.Legislator, error) {
+	key := "district:" + chamber + ":" + strconv.Itoa(districtNumber)
+	return read(ctx, r.cache, key, cloneLegislatorPtr, func() (*domain.Legislator, error) {
+		return r.inner.GetLegislatorByDistrict(ctx, chamber, districtNumber)
+	})
+}
+
+// ListTerms returns every seat a legislator has held, oldest first.
+func (r *LegislatorRepository) ListTerms(ctx context.Context, legislatorID string) ([]domain.Term, error) {
+	return read(ctx, r.cache, "terms:"+legislatorID, cloneTerms, func() ([]domain.Term, error) {
+		return r.inner.ListTerms(ctx, legislatorID)
+	})
+}
+
+// UpsertLegislator writes through to the wrapped repository.
+func (r *LegislatorRepository) UpsertLegislator(ctx context.Context, l domain.Legislator) error {
+	defer r.cache.invalidate()
+	return r.inner.UpsertLegislator(ctx, l)
+}
+
+// UpsertLegislators writes through to the wrapped repository.
+func (r *LegislatorRepository) UpsertLegislators(ctx context.Context, legislators []domain.Legislator) error {
+	defer r.cache.invalidate()
+	return r.inner.UpsertLegislators(ctx, legislators)
+}
+
+// RetireLegislators writes through to the wrapped repository.
+func (r *LegislatorRepository) RetireLegislators(ctx context.Context, keep []string) (int, error) {
+	defer r.cache.invalidate()
+	return r.inner.RetireLegislators(ctx, keep)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"api/internal/domain"
+)
+
+// DeadLetterRepository stores records that ingestion failed to write.
+type DeadLetterRepository interface {
+	// RecordDeadLetter stores a failure, keyed on (Kind, Key). A record that
+	// is already dead-lettered has its source, payload and error replaced
+	// and its attempt count incremented. ID, Attempts and the failure times
+	// of dl are ignored.
+	RecordDeadLetter(ctx context.Context, dl domain.DeadLetter) error
+	// ListDeadLetters returns dead letters, oldest first, limited to one
+	// kind if kind is non-empty.
+	ListDeadLetters(ctx context.Context, kind string) ([]domain.DeadLetter, error)
+	// ResolveDeadLetter removes the dead letter for kind and key, if any.
+	ResolveDeadLetter(ctx context.Context, kind, key string) error
+}
@@ -0,0 +1,164 @@
+// Package events wraps repositories so that changes made by writes are
+// detected, by diffing each record before and after, and published as
+// domain.Events to a Sink such as the notification engine or webhooks.
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/merge"
+	"api/internal/repository"
+)
+
+// Sink receives the events detected by a write.
+type Sink interface {
+	Publish(ctx context.Context, events []domain.Event) error
+}
+
+// Sinks publishes to each of its sinks in turn. One failing doesn't stop
+// the others; their errors are joined.
+type Sinks []Sink
+
+// Publish publishes events to every sink.
+func (s Sinks) Publish(ctx context.Context, events []domain.Event) error {
+	var errs []error
+	for _, sink := range s {
+		if err := sink.Publish(ctx, events); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// BillRepository publishes status, action and text version changes of the
+// bills written through it. Reads pass through to the wrapped repository.
+//
+// Only bills already stored produce events: the first sync of a session
+// would otherwise announce every bill in it.
+type BillRepository struct {
+	repository.BillRepository
+	sink   Sink
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewBillRepository wraps inner, publishing to sink.
+func NewBillRepository(inner repository.BillRepository, sink Sink, logger *slog.Logger) *BillRepository {
+	return &BillRepository{BillRepository: inner, sink: sink, logger: logger, now: time.Now}
+}
+
+// UpsertBill writes the bill and publishes its changes.
+func (r *BillRepository) UpsertBill(ctx context.Context, b domain.Bill) error {
+	return r.upsert(ctx, []domain.Bill{b}, func() error {
+		return r.BillRepository.UpsertBill(ctx, b)
+	})
+}
+
+// UpsertBills writes the batch and, if it succeeds, publishes its changes.
+func (r *BillRepository) UpsertBills(ctx context.Context, bills []domain.Bill) error {
+	return r.upsert(ctx, bills, func() error {
+		return r.BillRepository.UpsertBills(ctx, bills)
+	})
+}
+
+// upsert loads the stored state of bills, runs write, then works out each
+// bill's new state the way the stores do, with merge.Bill, and publishes
+// the differences. Failing to detect or publish changes is logged, not
+// returned: the write itself succeeded.
+func (r *BillRepository) upsert(ctx context.Context, bills []domain.Bill, write func() error) error {
+	stored, err := r.stored(ctx, bills)
+	if err != nil {
+		r.logger.Warn("could not load stored bills; changes will not be published", "error", err)
+		return write()
+	}
+	if err := write(); err != nil {
+		return err
+	}
+
+	now := r.now().UTC()
+	var events []domain.Event
+	for _, b := range bills {
+		key := billKey{b.SessionYear, b.BillNumber}
+		prev, ok := stored[key]
+		if !ok {
+			stored[key] = merge.Bill(nil, b, merge.DefaultBillRules, now)
+			continue
+		}
+		next := merge.Bill(&prev, b, merge.DefaultBillRules, now)
+		stored[key] = next
+		events = append(events, billChanges(prev, next, now)...)
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	if err := r.sink.Publish(ctx, events); err != nil {
+		r.logger.Error("failed to publish bill events", "events", len(events), "error", err)
+	}
+	return nil
+}
+
+// billChanges returns the events for a bill going from prev to next.
+func billChanges(prev, next domain.Bill, at time.Time) []domain.Event {
+	var out []domain.Event
+	if next.Status != prev.Status {
+		out = append(out, domain.Event{Type: domain.EventBillStatusChanged, Bill: &next, Previous: prev.Status, At: at})
+	}
+	if next.LastAction != "" && (next.LastAction != prev.LastAction || !sameTime(next.LastActionDate, prev.LastActionDate)) {
+		out = append(out, domain.Event{Type: domain.EventBillAction, Bill: &next, Previous: prev.LastAction, At: at})
+	}
+	if next.FullTextURL != "" && next.FullTextURL != prev.FullTextURL {
+		out = append(out, domain.Event{Type: domain.EventBillNewVersion, Bill: &next, Previous: prev.FullTextURL, At: at})
+	}
+	return out
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// billKey identifies a bill the way upserts do.
+type billKey struct {
+	year   int
+	number string
+}
+
+// stored returns the stored state of bills, looked up by number within
+// each of their sessions.
+func (r *BillRepository) stored(ctx context.Context, bills []domain.Bill) (map[billKey]domain.Bill, error) {
+	var years []int
+	numbers := map[int][]string{}
+	seen := map[billKey]bool{}
+	for _, b := range bills {
+		key := billKey{b.SessionYear, b.BillNumber}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if _, ok := numbers[b.SessionYear]; !ok {
+			years = append(years, b.SessionYear)
+		}
+		numbers[b.SessionYear] = append(numbers[b.SessionYear], b.BillNumber)
+	}
+
+	out := map[billKey]domain.Bill{}
+	for _, year := range years {
+		batch, err := r.BillRepository.ListBills(ctx, repository.BillFilters{
+			SessionYear: year, BillNumbers: numbers[year], PageSize: len(numbers[year]), OmitSponsor: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("load stored bills: %w", err)
+		}
+		for _, s := range batch {
+			out[billKey{s.SessionYear, s.BillNumber}] = s
+		}
+	}
+	return out, nil
+}
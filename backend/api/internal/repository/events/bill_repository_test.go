@@ -0,0 +1,116 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository/memory"
+)
+
+type recorder struct {
+	events []domain.Event
+	err    error
+}
+
+func (r *recorder) Publish(ctx context.Context, events []domain.Event) error {
+	r.events = append(r.events, events...)
+	return r.err
+}
+
+func TestBillEvents(t *testing.T) {
+	ctx := context.Background()
+	sink := &recorder{}
+	repo := NewBillRepository(memory.NewBillRepository(memory.NewLegislatorRepository()), sink, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	day := func(d int) *time.Time {
+		t := time.Date(2026, time.February, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	bill := func(number, status, action string, date *time.Time) domain.Bill {
+		return domain.Bill{
+			BillNumber: number, SessionYear: 2026, Title: number, Status: status,
+			LastAction: action, LastActionDate: date, Source: domain.SourceUtahLegislature,
+		}
+	}
+
+	// New bills are not announced.
+	err := repo.UpsertBills(ctx, []domain.Bill{
+		bill("HB0001", "introduced", "read 1st time", day(1)),
+		bill("HB0002", "introduced", "read 1st time", day(1)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sink.events) != 0 {
+		t.Fatalf("first sync published %+v", sink.events)
+	}
+
+	// A rewrite with no changes publishes nothing; a new action and status
+	// publish one event each.
+	err = repo.UpsertBills(ctx, []domain.Bill{
+		bill("HB0001", "introduced", "read 1st time", day(1)),
+		bill("HB0002", "passed house", "passed 3rd reading", day(5)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sink.events) != 2 {
+		t.Fatalf("published %+v, want a status change and an action", sink.events)
+	}
+	status, action := sink.events[0], sink.events[1]
+	if status.Type != domain.EventBillStatusChanged || status.Previous != "introduced" ||
+		status.Bill.Status != "passed house" || status.Bill.ID == "" {
+		t.Errorf("status event = %+v", status)
+	}
+	if action.Type != domain.EventBillAction || action.Previous != "read 1st time" || action.Bill.LastAction != "passed 3rd reading" {
+		t.Errorf("action event = %+v", action)
+	}
+
+	// The same action on a later day is new; a failed publish doesn't fail
+	// the write.
+	sink.events, sink.err = nil, errors.New("sink down")
+	if err := repo.UpsertBill(ctx, bill("HB0002", "passed house", "passed 3rd reading", day(6))); err != nil {
+		t.Fatalf("UpsertBill with failing sink: %v", err)
+	}
+	if len(sink.events) != 1 || sink.events[0].Type != domain.EventBillAction {
+		t.Errorf("published %+v, want one action", sink.events)
+	}
+}
+
+func TestBillNewVersion(t *testing.T) {
+	ctx := context.Background()
+	sink := &recorder{}
+	repo := NewBillRepository(memory.NewBillRepository(memory.NewLegislatorRepository()), sink, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	b := domain.Bill{BillNumber: "HB0001", SessionYear: 2026, Title: "Water", Status: "introduced", Source: domain.SourceUtahLegislature}
+	if err := repo.UpsertBill(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	for _, url := range []string{"https://le.utah.gov/~2026/bills/static/HB0001.html", "https://le.utah.gov/~2026/bills/static/HB0001S01.html"} {
+		b.FullTextURL = url
+		if err := repo.UpsertBill(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(sink.events) != 2 {
+		t.Fatalf("published %+v, want two new versions", sink.events)
+	}
+	e := sink.events[1]
+	if e.Type != domain.EventBillNewVersion || e.Previous != "https://le.utah.gov/~2026/bills/static/HB0001.html" ||
+		e.Bill.FullTextURL != "https://le.utah.gov/~2026/bills/static/HB0001S01.html" {
+		t.Errorf("new version event = %+v", e)
+	}
+}
+
+func TestSinks(t *testing.T) {
+	a, b := &recorder{err: errors.New("a down")}, &recorder{}
+	err := Sinks{a, b}.Publish(context.Background(), []domain.Event{{Type: domain.EventBillAction}})
+	if err == nil || len(a.events) != 1 || len(b.events) != 1 {
+		t.Errorf("Publish = %v, a %d, b %d; want a's error and both published to", err, len(a.events), len(b.events))
+	}
+}
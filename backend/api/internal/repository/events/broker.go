@@ -0,0 +1,64 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"api/internal/domain"
+)
+
+// Broker is a Sink that fans events out to in-process subscribers, such as
+// streaming RPCs. Publishing never blocks on a subscriber: one whose buffer
+// is full is dropped, its channel closed, so a stalled client can't hold up
+// ingestion or miss events silently.
+type Broker struct {
+	mu     sync.Mutex
+	subs   map[chan domain.Event]struct{}
+	buffer int
+}
+
+// NewBroker creates a Broker whose subscribers can fall buffer events
+// behind before they are dropped.
+func NewBroker(buffer int) *Broker {
+	return &Broker{subs: map[chan domain.Event]struct{}{}, buffer: buffer}
+}
+
+// Subscribe returns a channel receiving every event published from now on,
+// and a function to unsubscribe. The channel is closed on unsubscribing or
+// if the subscriber falls too far behind.
+func (b *Broker) Subscribe() (<-chan domain.Event, func()) {
+	ch := make(chan domain.Event, b.buffer)
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+	return ch, func() { b.drop(ch) }
+}
+
+// Publish sends events to every subscriber.
+func (b *Broker) Publish(ctx context.Context, events []domain.Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs {
+		for _, e := range events {
+			select {
+			case ch <- e:
+				continue
+			default:
+			}
+			delete(b.subs, ch)
+			close(ch)
+			break
+		}
+	}
+	return nil
+}
+
+// drop unsubscribes ch, if it is still subscribed.
+func (b *Broker) drop(ch chan domain.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subs[ch]; ok {
+		delete(b.subs, ch)
+		close(ch)
+	}
+}
@@ -0,0 +1,43 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"api/internal/domain"
+)
+
+func TestBroker(t *testing.T) {
+	ctx := context.Background()
+	b := NewBroker(2)
+	fast, unsubscribe := b.Subscribe()
+	slow, _ := b.Subscribe()
+
+	b.Publish(ctx, []domain.Event{{Type: domain.EventBillAction}})
+	if e := <-fast; e.Type != domain.EventBillAction {
+		t.Errorf("received %+v", e)
+	}
+	// The slow subscriber's buffer fills up and it is dropped; the fast
+	// one keeps up.
+	b.Publish(ctx, []domain.Event{{Type: domain.EventBillStatusChanged}, {Type: domain.EventBillNewVersion}})
+	var got []domain.Event
+	for e := range slow {
+		got = append(got, e)
+	}
+	if len(got) != 2 {
+		t.Errorf("slow subscriber received %d events before being dropped, want 2", len(got))
+	}
+	if len(fast) != 2 {
+		t.Errorf("fast subscriber has %d events queued, want 2", len(fast))
+	}
+
+	unsubscribe()
+	unsubscribe() // idempotent
+	if _, ok := <-fast; !ok {
+		t.Error("queued events were lost on unsubscribing")
+	}
+	b.Publish(ctx, []domain.Event{{Type: domain.EventBillAction}})
+	if len(b.subs) != 0 {
+		t.Errorf("%d subscribers left", len(b.subs))
+	}
+}
@@ -0,0 +1,189 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// LegislatorRepository publishes a legislator.updated event for each stored
+// legislator whose public record changes through it, including being
+// retired. Reads pass through to the wrapped repository.
+//
+// Unlike bills, legislators are resolved to stored people by several
+// upstream IDs and their seat, so rather than repeat that here the whole
+// (small) table is read before and after each write and diffed by ID. New
+// legislators produce no events. Callers writing legislators one at a time
+// can share one pair of reads across the writes with Batch.
+type LegislatorRepository struct {
+	repository.LegislatorRepository
+	sink   Sink
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewLegislatorRepository wraps inner, publishing to sink.
+func NewLegislatorRepository(inner repository.LegislatorRepository, sink Sink, logger *slog.Logger) *LegislatorRepository {
+	return &LegislatorRepository{LegislatorRepository: inner, sink: sink, logger: logger, now: time.Now}
+}
+
+// UpsertLegislator writes the legislator and publishes their changes.
+func (r *LegislatorRepository) UpsertLegislator(ctx context.Context, l domain.Legislator) error {
+	return r.write(ctx, func() error {
+		return r.LegislatorRepository.UpsertLegislator(ctx, l)
+	})
+}
+
+// UpsertLegislators writes the batch and, if it succeeds, publishes its
+// changes.
+func (r *LegislatorRepository) UpsertLegislators(ctx context.Context, legislators []domain.Legislator) error {
+	return r.write(ctx, func() error {
+		return r.LegislatorRepository.UpsertLegislators(ctx, legislators)
+	})
+}
+
+// RetireLegislators retires legislators and publishes that they no longer
+// hold a seat.
+func (r *LegislatorRepository) RetireLegislators(ctx context.Context, keep []string) (int, error) {
+	var n int
+	err := r.write(ctx, func() error {
+		var err error
+		n, err = r.LegislatorRepository.RetireLegislators(ctx, keep)
+		return err
+	})
+	return n, err
+}
+
+// write snapshots the legislators, runs write, and publishes the
+// differences. As with bills, failing to detect or publish changes is
+// logged, not returned. Within a Batch, publishing waits for its flush.
+func (r *LegislatorRepository) write(ctx context.Context, write func() error) error {
+	if b, ok := ctx.Value(batchKey{}).(*batch); ok {
+		b.mu.Lock()
+		if b.repo == nil {
+			b.repo = r
+			b.before, b.err = r.snapshot(ctx)
+			if b.err != nil {
+				r.logger.Warn("could not load stored legislators; changes will not be published", "error", b.err)
+			}
+		}
+		b.mu.Unlock()
+		return write()
+	}
+
+	before, err := r.snapshot(ctx)
+	if err != nil {
+		r.logger.Warn("could not load stored legislators; changes will not be published", "error", err)
+		return write()
+	}
+	if err := write(); err != nil {
+		return err
+	}
+	r.publish(ctx, before)
+	return nil
+}
+
+// publish reloads the legislators and publishes how they differ from
+// before.
+func (r *LegislatorRepository) publish(ctx context.Context, before map[string]domain.Legislator) {
+	after, err := r.snapshot(ctx)
+	if err != nil {
+		r.logger.Warn("could not reload legislators; changes will not be published", "error", err)
+		return
+	}
+
+	now := r.now().UTC()
+	var events []domain.Event
+	for _, next := range after {
+		prev, ok := before[next.ID]
+		if !ok {
+			continue
+		}
+		if changed := legislatorChanges(prev, next); len(changed) > 0 {
+			events = append(events, domain.Event{Type: domain.EventLegislatorUpdated, Legislator: &next, Changed: changed, At: now})
+		}
+	}
+	if len(events) == 0 {
+		return
+	}
+	if err := r.sink.Publish(ctx, events); err != nil {
+		r.logger.Error("failed to publish legislator events", "events", len(events), "error", err)
+	}
+}
+
+type batchKey struct{}
+
+// batch is the state of a Batch: the snapshot taken before its first write.
+type batch struct {
+	mu     sync.Mutex
+	repo   *LegislatorRepository
+	before map[string]domain.Legislator
+	err    error
+}
+
+// Batch returns a context under which legislator writes are diffed
+// together: the table is read before the first write and again when flush
+// is called, rather than around every write, and the changes are published
+// by flush. It is for writing a batch one legislator at a time, where
+// snapshotting each write would read the whole table twice per record.
+// With no writes, or none through a LegislatorRepository, flush does
+// nothing.
+func Batch(ctx context.Context) (context.Context, func()) {
+	b := &batch{}
+	flush := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if b.repo == nil || b.err != nil {
+			return
+		}
+		b.repo.publish(context.WithoutCancel(ctx), b.before)
+		b.repo = nil
+	}
+	return context.WithValue(ctx, batchKey{}, b), flush
+}
+
+// snapshot returns every stored legislator, current or not, by ID.
+func (r *LegislatorRepository) snapshot(ctx context.Context) (map[string]domain.Legislator, error) {
+	all, err := r.LegislatorRepository.ListLegislators(ctx, repository.LegislatorFilters{IncludeFormer: true})
+	if err != nil {
+		return nil, fmt.Errorf("load stored legislators: %w", err)
+	}
+	out := make(map[string]domain.Legislator, len(all))
+	for _, l := range all {
+		out[l.ID] = l
+	}
+	return out, nil
+}
+
+// legislatorChanges returns the names of the public fields that differ
+// between prev and next, named as in merge provenance.
+func legislatorChanges(prev, next domain.Legislator) []string {
+	var changed []string
+	check := func(name string, differ bool) {
+		if differ {
+			changed = append(changed, name)
+		}
+	}
+	check("chamber", prev.Chamber != next.Chamber)
+	check("district_number", prev.DistrictNumber != next.DistrictNumber)
+	check("first_name", prev.FirstName != next.FirstName)
+	check("last_name", prev.LastName != next.LastName)
+	check("party", prev.Party != next.Party)
+	check("email", prev.Email != next.Email)
+	check("phone", prev.Phone != next.Phone)
+	check("website", prev.Website != next.Website)
+	check("image_url", prev.ImageURL != next.ImageURL)
+	check("offices", !slices.Equal(prev.Offices, next.Offices))
+	check("twitter", prev.Twitter != next.Twitter)
+	check("facebook", prev.Facebook != next.Facebook)
+	check("instagram", prev.Instagram != next.Instagram)
+	check("youtube", prev.YouTube != next.YouTube)
+	check("current", prev.Current != next.Current)
+	return changed
+}
@@ -0,0 +1,111 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/repository/memory"
+)
+
+func TestLegislatorEvents(t *testing.T) {
+	ctx := context.Background()
+	sink := &recorder{}
+	repo := NewLegislatorRepository(memory.NewLegislatorRepository(), sink, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	legislator := func(utahID, last, party string) domain.Legislator {
+		return domain.Legislator{
+			UtahLegislatureID: utahID, Chamber: "house", DistrictNumber: len(utahID), FirstName: "Pat", LastName: last,
+			Party: party, Source: domain.SourceUtahLegislature,
+		}
+	}
+
+	// New legislators are not announced, nor are rewrites with no changes.
+	if err := repo.UpsertLegislators(ctx, []domain.Legislator{legislator("A", "Adams", "R"), legislator("BB", "Baker", "D")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.UpsertLegislator(ctx, legislator("A", "Adams", "R")); err != nil {
+		t.Fatal(err)
+	}
+	if len(sink.events) != 0 {
+		t.Fatalf("published %+v, want nothing", sink.events)
+	}
+
+	if err := repo.UpsertLegislator(ctx, legislator("A", "Adams-Lee", "I")); err != nil {
+		t.Fatal(err)
+	}
+	if len(sink.events) != 1 {
+		t.Fatalf("published %+v, want one update", sink.events)
+	}
+	e := sink.events[0]
+	if e.Type != domain.EventLegislatorUpdated || e.Legislator.LastName != "Adams-Lee" || fmt.Sprint(e.Changed) != "[last_name party]" {
+		t.Errorf("update event = %+v", e)
+	}
+
+	// Retiring someone is an update too.
+	sink.events = nil
+	if n, err := repo.RetireLegislators(ctx, []string{"A"}); err != nil || n != 1 {
+		t.Fatalf("RetireLegislators = %d, %v", n, err)
+	}
+	if len(sink.events) != 1 || sink.events[0].Legislator.UtahLegislatureID != "BB" || fmt.Sprint(sink.events[0].Changed) != "[current]" {
+		t.Errorf("published %+v, want BB no longer current", sink.events)
+	}
+}
+
+// countingLegislators counts the full listings snapshots make.
+type countingLegislators struct {
+	*memory.LegislatorRepository
+	lists int
+}
+
+func (c *countingLegislators) ListLegislators(ctx context.Context, f repository.LegislatorFilters) ([]domain.Legislator, error) {
+	c.lists++
+	return c.LegislatorRepository.ListLegislators(ctx, f)
+}
+
+func TestLegislatorBatch(t *testing.T) {
+	ctx := context.Background()
+	sink := &recorder{}
+	inner := &countingLegislators{LegislatorRepository: memory.NewLegislatorRepository()}
+	repo := NewLegislatorRepository(inner, sink, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	legislator := func(utahID string, district int, party string) domain.Legislator {
+		return domain.Legislator{
+			UtahLegislatureID: utahID, Chamber: "house", DistrictNumber: district, LastName: utahID,
+			Party: party, Source: domain.SourceUtahLegislature,
+		}
+	}
+	if err := repo.UpsertLegislators(ctx, []domain.Legislator{legislator("A", 1, "R"), legislator("B", 2, "R")}); err != nil {
+		t.Fatal(err)
+	}
+
+	inner.lists = 0
+	batchCtx, flush := Batch(ctx)
+	for _, l := range []domain.Legislator{legislator("A", 1, "D"), legislator("B", 2, "I"), legislator("C", 3, "R")} {
+		if err := repo.UpsertLegislator(batchCtx, l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(sink.events) != 0 {
+		t.Fatalf("published %+v before flush", sink.events)
+	}
+	flush()
+	if inner.lists != 2 {
+		t.Errorf("listed the legislators %d times, want once before and once after", inner.lists)
+	}
+	if len(sink.events) != 2 || sink.events[0].Legislator.UtahLegislatureID == sink.events[1].Legislator.UtahLegislatureID {
+		t.Errorf("published %+v, want A and B updated", sink.events)
+	}
+
+	// A second flush, or one with no writes, publishes nothing.
+	flush()
+	_, empty := Batch(ctx)
+	empty()
+	if len(sink.events) != 2 {
+		t.Errorf("published %d events, want 2", len(sink.events))
+	}
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"api/internal/domain"
+)
+
+// JobRunRepository stores the run history of ingestion jobs.
+type JobRunRepository interface {
+	// SaveJobRun inserts run if its ID is empty, setting the ID, and
+	// otherwise updates the stored run.
+	SaveJobRun(ctx context.Context, run *domain.JobRun) error
+	// ListJobRuns returns the most recent runs, newest first, limited to one
+	// job if job is non-empty. A limit <= 0 defaults to 50.
+	ListJobRuns(ctx context.Context, job string, limit int) ([]domain.JobRun, error)
+}
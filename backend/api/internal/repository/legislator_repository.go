@@ -2,15 +2,49 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"api/internal/domain"
 )
 
+// LegislatorFilters holds optional filters for listing legislators.
+//
+// People with no terms at all, such as those stored without a chamber or
+// district, hold no seat to filter on: they are listed, with Current false,
+// only when neither Chamber nor AsOf is set.
+type LegislatorFilters struct {
+	Chamber string // "house" or "senate"; matches the seat held, not the person
+
+	// IncludeFormer also returns people who no longer hold a seat. With
+	// Chamber set, it returns everyone who ever served in that chamber.
+	IncludeFormer bool
+
+	// AsOf returns the members serving on that date instead of today, with
+	// Chamber and DistrictNumber set to the seat they held then. Terms with
+	// an unknown start never match.
+	AsOf *time.Time
+}
+
 // LegislatorRepository defines the operations on the legislators store.
 // Implementations are swappable (Postgres, in-memory, etc.).
+//
+// Legislators are people, identified by upstream IDs (UtahLegislatureID
+// first). The seats they hold are tracked separately as Terms, so a chamber
+// move or redistricting never turns one person into another.
 type LegislatorRepository interface {
-	ListLegislators(ctx context.Context, chamber string) ([]domain.Legislator, error)
+	ListLegislators(ctx context.Context, filters LegislatorFilters) ([]domain.Legislator, error)
+	// GetLegislator returns a legislator with their full service history in Terms.
 	GetLegislator(ctx context.Context, id string) (*domain.Legislator, error)
+	// GetLegislatorByDistrict returns the current holder of a seat.
 	GetLegislatorByDistrict(ctx context.Context, chamber string, districtNumber int) (*domain.Legislator, error)
+	// ListTerms returns every seat a legislator has held, oldest first.
+	ListTerms(ctx context.Context, legislatorID string) ([]domain.Term, error)
 	UpsertLegislator(ctx context.Context, legislator domain.Legislator) error
+	// UpsertLegislators upserts a batch of legislators atomically, in order:
+	// if any fails, none of the batch is stored.
+	UpsertLegislators(ctx context.Context, legislators []domain.Legislator) error
+	// RetireLegislators ends the current term of every legislator whose
+	// UtahLegislatureID is not in keep, returning how many were retired.
+	// The record itself is kept so historic bills still link to it.
+	RetireLegislators(ctx context.Context, keep []string) (int, error)
 }
@@ -0,0 +1,112 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"api/internal/domain"
+)
+
+// APIKeyRepository is the in-memory implementation of repository.APIKeyRepository.
+// Keys are kept in insertion order, which is oldest first.
+type APIKeyRepository struct {
+	mu   sync.RWMutex
+	keys []domain.APIKey
+}
+
+// NewAPIKeyRepository creates a new, empty in-memory APIKeyRepository.
+func NewAPIKeyRepository() *APIKeyRepository {
+	return &APIKeyRepository{}
+}
+
+// CreateAPIKey stores a new key.
+func (r *APIKeyRepository) CreateAPIKey(ctx context.Context, k domain.APIKey) (domain.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k.ID, k.CreatedAt = newID("apikey"), time.Now().UTC()
+	k = cloneAPIKey(k)
+	r.keys = append(r.keys, k)
+	return cloneAPIKey(k), nil
+}
+
+// GetAPIKey returns a key by ID.
+func (r *APIKeyRepository) GetAPIKey(ctx context.Context, id string) (*domain.APIKey, error) {
+	return r.find(func(k domain.APIKey) bool { return k.ID == id }), nil
+}
+
+// GetAPIKeyByHash returns the key with the given hash.
+func (r *APIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	return r.find(func(k domain.APIKey) bool { return k.Hash == hash }), nil
+}
+
+func (r *APIKeyRepository) find(match func(domain.APIKey) bool) *domain.APIKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if i := slices.IndexFunc(r.keys, match); i >= 0 {
+		k := cloneAPIKey(r.keys[i])
+		return &k
+	}
+	return nil
+}
+
+// ListAPIKeys returns every key, oldest first.
+func (r *APIKeyRepository) ListAPIKeys(ctx context.Context) ([]domain.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]domain.APIKey, 0, len(r.keys))
+	for _, k := range r.keys {
+		out = append(out, cloneAPIKey(k))
+	}
+	return out, nil
+}
+
+// UpdateAPIKey stores a key's name, limits and revocation.
+func (r *APIKeyRepository) UpdateAPIKey(ctx context.Context, k domain.APIKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.keys {
+		if r.keys[i].ID == k.ID {
+			r.keys[i].Name = k.Name
+			r.keys[i].RatePerMinute = k.RatePerMinute
+			r.keys[i].Burst = k.Burst
+			r.keys[i].Revoked = k.Revoked
+		}
+	}
+	return nil
+}
+
+// DeleteAPIKey removes a key.
+func (r *APIKeyRepository) DeleteAPIKey(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keys = slices.DeleteFunc(r.keys, func(k domain.APIKey) bool { return k.ID == id })
+	return nil
+}
+
+// AddAPIKeyUsage adds to a key's usage counts.
+func (r *APIKeyRepository) AddAPIKeyUsage(ctx context.Context, id string, requests, throttled int64, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.keys {
+		k := &r.keys[i]
+		if k.ID != id {
+			continue
+		}
+		k.Requests += requests
+		k.Throttled += throttled
+		if at = at.UTC(); k.LastUsedAt == nil || at.After(*k.LastUsedAt) {
+			k.LastUsedAt = &at
+		}
+	}
+	return nil
+}
+
+func cloneAPIKey(k domain.APIKey) domain.APIKey {
+	if k.LastUsedAt != nil {
+		t := *k.LastUsedAt
+		k.LastUsedAt = &t
+	}
+	return k
+}
@@ -0,0 +1,257 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/merge"
+	"api/internal/repository"
+)
+
+// BillRepository is the in-memory implementation of repository.BillRepository.
+type BillRepository struct {
+	mu          sync.RWMutex
+	bills       map[string]domain.Bill // by ID
+	legislators repository.LegislatorRepository
+}
+
+// NewBillRepository creates a new, empty in-memory BillRepository. Sponsors
+// are populated from legislators, which may be nil.
+func NewBillRepository(legislators repository.LegislatorRepository) *BillRepository {
+	return &BillRepository{bills: map[string]domain.Bill{}, legislators: legislators}
+}
+
+// ListBills returns bills filtered by the given criteria, newest session
+// first and then by bill number, or by latest activity.
+func (r *BillRepository) ListBills(ctx context.Context, f repository.BillFilters) ([]domain.Bill, error) {
+	var ids map[string]bool
+	if f.IDs != nil {
+		ids = make(map[string]bool, len(f.IDs))
+		for _, id := range f.IDs {
+			ids[id] = true
+		}
+	}
+
+	var numbers map[string]bool
+	if f.BillNumbers != nil {
+		numbers = make(map[string]bool, len(f.BillNumbers))
+		for _, n := range f.BillNumbers {
+			numbers[n] = true
+		}
+	}
+
+	r.mu.RLock()
+	var matched []domain.Bill
+	for _, b := range r.bills {
+		if ids != nil && !ids[b.ID] {
+			continue
+		}
+		if numbers != nil && !numbers[b.BillNumber] {
+			continue
+		}
+		if f.SessionYear > 0 && b.SessionYear != f.SessionYear {
+			continue
+		}
+		if f.Status != "" && b.Status != f.Status {
+			continue
+		}
+		if f.SponsorID != "" && b.SponsorID != f.SponsorID {
+			continue
+		}
+		matched = append(matched, cloneBill(b))
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(matched, func(i, j int) bool {
+		if f.ByActivity {
+			ai, aj := matched[i].LastActionDate, matched[j].LastActionDate
+			switch {
+			case ai != nil && aj == nil:
+				return true
+			case ai == nil && aj != nil:
+				return false
+			case ai != nil && !ai.Equal(*aj):
+				return ai.After(*aj)
+			}
+		}
+		if matched[i].SessionYear != matched[j].SessionYear {
+			return matched[i].SessionYear > matched[j].SessionYear
+		}
+		return matched[i].BillNumber < matched[j].BillNumber
+	})
+
+	pageSize := f.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	page := f.Page
+	if page <= 0 {
+		page = 1
+	}
+	start := min((page-1)*pageSize, len(matched))
+	end := min(start+pageSize, len(matched))
+
+	bills := make([]domain.Bill, 0, end-start)
+	for _, b := range matched[start:end] {
+		if !f.OmitSponsor {
+			if err := r.populateSponsor(ctx, &b); err != nil {
+				return nil, err
+			}
+		}
+		bills = append(bills, b)
+	}
+	return bills, nil
+}
+
+// GetBill returns a single bill by its ID, with the sponsor populated.
+func (r *BillRepository) GetBill(ctx context.Context, id string) (*domain.Bill, error) {
+	r.mu.RLock()
+	stored, ok := r.bills[id]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+
+	b := cloneBill(stored)
+	if err := r.populateSponsor(ctx, &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// UpsertBill inserts or updates a bill keyed on (bill_number, session_year),
+// merging fields according to merge.DefaultBillRules.
+func (r *BillRepository) UpsertBill(ctx context.Context, b domain.Bill) error {
+	return r.UpsertBills(ctx, []domain.Bill{b})
+}
+
+// UpsertBills upserts a batch of bills under a single lock, so readers see
+// all of the batch or none of it.
+func (r *BillRepository) UpsertBills(ctx context.Context, bills []domain.Bill) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC()
+	for _, b := range bills {
+		r.upsert(b, now)
+	}
+	return nil
+}
+
+// upsert stores one bill. The caller must hold r.mu.
+func (r *BillRepository) upsert(b domain.Bill, now time.Time) {
+	var existing *domain.Bill
+	for _, stored := range r.bills {
+		if stored.BillNumber == b.BillNumber && stored.SessionYear == b.SessionYear {
+			found := cloneBill(stored)
+			existing = &found
+			break
+		}
+	}
+
+	m := merge.Bill(existing, b, merge.DefaultBillRules, now)
+	if existing == nil {
+		m.ID = newID("bill")
+	}
+	m.Sponsor, m.Source = nil, ""
+	r.bills[m.ID] = cloneBill(m)
+}
+
+// LinkSponsors links bills with no sponsor to the legislator with their
+// SponsorUtahID.
+func (r *BillRepository) LinkSponsors(ctx context.Context) (int, error) {
+	if r.legislators == nil {
+		return 0, nil
+	}
+	legislators, err := r.legislators.ListLegislators(ctx, repository.LegislatorFilters{IncludeFormer: true})
+	if err != nil {
+		return 0, fmt.Errorf("link sponsors: %w", err)
+	}
+	byUtahID := make(map[string]string, len(legislators))
+	for _, l := range legislators {
+		if l.UtahLegislatureID != "" {
+			byUtahID[l.UtahLegislatureID] = l.ID
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	linked := 0
+	for id, b := range r.bills {
+		if b.SponsorID != "" || b.SponsorUtahID == "" {
+			continue
+		}
+		if sponsor, ok := byUtahID[b.SponsorUtahID]; ok {
+			b.SponsorID = sponsor
+			r.bills[id] = b
+			linked++
+		}
+	}
+	return linked, nil
+}
+
+// UnresolvedSponsors returns the sponsor IDs of bills with no sponsor linked.
+func (r *BillRepository) UnresolvedSponsors(ctx context.Context) ([]domain.UnresolvedSponsor, error) {
+	r.mu.RLock()
+	counts := map[string]int{}
+	for _, b := range r.bills {
+		if b.SponsorID == "" && b.SponsorUtahID != "" {
+			counts[b.SponsorUtahID]++
+		}
+	}
+	r.mu.RUnlock()
+
+	out := make([]domain.UnresolvedSponsor, 0, len(counts))
+	for id, n := range counts {
+		out = append(out, domain.UnresolvedSponsor{SponsorUtahID: id, Bills: n})
+	}
+	sortUnresolvedSponsors(out)
+	return out, nil
+}
+
+// sortUnresolvedSponsors orders sponsors by bill count, most first, then ID.
+func sortUnresolvedSponsors(s []domain.UnresolvedSponsor) {
+	sort.Slice(s, func(i, j int) bool {
+		if s[i].Bills != s[j].Bills {
+			return s[i].Bills > s[j].Bills
+		}
+		return s[i].SponsorUtahID < s[j].SponsorUtahID
+	})
+}
+
+// populateSponsor sets b.Sponsor from the legislator store. A sponsor that
+// no longer exists is left unset, as with a dangling relation.
+func (r *BillRepository) populateSponsor(ctx context.Context, b *domain.Bill) error {
+	if b.SponsorID == "" || r.legislators == nil {
+		return nil
+	}
+	l, err := r.legislators.GetLegislator(ctx, b.SponsorID)
+	if err != nil {
+		return err
+	}
+	if l != nil {
+		l.Terms = nil
+		b.Sponsor = l
+	}
+	return nil
+}
+
+// cloneBill deep-copies b so callers can't mutate stored state.
+func cloneBill(b domain.Bill) domain.Bill {
+	if b.LastActionDate != nil {
+		t := *b.LastActionDate
+		b.LastActionDate = &t
+	}
+	if b.EffectiveDate != nil {
+		t := *b.EffectiveDate
+		b.EffectiveDate = &t
+	}
+	b.Provenance = maps.Clone(b.Provenance)
+	b.Sponsor = nil
+	return b
+}
@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"api/internal/domain"
+)
+
+// DeadLetterRepository is the in-memory implementation of repository.DeadLetterRepository.
+type DeadLetterRepository struct {
+	mu      sync.RWMutex
+	letters map[[2]string]domain.DeadLetter // by (kind, key)
+}
+
+// NewDeadLetterRepository creates a new, empty in-memory DeadLetterRepository.
+func NewDeadLetterRepository() *DeadLetterRepository {
+	return &DeadLetterRepository{letters: map[[2]string]domain.DeadLetter{}}
+}
+
+// RecordDeadLetter stores a failure, incrementing the attempt count of a
+// record already dead-lettered.
+func (r *DeadLetterRepository) RecordDeadLetter(ctx context.Context, dl domain.DeadLetter) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC()
+	k := [2]string{dl.Kind, dl.Key}
+	if existing, ok := r.letters[k]; ok {
+		dl.ID, dl.Attempts, dl.FirstFailedAt = existing.ID, existing.Attempts+1, existing.FirstFailedAt
+	} else {
+		dl.ID, dl.Attempts, dl.FirstFailedAt = newID("dl"), 1, now
+	}
+	dl.LastFailedAt = now
+	dl.Payload = append([]byte(nil), dl.Payload...)
+	r.letters[k] = dl
+	return nil
+}
+
+// ListDeadLetters returns dead letters, oldest first.
+func (r *DeadLetterRepository) ListDeadLetters(ctx context.Context, kind string) ([]domain.DeadLetter, error) {
+	r.mu.RLock()
+	out := []domain.DeadLetter{}
+	for _, dl := range r.letters {
+		if kind == "" || dl.Kind == kind {
+			dl.Payload = append([]byte(nil), dl.Payload...)
+			out = append(out, dl)
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].FirstFailedAt.Equal(out[j].FirstFailedAt) {
+			return out[i].FirstFailedAt.Before(out[j].FirstFailedAt)
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out, nil
+}
+
+// ResolveDeadLetter removes a dead letter.
+func (r *DeadLetterRepository) ResolveDeadLetter(ctx context.Context, kind, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.letters, [2]string{kind, key})
+	return nil
+}
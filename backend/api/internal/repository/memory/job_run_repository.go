@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"api/internal/domain"
+)
+
+// JobRunRepository is the in-memory implementation of repository.JobRunRepository.
+type JobRunRepository struct {
+	mu   sync.RWMutex
+	runs map[string]domain.JobRun
+}
+
+// NewJobRunRepository creates a new, empty in-memory JobRunRepository.
+func NewJobRunRepository() *JobRunRepository {
+	return &JobRunRepository{runs: map[string]domain.JobRun{}}
+}
+
+// SaveJobRun inserts or updates a run.
+func (r *JobRunRepository) SaveJobRun(ctx context.Context, run *domain.JobRun) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if run.ID == "" {
+		run.ID = newID("run")
+	} else if _, ok := r.runs[run.ID]; !ok {
+		return fmt.Errorf("save job run: %s not found", run.ID)
+	}
+	r.runs[run.ID] = cloneJobRun(*run)
+	return nil
+}
+
+// ListJobRuns returns the most recent runs, newest first.
+func (r *JobRunRepository) ListJobRuns(ctx context.Context, job string, limit int) ([]domain.JobRun, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	r.mu.RLock()
+	var out []domain.JobRun
+	for _, run := range r.runs {
+		if job == "" || run.Job == job {
+			out = append(out, cloneJobRun(run))
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(out, func(i, j int) bool { return out[i].StartedAt.After(out[j].StartedAt) })
+	return out[:min(limit, len(out))], nil
+}
+
+func cloneJobRun(run domain.JobRun) domain.JobRun {
+	if run.FinishedAt != nil {
+		t := *run.FinishedAt
+		run.FinishedAt = &t
+	}
+	return run
+}
@@ -0,0 +1,350 @@
+// Package memory provides thread-safe, in-memory implementations of the
+// repository interfaces, for tests and local development without a
+// database. Behaviour matches the PocketBase implementation; both are held
+// to the same contract by the repositorytest conformance suite.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/merge"
+	"api/internal/repository"
+)
+
+// idSeq numbers records across all repositories in the process.
+var idSeq atomic.Int64
+
+// newID returns a new unique record ID.
+func newID(prefix string) string {
+	return fmt.Sprintf("%s%d", prefix, idSeq.Add(1))
+}
+
+// LegislatorRepository is the in-memory implementation of repository.LegislatorRepository.
+type LegislatorRepository struct {
+	mu          sync.RWMutex
+	legislators map[string]domain.Legislator // by ID; Chamber/DistrictNumber are the stored seat
+	terms       []domain.Term
+}
+
+// NewLegislatorRepository creates a new, empty in-memory LegislatorRepository.
+func NewLegislatorRepository() *LegislatorRepository {
+	return &LegislatorRepository{legislators: map[string]domain.Legislator{}}
+}
+
+// ListLegislators returns legislators matching the filters. By default only
+// current members are returned; see repository.LegislatorFilters.
+func (r *LegislatorRepository) ListLegislators(ctx context.Context, f repository.LegislatorFilters) ([]domain.Legislator, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// One term per person: the open one if any, else the most recent.
+	best := map[string]domain.Term{}
+	for _, t := range r.terms {
+		if f.Chamber != "" && t.Chamber != f.Chamber {
+			continue
+		}
+		switch {
+		case f.AsOf != nil:
+			if t.Start.IsZero() || t.Start.After(*f.AsOf) || (t.End != nil && !t.End.After(*f.AsOf)) {
+				continue
+			}
+		case !f.IncludeFormer:
+			if t.End != nil {
+				continue
+			}
+		}
+		if cur, ok := best[t.LegislatorID]; !ok || laterTerm(t, cur) {
+			best[t.LegislatorID] = t
+		}
+	}
+
+	legislators := make([]domain.Legislator, 0, len(best))
+	for id, t := range best {
+		l := cloneLegislator(r.legislators[id])
+		applyTerm(&l, cloneTerm(t))
+		legislators = append(legislators, l)
+	}
+	if f.AsOf == nil && f.Chamber == "" {
+		held := map[string]bool{}
+		for _, t := range r.terms {
+			held[t.LegislatorID] = true
+		}
+		for id, stored := range r.legislators {
+			if !held[id] {
+				legislators = append(legislators, cloneLegislator(stored))
+			}
+		}
+	}
+
+	sort.Slice(legislators, func(i, j int) bool {
+		a, b := legislators[i], legislators[j]
+		if a.Chamber != b.Chamber {
+			return a.Chamber < b.Chamber
+		}
+		if a.DistrictNumber != b.DistrictNumber {
+			return a.DistrictNumber < b.DistrictNumber
+		}
+		return a.LastName < b.LastName
+	})
+	return legislators, nil
+}
+
+// GetLegislator returns a single legislator by its ID, with every term they
+// have served in Terms.
+func (r *LegislatorRepository) GetLegislator(ctx context.Context, id string) (*domain.Legislator, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.legislators[id]
+	if !ok {
+		return nil, nil
+	}
+	l := cloneLegislator(stored)
+	l.Terms = r.termsOf(id)
+	if len(l.Terms) > 0 {
+		latest := l.Terms[0]
+		for _, t := range l.Terms[1:] {
+			if laterTerm(t, latest) {
+				latest = t
+			}
+		}
+		l.TermStart, l.TermEnd, l.Current = termDates(latest)
+	}
+	return &l, nil
+}
+
+// GetLegislatorByDistrict returns the legislator currently holding the seat
+// for a given chamber and district number.
+func (r *LegislatorRepository) GetLegislatorByDistrict(ctx context.Context, chamber string, districtNumber int) (*domain.Legislator, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	holder := r.currentSeatHolder(chamber, districtNumber)
+	if holder == nil {
+		return nil, nil
+	}
+	l := cloneLegislator(*holder)
+	return &l, nil
+}
+
+// ListTerms returns every seat a legislator has held, oldest first.
+func (r *LegislatorRepository) ListTerms(ctx context.Context, legislatorID string) ([]domain.Term, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.termsOf(legislatorID), nil
+}
+
+// UpsertLegislator inserts or updates a person, then records their current
+// seat as a Term, resolving identity and merging fields the same way as the
+// PocketBase implementation.
+func (r *LegislatorRepository) UpsertLegislator(ctx context.Context, l domain.Legislator) error {
+	return r.UpsertLegislators(ctx, []domain.Legislator{l})
+}
+
+// UpsertLegislators upserts a batch of legislators in order under a single
+// lock, so readers see all of the batch or none of it.
+func (r *LegislatorRepository) UpsertLegislators(ctx context.Context, legislators []domain.Legislator) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC()
+	for _, l := range legislators {
+		r.upsert(l, now)
+	}
+	return nil
+}
+
+// upsert stores one person and their current seat. The caller must hold r.mu.
+func (r *LegislatorRepository) upsert(l domain.Legislator, now time.Time) {
+	existing := r.resolve(l)
+	m := merge.Legislator(existing, l, merge.DefaultLegislatorRules, now)
+	if existing == nil {
+		m.ID = newID("leg")
+	}
+	// Only stored fields are kept; term dates are derived from terms on read.
+	m.TermStart, m.TermEnd, m.Current, m.Terms, m.Source = nil, nil, false, nil, ""
+	r.legislators[m.ID] = cloneLegislator(m)
+
+	r.syncCurrentTerm(m.ID, m.Chamber, m.DistrictNumber, l.TermStart, now)
+}
+
+// RetireLegislators ends the open terms of every legislator with a Utah
+// Legislature ID missing from keep. An empty keep list retires no one.
+func (r *LegislatorRepository) RetireLegislators(ctx context.Context, keep []string) (int, error) {
+	if len(keep) == 0 {
+		return 0, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC()
+	retired := 0
+	for i, t := range r.terms {
+		id := r.legislators[t.LegislatorID].UtahLegislatureID
+		if t.End != nil || id == "" || slices.Contains(keep, id) {
+			continue
+		}
+		r.terms[i].End = &now
+		retired++
+	}
+	return retired, nil
+}
+
+// resolve finds the stored person an incoming record refers to, or nil if it
+// is someone new: upstream IDs first, then the current holder of the seat
+// unless their IDs conflict. The caller must hold r.mu.
+func (r *LegislatorRepository) resolve(l domain.Legislator) *domain.Legislator {
+	match := []func(domain.Legislator) bool{
+		func(s domain.Legislator) bool {
+			return l.UtahLegislatureID != "" && s.UtahLegislatureID == l.UtahLegislatureID
+		},
+		func(s domain.Legislator) bool { return l.OpenStatesID != "" && s.OpenStatesID == l.OpenStatesID },
+		func(s domain.Legislator) bool { return l.LegiscanID != 0 && s.LegiscanID == l.LegiscanID },
+	}
+	for _, m := range match {
+		for _, s := range r.legislators {
+			if m(s) {
+				found := cloneLegislator(s)
+				return &found
+			}
+		}
+	}
+
+	holder := r.currentSeatHolder(l.Chamber, l.DistrictNumber)
+	if holder == nil || merge.ConflictingIDs(*holder, l) {
+		return nil
+	}
+	found := cloneLegislator(*holder)
+	return &found
+}
+
+// currentSeatHolder returns the stored person with an open term for the
+// seat, or nil. The caller must hold r.mu.
+func (r *LegislatorRepository) currentSeatHolder(chamber string, districtNumber int) *domain.Legislator {
+	if chamber == "" || districtNumber == 0 {
+		return nil
+	}
+	for _, t := range r.terms {
+		if t.End == nil && t.Chamber == chamber && t.DistrictNumber == districtNumber {
+			if l, ok := r.legislators[t.LegislatorID]; ok {
+				return &l
+			}
+		}
+	}
+	return nil
+}
+
+// syncCurrentTerm makes (chamber, districtNumber) the one open term for
+// legislatorID, closing the person's other open terms and anyone else's open
+// term on the seat. startHint only dates a person's first term, moving its
+// start back if it is already open on this seat. The caller must hold r.mu.
+func (r *LegislatorRepository) syncCurrentTerm(legislatorID, chamber string, districtNumber int, startHint *time.Time, now time.Time) {
+	if chamber == "" || districtNumber == 0 {
+		return
+	}
+
+	held, previous := -1, 0
+	for i, t := range r.terms {
+		mine := t.LegislatorID == legislatorID
+		if mine {
+			previous++
+		}
+		sameSeat := t.Chamber == chamber && t.DistrictNumber == districtNumber
+		if t.End != nil || (!mine && !sameSeat) {
+			continue
+		}
+		if mine && sameSeat {
+			held = i
+			continue
+		}
+		end := now
+		r.terms[i].End = &end
+	}
+	if held >= 0 {
+		if t := &r.terms[held]; startHint != nil && previous == 1 && (t.Start.IsZero() || startHint.Before(t.Start)) {
+			t.Start = *startHint
+		}
+		return
+	}
+
+	start := now
+	if startHint != nil && previous == 0 {
+		start = *startHint
+	}
+	r.terms = append(r.terms, domain.Term{
+		ID:             newID("term"),
+		LegislatorID:   legislatorID,
+		Chamber:        chamber,
+		DistrictNumber: districtNumber,
+		Start:          start,
+	})
+}
+
+// termsOf returns copies of a person's terms, oldest first. The caller must
+// hold r.mu.
+func (r *LegislatorRepository) termsOf(legislatorID string) []domain.Term {
+	var out []domain.Term
+	for _, t := range r.terms {
+		if t.LegislatorID == legislatorID {
+			out = append(out, cloneTerm(t))
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Start.Before(out[j].Start) })
+	return out
+}
+
+// laterTerm reports whether a should represent a person over b: an open
+// term beats a closed one, then the later end, then the later start.
+func laterTerm(a, b domain.Term) bool {
+	switch {
+	case a.End == nil && b.End != nil:
+		return true
+	case a.End != nil && b.End == nil:
+		return false
+	case a.End != nil && !a.End.Equal(*b.End):
+		return a.End.After(*b.End)
+	default:
+		return a.Start.After(b.Start)
+	}
+}
+
+// applyTerm sets a legislator's seat and term dates from t.
+func applyTerm(l *domain.Legislator, t domain.Term) {
+	l.Chamber = t.Chamber
+	l.DistrictNumber = t.DistrictNumber
+	l.TermStart, l.TermEnd, l.Current = termDates(t)
+}
+
+// termDates returns a term's start (nil if unknown), end, and whether it is open.
+func termDates(t domain.Term) (start, end *time.Time, current bool) {
+	if !t.Start.IsZero() {
+		s := t.Start
+		start = &s
+	}
+	return start, t.End, t.End == nil
+}
+
+// cloneLegislator deep-copies l so callers can't mutate stored state.
+func cloneLegislator(l domain.Legislator) domain.Legislator {
+	l.Offices = slices.Clone(l.Offices)
+	l.Provenance = maps.Clone(l.Provenance)
+	l.Terms = nil
+	return l
+}
+
+func cloneTerm(t domain.Term) domain.Term {
+	if t.End != nil {
+		end := *t.End
+		t.End = &end
+	}
+	return t
+}
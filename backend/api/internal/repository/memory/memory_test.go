@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/repository/repositorytest"
+)
+
+func newStores(t *testing.T) repositorytest.Stores {
+	legislators := NewLegislatorRepository()
+	return repositorytest.Stores{
+		Bills:       NewBillRepository(legislators),
+		Legislators: legislators,
+		Stats:       NewStatsRepository(),
+		Sync:        NewSyncRepository(),
+		Runs:        NewJobRunRepository(),
+		DeadLetters: NewDeadLetterRepository(),
+		Watchlist:   NewWatchlistRepository(),
+
+		Notifications: NewNotificationRepository(),
+		Webhooks:      NewWebhookRepository(),
+		APIKeys:       NewAPIKeyRepository(),
+	}
+}
+
+func TestLegislatorRepository(t *testing.T) {
+	repositorytest.TestLegislatorRepository(t, newStores)
+}
+
+func TestBillRepository(t *testing.T) {
+	repositorytest.TestBillRepository(t, newStores)
+}
+
+func TestStatsRepository(t *testing.T) {
+	repositorytest.TestStatsRepository(t, newStores)
+}
+
+func TestSyncRepository(t *testing.T) {
+	repositorytest.TestSyncRepository(t, newStores)
+}
+
+func TestJobRunRepository(t *testing.T) {
+	repositorytest.TestJobRunRepository(t, newStores)
+}
+
+func TestDeadLetterRepository(t *testing.T) {
+	repositorytest.TestDeadLetterRepository(t, newStores)
+}
+
+// TestConcurrentUpserts is meant for -race: concurrent writers and readers
+// must not corrupt the store or lose updates.
+func TestConcurrentUpserts(t *testing.T) {
+	ctx := context.Background()
+	stores := newStores(t)
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			l := domain.Legislator{
+				Chamber:           "house",
+				DistrictNumber:    i,
+				FirstName:         "First",
+				LastName:          fmt.Sprintf("Last%d", i),
+				UtahLegislatureID: fmt.Sprintf("ID%d", i),
+				Source:            domain.SourceUtahLegislature,
+			}
+			if err := stores.Legislators.UpsertLegislator(ctx, l); err != nil {
+				t.Error(err)
+			}
+			b := domain.Bill{BillNumber: fmt.Sprintf("HB%04d", i), SessionYear: 2026, Source: domain.SourceUtahLegislature}
+			if err := stores.Bills.UpsertBill(ctx, b); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := stores.Legislators.ListLegislators(ctx, repository.LegislatorFilters{}); err != nil {
+				t.Error(err)
+			}
+			if _, err := stores.Bills.ListBills(ctx, repository.BillFilters{}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	ls, _ := stores.Legislators.ListLegislators(ctx, repository.LegislatorFilters{})
+	bills, _ := stores.Bills.ListBills(ctx, repository.BillFilters{})
+	if len(ls) != 20 || len(bills) != 20 {
+		t.Errorf("got %d legislators and %d bills, want 20 each", len(ls), len(bills))
+	}
+}
+
+func TestWatchlistRepository(t *testing.T) {
+	repositorytest.TestWatchlistRepository(t, newStores)
+}
+
+func TestNotificationRepository(t *testing.T) {
+	repositorytest.TestNotificationRepository(t, newStores)
+}
+
+func TestWebhookRepository(t *testing.T) {
+	repositorytest.TestWebhookRepository(t, newStores)
+}
+
+func TestAPIKeyRepository(t *testing.T) {
+	repositorytest.TestAPIKeyRepository(t, newStores)
+}
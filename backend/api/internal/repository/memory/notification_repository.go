@@ -0,0 +1,155 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"api/internal/domain"
+)
+
+// NotificationRepository is the in-memory implementation of repository.NotificationRepository.
+// Subscriptions, devices and notifications are kept in insertion order,
+// which is oldest first.
+type NotificationRepository struct {
+	mu            sync.RWMutex
+	subscriptions []domain.NotificationSubscription
+	settings      map[string]domain.NotificationSettings // by user
+	devices       []domain.Device
+	outbox        []domain.Notification // not yet sent
+}
+
+// NewNotificationRepository creates a new, empty in-memory NotificationRepository.
+func NewNotificationRepository() *NotificationRepository {
+	return &NotificationRepository{settings: map[string]domain.NotificationSettings{}}
+}
+
+// SaveSubscription stores a new subscription.
+func (r *NotificationRepository) SaveSubscription(ctx context.Context, s domain.NotificationSubscription) (domain.NotificationSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s.ID, s.CreatedAt = newID("sub"), time.Now().UTC()
+	s.EventTypes, s.Channels = slices.Clone(s.EventTypes), slices.Clone(s.Channels)
+	r.subscriptions = append(r.subscriptions, s)
+	return s, nil
+}
+
+// DeleteSubscription removes one of a user's subscriptions.
+func (r *NotificationRepository) DeleteSubscription(ctx context.Context, userID, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscriptions = slices.DeleteFunc(r.subscriptions, func(s domain.NotificationSubscription) bool {
+		return s.UserID == userID && s.ID == id
+	})
+	return nil
+}
+
+// ListSubscriptions returns a user's subscriptions, or everyone's.
+func (r *NotificationRepository) ListSubscriptions(ctx context.Context, userID string) ([]domain.NotificationSubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := []domain.NotificationSubscription{}
+	for _, s := range r.subscriptions {
+		if userID == "" || s.UserID == userID {
+			s.EventTypes, s.Channels = slices.Clone(s.EventTypes), slices.Clone(s.Channels)
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+// GetNotificationSettings returns a user's settings, or the defaults.
+func (r *NotificationRepository) GetNotificationSettings(ctx context.Context, userID string) (domain.NotificationSettings, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.settings[userID]
+	if !ok {
+		return domain.DefaultNotificationSettings(userID), nil
+	}
+	return s, nil
+}
+
+// SaveNotificationSettings replaces a user's settings.
+func (r *NotificationRepository) SaveNotificationSettings(ctx context.Context, s domain.NotificationSettings) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.settings[s.UserID] = s
+	return nil
+}
+
+// SaveDevice registers a push destination.
+func (r *NotificationRepository) SaveDevice(ctx context.Context, d domain.Device) (domain.Device, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	i := slices.IndexFunc(r.devices, func(e domain.Device) bool {
+		return e.Channel == d.Channel && e.Token == d.Token
+	})
+	if i >= 0 {
+		d.ID, d.CreatedAt = r.devices[i].ID, r.devices[i].CreatedAt
+		r.devices[i] = d
+		return d, nil
+	}
+	d.ID, d.CreatedAt = newID("device"), time.Now().UTC()
+	r.devices = append(r.devices, d)
+	return d, nil
+}
+
+// DeleteDevice removes a user's device.
+func (r *NotificationRepository) DeleteDevice(ctx context.Context, userID, channel, token string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.devices = slices.DeleteFunc(r.devices, func(d domain.Device) bool {
+		return d.UserID == userID && d.Channel == channel && d.Token == token
+	})
+	return nil
+}
+
+// ListDevices returns a user's devices.
+func (r *NotificationRepository) ListDevices(ctx context.Context, userID string) ([]domain.Device, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := []domain.Device{}
+	for _, d := range r.devices {
+		if d.UserID == userID {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+// EnqueueNotifications adds notifications to the outbox.
+func (r *NotificationRepository) EnqueueNotifications(ctx context.Context, ns []domain.Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now().UTC()
+	for _, n := range ns {
+		n.ID, n.CreatedAt = newID("notification"), now
+		n.Channels = slices.Clone(n.Channels)
+		r.outbox = append(r.outbox, n)
+	}
+	return nil
+}
+
+// PendingNotifications returns the outbox, oldest first.
+func (r *NotificationRepository) PendingNotifications(ctx context.Context) ([]domain.Notification, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]domain.Notification, 0, len(r.outbox))
+	for _, n := range r.outbox {
+		n.Channels = slices.Clone(n.Channels)
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+// MarkNotificationsSent takes notifications out of the outbox. Sent
+// notifications aren't kept.
+func (r *NotificationRepository) MarkNotificationsSent(ctx context.Context, ids []string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.outbox = slices.DeleteFunc(r.outbox, func(n domain.Notification) bool {
+		return slices.Contains(ids, n.ID)
+	})
+	return nil
+}
@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+
+	"api/internal/domain"
+)
+
+// StatsRepository is the in-memory implementation of repository.StatsRepository.
+type StatsRepository struct {
+	mu        sync.RWMutex
+	bySession map[int][]domain.LegislatorStats
+}
+
+// NewStatsRepository creates a new, empty in-memory StatsRepository.
+func NewStatsRepository() *StatsRepository {
+	return &StatsRepository{bySession: map[int][]domain.LegislatorStats{}}
+}
+
+// GetLegislatorStats returns a legislator's per-session stats, newest first.
+func (r *StatsRepository) GetLegislatorStats(ctx context.Context, legislatorID string, sessionYear int) ([]domain.LegislatorStats, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var out []domain.LegislatorStats
+	for _, stats := range r.bySession {
+		for _, s := range stats {
+			if s.LegislatorID != legislatorID || (sessionYear != 0 && s.SessionYear != sessionYear) {
+				continue
+			}
+			s.Agreement = slices.Clone(s.Agreement)
+			out = append(out, s)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].SessionYear != out[j].SessionYear {
+			return out[i].SessionYear > out[j].SessionYear
+		}
+		return out[i].SessionID > out[j].SessionID
+	})
+	return out, nil
+}
+
+// SessionDatasetHash returns the dataset hash of a session's stats.
+func (r *StatsRepository) SessionDatasetHash(ctx context.Context, sessionID int) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if stats := r.bySession[sessionID]; len(stats) > 0 {
+		return stats[0].DatasetHash, nil
+	}
+	return "", nil
+}
+
+// ReplaceSessionStats replaces every stored stat for a session.
+func (r *StatsRepository) ReplaceSessionStats(ctx context.Context, sessionID int, stats []domain.LegislatorStats) error {
+	stored := make([]domain.LegislatorStats, 0, len(stats))
+	for _, s := range stats {
+		s.SessionID = sessionID
+		s.Agreement = slices.Clone(s.Agreement)
+		stored = append(stored, s)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bySession[sessionID] = stored
+	return nil
+}
@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"api/internal/domain"
+)
+
+// SyncRepository is the in-memory implementation of repository.SyncRepository.
+type SyncRepository struct {
+	mu       sync.RWMutex
+	versions map[string]domain.SyncVersion
+}
+
+// NewSyncRepository creates a new, empty in-memory SyncRepository.
+func NewSyncRepository() *SyncRepository {
+	return &SyncRepository{versions: map[string]domain.SyncVersion{}}
+}
+
+// GetSyncVersion returns the current marker for entity.
+func (r *SyncRepository) GetSyncVersion(ctx context.Context, entity string) (domain.SyncVersion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if v, ok := r.versions[entity]; ok {
+		return v, nil
+	}
+	return domain.SyncVersion{Entity: entity}, nil
+}
+
+// BumpSyncVersion increments entity's version and sets SyncedAt to now.
+func (r *SyncRepository) BumpSyncVersion(ctx context.Context, entity string) (domain.SyncVersion, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	v := r.versions[entity]
+	v.Entity = entity
+	v.Version++
+	v.SyncedAt = time.Now().UTC()
+	r.versions[entity] = v
+	return v, nil
+}
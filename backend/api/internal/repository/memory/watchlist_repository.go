@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"api/internal/domain"
+)
+
+// WatchlistRepository is the in-memory implementation of repository.WatchlistRepository.
+type WatchlistRepository struct {
+	mu      sync.RWMutex
+	follows map[[3]string]domain.Follow // by (user, kind, target)
+}
+
+// NewWatchlistRepository creates a new, empty in-memory WatchlistRepository.
+func NewWatchlistRepository() *WatchlistRepository {
+	return &WatchlistRepository{follows: map[[3]string]domain.Follow{}}
+}
+
+// Follow adds an item to a user's watchlist.
+func (r *WatchlistRepository) Follow(ctx context.Context, f domain.Follow) (domain.Follow, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := [3]string{f.UserID, f.Kind, f.TargetID}
+	if existing, ok := r.follows[k]; ok {
+		return existing, nil
+	}
+	f.ID, f.CreatedAt = newID("follow"), time.Now().UTC()
+	r.follows[k] = f
+	return f, nil
+}
+
+// Unfollow removes an item from a user's watchlist.
+func (r *WatchlistRepository) Unfollow(ctx context.Context, userID, kind, targetID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.follows, [3]string{userID, kind, targetID})
+	return nil
+}
+
+// ListFollows returns a user's follows, newest first.
+func (r *WatchlistRepository) ListFollows(ctx context.Context, userID, kind string) ([]domain.Follow, error) {
+	r.mu.RLock()
+	out := []domain.Follow{}
+	for _, f := range r.follows {
+		if f.UserID == userID && (kind == "" || f.Kind == kind) {
+			out = append(out, f)
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].CreatedAt.After(out[j].CreatedAt)
+		}
+		return out[i].ID > out[j].ID
+	})
+	return out, nil
+}
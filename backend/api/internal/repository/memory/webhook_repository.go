@@ -0,0 +1,164 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"api/internal/domain"
+)
+
+// WebhookRepository is the in-memory implementation of repository.WebhookRepository.
+// Webhooks and deliveries are kept in insertion order, which is oldest
+// first.
+type WebhookRepository struct {
+	mu         sync.RWMutex
+	webhooks   []domain.Webhook
+	deliveries []domain.WebhookDelivery
+}
+
+// NewWebhookRepository creates a new, empty in-memory WebhookRepository.
+func NewWebhookRepository() *WebhookRepository {
+	return &WebhookRepository{}
+}
+
+// CreateWebhook stores a new webhook.
+func (r *WebhookRepository) CreateWebhook(ctx context.Context, w domain.Webhook) (domain.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	w.ID, w.CreatedAt = newID("webhook"), time.Now().UTC()
+	w = cloneWebhook(w)
+	r.webhooks = append(r.webhooks, w)
+	return cloneWebhook(w), nil
+}
+
+// GetWebhook returns a webhook by ID.
+func (r *WebhookRepository) GetWebhook(ctx context.Context, id string) (*domain.Webhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, w := range r.webhooks {
+		if w.ID == id {
+			w = cloneWebhook(w)
+			return &w, nil
+		}
+	}
+	return nil, nil
+}
+
+// ListWebhooks returns an owner's webhooks, or everyone's.
+func (r *WebhookRepository) ListWebhooks(ctx context.Context, ownerID string) ([]domain.Webhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := []domain.Webhook{}
+	for _, w := range r.webhooks {
+		if ownerID == "" || w.OwnerID == ownerID {
+			out = append(out, cloneWebhook(w))
+		}
+	}
+	return out, nil
+}
+
+// DeleteWebhook removes one of an owner's webhooks and its deliveries.
+func (r *WebhookRepository) DeleteWebhook(ctx context.Context, ownerID, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	before := len(r.webhooks)
+	r.webhooks = slices.DeleteFunc(r.webhooks, func(w domain.Webhook) bool {
+		return w.OwnerID == ownerID && w.ID == id
+	})
+	if len(r.webhooks) < before {
+		r.deliveries = slices.DeleteFunc(r.deliveries, func(d domain.WebhookDelivery) bool {
+			return d.WebhookID == id
+		})
+	}
+	return nil
+}
+
+// EnqueueDeliveries adds deliveries to the log.
+func (r *WebhookRepository) EnqueueDeliveries(ctx context.Context, ds []domain.WebhookDelivery) ([]domain.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now().UTC()
+	out := make([]domain.WebhookDelivery, 0, len(ds))
+	for _, d := range ds {
+		d.ID, d.CreatedAt = newID("delivery"), now
+		r.deliveries = append(r.deliveries, cloneDelivery(d))
+		out = append(out, cloneDelivery(d))
+	}
+	return out, nil
+}
+
+// DueDeliveries returns the pending deliveries due by now, oldest first.
+func (r *WebhookRepository) DueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := []domain.WebhookDelivery{}
+	for _, d := range r.deliveries {
+		if limit > 0 && len(out) == limit {
+			break
+		}
+		if d.Status == domain.DeliveryPending && !d.NextAttemptAt.After(now) {
+			out = append(out, cloneDelivery(d))
+		}
+	}
+	return out, nil
+}
+
+// UpdateDelivery stores the outcome of an attempt.
+func (r *WebhookRepository) UpdateDelivery(ctx context.Context, d domain.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, s := range r.deliveries {
+		if s.ID != d.ID {
+			continue
+		}
+		s.Status, s.Attempts, s.NextAttemptAt, s.LastAttemptAt = d.Status, d.Attempts, d.NextAttemptAt, d.LastAttemptAt
+		s.ResponseStatus, s.LastError = d.ResponseStatus, d.LastError
+		r.deliveries[i] = s
+	}
+	return nil
+}
+
+// GetDelivery returns a delivery by ID.
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, d := range r.deliveries {
+		if d.ID == id {
+			d = cloneDelivery(d)
+			return &d, nil
+		}
+	}
+	return nil, nil
+}
+
+// ListDeliveries returns a webhook's most recent deliveries, newest first.
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, webhookID string, limit int) ([]domain.WebhookDelivery, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := []domain.WebhookDelivery{}
+	for i := len(r.deliveries) - 1; i >= 0 && len(out) < limit; i-- {
+		if d := r.deliveries[i]; d.WebhookID == webhookID {
+			out = append(out, cloneDelivery(d))
+		}
+	}
+	return out, nil
+}
+
+func cloneWebhook(w domain.Webhook) domain.Webhook {
+	w.EventTypes, w.Keywords = slices.Clone(w.EventTypes), slices.Clone(w.Keywords)
+	return w
+}
+
+func cloneDelivery(d domain.WebhookDelivery) domain.WebhookDelivery {
+	d.Payload = slices.Clone(d.Payload)
+	if d.LastAttemptAt != nil {
+		t := *d.LastAttemptAt
+		d.LastAttemptAt = &t
+	}
+	return d
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"api/internal/domain"
+)
+
+// NotificationRepository stores users' notification subscriptions, settings
+// and push devices, and the outbox of notifications waiting to be sent.
+type NotificationRepository interface {
+	// SaveSubscription stores a new subscription and returns it with its
+	// ID and CreatedAt set.
+	SaveSubscription(ctx context.Context, s domain.NotificationSubscription) (domain.NotificationSubscription, error)
+	// DeleteSubscription removes one of a user's subscriptions, if it
+	// exists.
+	DeleteSubscription(ctx context.Context, userID, id string) error
+	// ListSubscriptions returns a user's subscriptions, oldest first, or
+	// every user's if userID is empty.
+	ListSubscriptions(ctx context.Context, userID string) ([]domain.NotificationSubscription, error)
+
+	// GetNotificationSettings returns a user's settings, or
+	// domain.DefaultNotificationSettings if they have stored none.
+	GetNotificationSettings(ctx context.Context, userID string) (domain.NotificationSettings, error)
+	// SaveNotificationSettings replaces a user's settings.
+	SaveNotificationSettings(ctx context.Context, s domain.NotificationSettings) error
+
+	// SaveDevice registers a push destination keyed on (Channel, Token),
+	// moving it to d's user if another user had registered it, and returns
+	// the stored device.
+	SaveDevice(ctx context.Context, d domain.Device) (domain.Device, error)
+	// DeleteDevice removes a user's device, if it is registered.
+	DeleteDevice(ctx context.Context, userID, channel, token string) error
+	// ListDevices returns a user's devices, oldest first.
+	ListDevices(ctx context.Context, userID string) ([]domain.Device, error)
+
+	// EnqueueNotifications adds notifications to the outbox. Their IDs and
+	// CreatedAt are assigned.
+	EnqueueNotifications(ctx context.Context, ns []domain.Notification) error
+	// PendingNotifications returns the notifications not yet marked sent,
+	// oldest first.
+	PendingNotifications(ctx context.Context) ([]domain.Notification, error)
+	// MarkNotificationsSent takes notifications out of the outbox.
+	MarkNotificationsSent(ctx context.Context, ids []string, at time.Time) error
+}
@@ -0,0 +1,167 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"api/internal/domain"
+)
+
+// APIKeyRepository is the PocketBase implementation of repository.APIKeyRepository.
+type APIKeyRepository struct {
+	app core.App
+}
+
+// NewAPIKeyRepository creates a new PocketBase-backed APIKeyRepository.
+func NewAPIKeyRepository(app core.App) *APIKeyRepository {
+	return &APIKeyRepository{app: app}
+}
+
+const apiKeyCollection = "api_keys"
+
+// CreateAPIKey stores a new key.
+func (r *APIKeyRepository) CreateAPIKey(ctx context.Context, k domain.APIKey) (domain.APIKey, error) {
+	collection, err := r.app.FindCollectionByNameOrId(apiKeyCollection)
+	if err != nil {
+		return domain.APIKey{}, fmt.Errorf("find collection: %w", err)
+	}
+	rec := core.NewRecord(collection)
+	rec.Set("prefix", k.Prefix)
+	rec.Set("hash", k.Hash)
+	setAPIKeySettings(rec, k)
+	rec.Set("requests", k.Requests)
+	rec.Set("throttled", k.Throttled)
+	rec.Set("last_used_at", dateOrEmpty(k.LastUsedAt))
+	rec.Set("created_at", time.Now().UTC().Truncate(time.Millisecond)) // as stored
+	if err := r.app.Save(rec); err != nil {
+		return domain.APIKey{}, fmt.Errorf("create api key: %w", err)
+	}
+	return apiKeyFromRecord(rec), nil
+}
+
+// GetAPIKey returns a key by ID.
+func (r *APIKeyRepository) GetAPIKey(ctx context.Context, id string) (*domain.APIKey, error) {
+	rec, err := r.app.FindRecordById(apiKeyCollection, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get api key %s: %w", id, err)
+	}
+	k := apiKeyFromRecord(rec)
+	return &k, nil
+}
+
+// GetAPIKeyByHash returns the key with the given hash.
+func (r *APIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	rec, err := r.app.FindFirstRecordByData(apiKeyCollection, "hash", hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get api key by hash: %w", err)
+	}
+	k := apiKeyFromRecord(rec)
+	return &k, nil
+}
+
+// ListAPIKeys returns every key, oldest first.
+func (r *APIKeyRepository) ListAPIKeys(ctx context.Context) ([]domain.APIKey, error) {
+	records, err := r.app.FindRecordsByFilter(apiKeyCollection, "", "created_at, @rowid", 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+	out := make([]domain.APIKey, 0, len(records))
+	for _, rec := range records {
+		out = append(out, apiKeyFromRecord(rec))
+	}
+	return out, nil
+}
+
+// UpdateAPIKey stores a key's name, limits and revocation.
+func (r *APIKeyRepository) UpdateAPIKey(ctx context.Context, k domain.APIKey) error {
+	rec, err := r.app.FindRecordById(apiKeyCollection, k.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("update api key %s: %w", k.ID, err)
+	}
+	setAPIKeySettings(rec, k)
+	if err := r.app.Save(rec); err != nil {
+		return fmt.Errorf("update api key %s: %w", k.ID, err)
+	}
+	return nil
+}
+
+// DeleteAPIKey removes a key.
+func (r *APIKeyRepository) DeleteAPIKey(ctx context.Context, id string) error {
+	rec, err := r.app.FindRecordById(apiKeyCollection, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("delete api key %s: %w", id, err)
+	}
+	if err := r.app.Delete(rec); err != nil {
+		return fmt.Errorf("delete api key %s: %w", id, err)
+	}
+	return nil
+}
+
+// AddAPIKeyUsage adds to a key's usage counts in one transaction, so
+// concurrent updates aren't lost.
+func (r *APIKeyRepository) AddAPIKeyUsage(ctx context.Context, id string, requests, throttled int64, at time.Time) error {
+	return r.app.RunInTransaction(func(tx core.App) error {
+		rec, err := tx.FindFirstRecordByFilter(apiKeyCollection, "id = {:id}", dbx.Params{"id": id})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("add api key usage %s: %w", id, err)
+		}
+		rec.Set("requests", int64(rec.GetFloat("requests"))+requests)
+		rec.Set("throttled", int64(rec.GetFloat("throttled"))+throttled)
+		if last := rec.GetDateTime("last_used_at"); last.IsZero() || at.After(last.Time()) {
+			rec.Set("last_used_at", at.UTC())
+		}
+		if err := tx.Save(rec); err != nil {
+			return fmt.Errorf("add api key usage %s: %w", id, err)
+		}
+		return nil
+	})
+}
+
+// setAPIKeySettings sets the fields UpdateAPIKey stores.
+func setAPIKeySettings(rec *core.Record, k domain.APIKey) {
+	rec.Set("name", k.Name)
+	rec.Set("rate_per_minute", k.RatePerMinute)
+	rec.Set("burst", k.Burst)
+	rec.Set("revoked", k.Revoked)
+}
+
+func apiKeyFromRecord(rec *core.Record) domain.APIKey {
+	k := domain.APIKey{
+		ID:            rec.Id,
+		Name:          rec.GetString("name"),
+		Prefix:        rec.GetString("prefix"),
+		Hash:          rec.GetString("hash"),
+		RatePerMinute: rec.GetInt("rate_per_minute"),
+		Burst:         rec.GetInt("burst"),
+		Revoked:       rec.GetBool("revoked"),
+		Requests:      int64(rec.GetFloat("requests")),
+		Throttled:     int64(rec.GetFloat("throttled")),
+		CreatedAt:     rec.GetDateTime("created_at").Time(),
+	}
+	if t := rec.GetDateTime("last_used_at"); !t.IsZero() {
+		tt := t.Time()
+		k.LastUsedAt = &tt
+	}
+	return k
+}
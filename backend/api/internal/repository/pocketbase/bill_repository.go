@@ -5,11 +5,15 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/pocketbase/dbx"
 	"github.com/pocketbase/pocketbase/core"
 
 	"api/internal/domain"
+	"api/internal/merge"
 	"api/internal/repository"
 )
 
@@ -30,6 +34,29 @@ func (r *BillRepository) ListBills(ctx context.Context, f repository.BillFilters
 	filterParts := []string{}
 	params := map[string]any{}
 
+	if f.IDs != nil {
+		if len(f.IDs) == 0 {
+			return []domain.Bill{}, nil
+		}
+		ids := make([]string, len(f.IDs))
+		for i, id := range f.IDs {
+			ids[i] = fmt.Sprintf("id = {:id%d}", i)
+			params[fmt.Sprintf("id%d", i)] = id
+		}
+		filterParts = append(filterParts, "("+strings.Join(ids, " || ")+")")
+	}
+	if f.BillNumbers != nil {
+		if len(f.BillNumbers) == 0 {
+			return []domain.Bill{}, nil
+		}
+		numbers := make([]string, len(f.BillNumbers))
+		for i, n := range f.BillNumbers {
+			numbers[i] = fmt.Sprintf("bill_number = {:number%d}", i)
+			params[fmt.Sprintf("number%d", i)] = n
+		}
+		filterParts = append(filterParts, "("+strings.Join(numbers, " || ")+")")
+	}
+
 	if f.SessionYear > 0 {
 		filterParts = append(filterParts, "session_year = {:session_year}")
 		params["session_year"] = f.SessionYear
@@ -57,10 +84,16 @@ func (r *BillRepository) ListBills(ctx context.Context, f repository.BillFilters
 		page = 1
 	}
 
+	order := "-session_year, bill_number"
+	if f.ByActivity {
+		// Empty dates sort last when descending.
+		order = "-last_action_date, -session_year, bill_number"
+	}
+
 	records, err := r.app.FindRecordsByFilter(
 		billCollection,
 		filter,
-		"-session_year, bill_number",
+		order,
 		pageSize,
 		(page-1)*pageSize,
 		params,
@@ -71,11 +104,12 @@ func (r *BillRepository) ListBills(ctx context.Context, f repository.BillFilters
 
 	bills := make([]domain.Bill, 0, len(records))
 	for _, rec := range records {
-		bill, err := r.recordToBill(rec)
-		if err != nil {
-			return nil, fmt.Errorf("convert record to bill: %w", err)
+		bills = append(bills, billFromRecord(rec))
+	}
+	if !f.OmitSponsor {
+		if err := populateSponsors(r.app, bills); err != nil {
+			return nil, fmt.Errorf("list bills: %w", err)
 		}
-		bills = append(bills, *bill)
 	}
 	return bills, nil
 }
@@ -89,65 +123,230 @@ func (r *BillRepository) GetBill(ctx context.Context, id string) (*domain.Bill,
 		}
 		return nil, fmt.Errorf("get bill: %w", err)
 	}
-	return r.recordToBill(rec)
+	bills := []domain.Bill{billFromRecord(rec)}
+	if err := populateSponsors(r.app, bills); err != nil {
+		return nil, fmt.Errorf("get bill: %w", err)
+	}
+	return &bills[0], nil
 }
 
 // UpsertBill inserts or updates a bill record keyed on (bill_number, session_year).
+// Incoming fields are merged with the stored record according to
+// merge.DefaultBillRules, and per-field provenance is recorded.
 func (r *BillRepository) UpsertBill(ctx context.Context, b domain.Bill) error {
-	// Check if bill exists by bill_number and session_year
-	records, err := r.app.FindRecordsByFilter(
-		billCollection,
-		"bill_number = {:bill_number} && session_year = {:session_year}",
-		"",
-		1,
-		0,
-		map[string]any{"bill_number": b.BillNumber, "session_year": b.SessionYear},
-	)
-	if err != nil {
-		return fmt.Errorf("find existing bill: %w", err)
-	}
+	return r.UpsertBills(ctx, []domain.Bill{b})
+}
 
-	var rec *core.Record
-	if len(records) > 0 {
-		rec = records[0]
-	} else {
-		collection, err := r.app.FindCollectionByNameOrId(billCollection)
+// UpsertBills upserts a batch of bills in a single transaction: either every
+// bill is written or none is. The batch's existing bills are loaded in one
+// query up front instead of being looked up one by one.
+func (r *BillRepository) UpsertBills(ctx context.Context, bills []domain.Bill) error {
+	if len(bills) == 0 {
+		return nil
+	}
+	return r.app.RunInTransaction(func(tx core.App) error {
+		collection, err := tx.FindCollectionByNameOrId(billCollection)
 		if err != nil {
 			return fmt.Errorf("find collection: %w", err)
 		}
-		rec = core.NewRecord(collection)
+		index, err := loadBillIndex(tx, bills)
+		if err != nil {
+			return fmt.Errorf("load existing bills: %w", err)
+		}
+
+		now := time.Now().UTC()
+		for _, b := range bills {
+			key := billKey{b.BillNumber, b.SessionYear}
+			rec, ok := index[key]
+			var existing *domain.Bill
+			if ok {
+				stored := billFromRecord(rec)
+				existing = &stored
+			} else {
+				rec = core.NewRecord(collection)
+			}
+
+			// Merge field by field so a lower-priority source can't overwrite
+			// values supplied by a more authoritative one.
+			m := merge.Bill(existing, b, merge.DefaultBillRules, now)
+			setBillFields(rec, m)
+
+			if err := tx.Save(rec); err != nil {
+				return fmt.Errorf("upsert bill %s: %w", b.BillNumber, err)
+			}
+			index[key] = rec
+		}
+		return nil
+	})
+}
+
+// LinkSponsors links bills with no sponsor to the legislator with their
+// SponsorUtahID, in one transaction.
+func (r *BillRepository) LinkSponsors(ctx context.Context) (int, error) {
+	linked := 0
+	err := r.app.RunInTransaction(func(tx core.App) error {
+		linked = 0
+		records, err := unresolvedBills(tx)
+		if err != nil || len(records) == 0 {
+			return err
+		}
+		legislators, err := tx.FindAllRecords(legislatorCollection, dbx.NewExp("utah_legislature_id != ''"))
+		if err != nil {
+			return err
+		}
+		byUtahID := make(map[string]string, len(legislators))
+		for _, l := range legislators {
+			byUtahID[l.GetString("utah_legislature_id")] = l.Id
+		}
+
+		for _, rec := range records {
+			sponsor, ok := byUtahID[rec.GetString("sponsor_utah_id")]
+			if !ok {
+				continue
+			}
+			rec.Set("sponsor", sponsor)
+			if err := tx.Save(rec); err != nil {
+				return fmt.Errorf("link sponsor of bill %s: %w", rec.GetString("bill_number"), err)
+			}
+			linked++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("link sponsors: %w", err)
+	}
+	return linked, nil
+}
+
+// UnresolvedSponsors returns the sponsor IDs of bills with no sponsor linked.
+func (r *BillRepository) UnresolvedSponsors(ctx context.Context) ([]domain.UnresolvedSponsor, error) {
+	records, err := unresolvedBills(r.app)
+	if err != nil {
+		return nil, fmt.Errorf("unresolved sponsors: %w", err)
+	}
+	counts := map[string]int{}
+	for _, rec := range records {
+		counts[rec.GetString("sponsor_utah_id")]++
+	}
+
+	out := make([]domain.UnresolvedSponsor, 0, len(counts))
+	for id, n := range counts {
+		out = append(out, domain.UnresolvedSponsor{SponsorUtahID: id, Bills: n})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Bills != out[j].Bills {
+			return out[i].Bills > out[j].Bills
+		}
+		return out[i].SponsorUtahID < out[j].SponsorUtahID
+	})
+	return out, nil
+}
+
+// unresolvedBills returns the bills with an upstream sponsor ID but no
+// sponsor linked.
+func unresolvedBills(app core.App) ([]*core.Record, error) {
+	return app.FindAllRecords(billCollection, dbx.NewExp("sponsor = '' AND sponsor_utah_id != ''"))
+}
+
+// billKey is the natural key of a bill.
+type billKey struct {
+	number string
+	year   int
+}
+
+// loadBillIndex returns the stored bills in bills, keyed on (bill_number,
+// session_year). It matches the batch's numbers and years separately, so it
+// may return a few bills outside the batch; those are never looked up.
+func loadBillIndex(tx core.App, bills []domain.Bill) (map[billKey]*core.Record, error) {
+	numbers, years := []any{}, []any{}
+	seenNumber, seenYear := map[string]bool{}, map[int]bool{}
+	for _, b := range bills {
+		if !seenNumber[b.BillNumber] {
+			seenNumber[b.BillNumber] = true
+			numbers = append(numbers, b.BillNumber)
+		}
+		if !seenYear[b.SessionYear] {
+			seenYear[b.SessionYear] = true
+			years = append(years, b.SessionYear)
+		}
+	}
+
+	records, err := tx.FindAllRecords(billCollection,
+		dbx.In("bill_number", numbers...),
+		dbx.In("session_year", years...),
+	)
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[billKey]*core.Record, len(records))
+	for _, rec := range records {
+		index[billKey{rec.GetString("bill_number"), rec.GetInt("session_year")}] = rec
 	}
+	return index, nil
+}
+
+// setBillFields copies a merged bill onto its record.
+func setBillFields(rec *core.Record, m domain.Bill) {
+	rec.Set("bill_number", m.BillNumber)
+	rec.Set("bill_type", m.BillType)
+	rec.Set("session_year", m.SessionYear)
+	rec.Set("title", m.Title)
+	rec.Set("description", m.Description)
+	rec.Set("status", m.Status)
+	rec.Set("sponsor", m.SponsorID)
+	rec.Set("sponsor_utah_id", m.SponsorUtahID)
+	rec.Set("full_text_url", m.FullTextURL)
+	rec.Set("last_action", m.LastAction)
+	rec.Set("last_action_date", dateOrEmpty(m.LastActionDate))
+	rec.Set("fiscal_note_url", m.FiscalNoteURL)
+	rec.Set("effective_date", dateOrEmpty(m.EffectiveDate))
+	rec.Set("utah_legislature_id", m.UtahLegislatureID)
+	rec.Set("legiscan_id", m.LegiscanID)
+	rec.Set(provenanceField, provenanceToJSON(m.Provenance))
+}
 
-	// Set fields
-	rec.Set("bill_number", b.BillNumber)
-	rec.Set("bill_type", b.BillType)
-	rec.Set("session_year", b.SessionYear)
-	rec.Set("title", b.Title)
-	rec.Set("description", b.Description)
-	rec.Set("status", b.Status)
-	if b.SponsorID != "" {
-		rec.Set("sponsor", b.SponsorID)
+// populateSponsors sets Sponsor on each bill, loading every sponsor in one
+// query. A sponsor that no longer exists is left unset, with SponsorID
+// cleared, as with a dangling relation.
+func populateSponsors(app core.App, bills []domain.Bill) error {
+	ids := []string{}
+	seen := map[string]bool{}
+	for _, b := range bills {
+		if b.SponsorID != "" && !seen[b.SponsorID] {
+			seen[b.SponsorID] = true
+			ids = append(ids, b.SponsorID)
+		}
 	}
-	rec.Set("full_text_url", b.FullTextURL)
-	rec.Set("last_action", b.LastAction)
-	if b.LastActionDate != nil {
-		rec.Set("last_action_date", *b.LastActionDate)
+	if len(ids) == 0 {
+		return nil
 	}
-	rec.Set("fiscal_note_url", b.FiscalNoteURL)
-	if b.EffectiveDate != nil {
-		rec.Set("effective_date", *b.EffectiveDate)
+
+	records, err := app.FindRecordsByIds(legislatorCollection, ids)
+	if err != nil {
+		return fmt.Errorf("load sponsors: %w", err)
+	}
+	sponsors := make(map[string]domain.Legislator, len(records))
+	for _, rec := range records {
+		sponsors[rec.Id] = recordToLegislator(rec)
 	}
-	rec.Set("utah_legislature_id", b.UtahLegislatureID)
-	rec.Set("legiscan_id", b.LegiscanID)
 
-	if err := r.app.Save(rec); err != nil {
-		return fmt.Errorf("upsert bill %s: %w", b.BillNumber, err)
+	for i := range bills {
+		if bills[i].SponsorID == "" {
+			continue
+		}
+		l, ok := sponsors[bills[i].SponsorID]
+		if !ok {
+			bills[i].SponsorID = ""
+			continue
+		}
+		bills[i].Sponsor = &l
 	}
 	return nil
 }
 
-// recordToBill converts a PocketBase record to a domain.Bill with sponsor populated.
-func (r *BillRepository) recordToBill(rec *core.Record) (*domain.Bill, error) {
+// billFromRecord converts a PocketBase record to a domain.Bill, leaving the
+// sponsor as the raw relation ID.
+func billFromRecord(rec *core.Record) domain.Bill {
 	bill := domain.Bill{
 		ID:                rec.Id,
 		BillNumber:        rec.GetString("bill_number"),
@@ -156,11 +355,14 @@ func (r *BillRepository) recordToBill(rec *core.Record) (*domain.Bill, error) {
 		Title:             rec.GetString("title"),
 		Description:       rec.GetString("description"),
 		Status:            rec.GetString("status"),
+		SponsorID:         rec.GetString("sponsor"),
+		SponsorUtahID:     rec.GetString("sponsor_utah_id"),
 		FullTextURL:       rec.GetString("full_text_url"),
 		LastAction:        rec.GetString("last_action"),
 		FiscalNoteURL:     rec.GetString("fiscal_note_url"),
 		UtahLegislatureID: rec.GetString("utah_legislature_id"),
 		LegiscanID:        rec.GetInt("legiscan_id"),
+		Provenance:        recordProvenance(rec),
 	}
 
 	// Handle date fields using PocketBase's typed DateTime getter
@@ -172,17 +374,13 @@ func (r *BillRepository) recordToBill(rec *core.Record) (*domain.Bill, error) {
 		t := d.Time()
 		bill.EffectiveDate = &t
 	}
+	return bill
+}
 
-	// Populate sponsor if present
-	sponsorID := rec.GetString("sponsor")
-	if sponsorID != "" {
-		sponsorRec, err := r.app.FindRecordById(legislatorCollection, sponsorID)
-		if err == nil {
-			l := recordToLegislator(sponsorRec)
-			bill.Sponsor = &l
-			bill.SponsorID = l.ID
-		}
+// dateOrEmpty returns t for a PocketBase date field, or "" to clear it.
+func dateOrEmpty(t *time.Time) any {
+	if t == nil {
+		return ""
 	}
-
-	return &bill, nil
+	return *t
 }
@@ -0,0 +1,476 @@
+package pocketbase
+
+import (
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+// SetupCollections creates the collections used by the repositories if they don't exist,
+// or updates their schema if they do. This is idempotent.
+func SetupCollections(app core.App) error {
+	// Create or update legislators collection
+	legislators, err := app.FindCollectionByNameOrId("legislators")
+	if err != nil {
+		legislators = core.NewBaseCollection("legislators")
+	}
+
+	legislators.Fields = core.NewFieldsList(
+		&core.TextField{Name: "chamber", Required: true, Max: 10},
+		&core.NumberField{Name: "district_number"}, // 0 when unknown; seats are tracked as terms
+		&core.TextField{Name: "first_name", Required: true, Max: 100},
+		&core.TextField{Name: "last_name", Required: true, Max: 100},
+		&core.TextField{Name: "party", Max: 50},
+		&core.EmailField{Name: "email"},
+		&core.TextField{Name: "phone", Max: 20},
+		&core.URLField{Name: "website"},
+		&core.URLField{Name: "image_url"},
+		&core.JSONField{Name: "offices", MaxSize: 10000},
+		&core.TextField{Name: "twitter", Max: 100},
+		&core.TextField{Name: "facebook", Max: 100},
+		&core.TextField{Name: "instagram", Max: 100},
+		&core.TextField{Name: "youtube", Max: 100},
+		&core.TextField{Name: "utah_legislature_id", Max: 50},
+		&core.NumberField{Name: "legiscan_id"},
+		&core.TextField{Name: "openstates_id", Max: 50},
+		&core.JSONField{Name: "provenance", MaxSize: 10000},
+	)
+
+	// People are keyed on their Utah Legislature ID, not their seat.
+	legislators.AddIndex("idx_legislators_utah_legislature_id", true, "utah_legislature_id", "utah_legislature_id != ''")
+
+	// Public read, authenticated admin write
+	legislators.ListRule = types.Pointer("")
+	legislators.ViewRule = types.Pointer("")
+	legislators.CreateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+	legislators.UpdateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+	legislators.DeleteRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+
+	if err := app.Save(legislators); err != nil {
+		return err
+	}
+
+	// Create or update legislator_terms collection (seats held over time)
+	terms, err := app.FindCollectionByNameOrId("legislator_terms")
+	if err != nil {
+		terms = core.NewBaseCollection("legislator_terms")
+	}
+
+	terms.Fields = core.NewFieldsList(
+		&core.RelationField{Name: "legislator", Required: true, CollectionId: legislators.Id, CascadeDelete: true},
+		&core.TextField{Name: "chamber", Required: true, Max: 10},
+		&core.NumberField{Name: "district_number", Required: true},
+		&core.DateField{Name: "start_date"}, // empty when the start is unknown
+		&core.DateField{Name: "end_date"},
+	)
+	terms.AddIndex("idx_legislator_terms_seat", false, "chamber, district_number", "")
+	terms.AddIndex("idx_legislator_terms_legislator", false, "legislator", "")
+
+	// Public read, authenticated admin write
+	terms.ListRule = types.Pointer("")
+	terms.ViewRule = types.Pointer("")
+	terms.CreateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+	terms.UpdateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+	terms.DeleteRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+
+	if err := app.Save(terms); err != nil {
+		return err
+	}
+	if err := backfillTerms(app); err != nil {
+		return err
+	}
+
+	// Create or update legislator_stats collection (written by the stats job)
+	stats, err := app.FindCollectionByNameOrId("legislator_stats")
+	if err != nil {
+		stats = core.NewBaseCollection("legislator_stats")
+	}
+
+	stats.Fields = core.NewFieldsList(
+		&core.RelationField{Name: "legislator", Required: true, CollectionId: legislators.Id, CascadeDelete: true},
+		&core.NumberField{Name: "session_id", Required: true},
+		&core.TextField{Name: "session_name", Max: 200},
+		&core.NumberField{Name: "session_year", Required: true},
+		&core.NumberField{Name: "eligible_votes"},
+		&core.NumberField{Name: "votes_cast"},
+		&core.NumberField{Name: "missed_votes"},
+		&core.NumberField{Name: "participation_rate"},
+		&core.NumberField{Name: "votes_against_party"},
+		&core.NumberField{Name: "bills_sponsored"},
+		&core.NumberField{Name: "bills_passed"},
+		&core.JSONField{Name: "agreement", MaxSize: 200000},
+		&core.DateField{Name: "computed_at"},
+		&core.TextField{Name: "dataset_hash", Max: 100},
+	)
+	stats.AddIndex("idx_legislator_stats_session", true, "legislator, session_id", "")
+	stats.AddIndex("idx_legislator_stats_session_id", false, "session_id", "")
+
+	// Public read, authenticated admin write
+	stats.ListRule = types.Pointer("")
+	stats.ViewRule = types.Pointer("")
+	stats.CreateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+	stats.UpdateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+	stats.DeleteRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+
+	if err := app.Save(stats); err != nil {
+		return err
+	}
+
+	// Create or update bills collection
+	bills, err := app.FindCollectionByNameOrId("bills")
+	if err != nil {
+		bills = core.NewBaseCollection("bills")
+	}
+
+	bills.Fields = core.NewFieldsList(
+		&core.TextField{Name: "bill_number", Required: true, Max: 20},
+		&core.TextField{Name: "bill_type", Required: true, Max: 10},
+		&core.NumberField{Name: "session_year", Required: true},
+		&core.TextField{Name: "title", Required: true, Max: 500},
+		&core.TextField{Name: "description", Max: 10000},
+		&core.TextField{Name: "status", Required: true, Max: 50},
+		&core.RelationField{Name: "sponsor", CollectionId: legislators.Id},
+		&core.TextField{Name: "sponsor_utah_id", Max: 50},
+		&core.URLField{Name: "full_text_url"},
+		&core.TextField{Name: "last_action", Max: 500},
+		&core.DateField{Name: "last_action_date"},
+		&core.URLField{Name: "fiscal_note_url"},
+		&core.DateField{Name: "effective_date"},
+		&core.TextField{Name: "utah_legislature_id", Max: 50},
+		&core.NumberField{Name: "legiscan_id"},
+		&core.JSONField{Name: "provenance", MaxSize: 10000},
+	)
+
+	// Public read, authenticated admin write
+	bills.ListRule = types.Pointer("")
+	bills.ViewRule = types.Pointer("")
+	bills.CreateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+	bills.UpdateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+	bills.DeleteRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+
+	bills.AddIndex("idx_bills_sponsor_utah_id", false, "sponsor_utah_id", "")
+
+	if err := app.Save(bills); err != nil {
+		return err
+	}
+
+	// Create or update sync_versions collection (bumped by ingestion jobs)
+	syncVersions, err := app.FindCollectionByNameOrId("sync_versions")
+	if err != nil {
+		syncVersions = core.NewBaseCollection("sync_versions")
+	}
+
+	syncVersions.Fields = core.NewFieldsList(
+		&core.TextField{Name: "entity", Required: true, Max: 50},
+		&core.NumberField{Name: "version"},
+		&core.DateField{Name: "synced_at"},
+	)
+	syncVersions.AddIndex("idx_sync_versions_entity", true, "entity", "")
+
+	// Public read, authenticated admin write
+	syncVersions.ListRule = types.Pointer("")
+	syncVersions.ViewRule = types.Pointer("")
+	syncVersions.CreateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+	syncVersions.UpdateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+	syncVersions.DeleteRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
+
+	if err := app.Save(syncVersions); err != nil {
+		return err
+	}
+
+	// Create or update job_runs collection (written by the scheduler)
+	jobRuns, err := app.FindCollectionByNameOrId("job_runs")
+	if err != nil {
+		jobRuns = core.NewBaseCollection("job_runs")
+	}
+
+	jobRuns.Fields = core.NewFieldsList(
+		&core.TextField{Name: "job", Required: true, Max: 50},
+		&core.TextField{Name: "trigger", Max: 20},
+		&core.TextField{Name: "status", Required: true, Max: 20},
+		&core.DateField{Name: "started_at", Required: true},
+		&core.DateField{Name: "finished_at"},
+		&core.NumberField{Name: "upserted"},
+		&core.NumberField{Name: "failed"},
+		&core.TextField{Name: "error", Max: 5000},
+	)
+	jobRuns.AddIndex("idx_job_runs_job_started", false, "job, started_at", "")
+
+	// Superuser only: run history is operator data
+	jobRuns.ListRule = nil
+	jobRuns.ViewRule = nil
+	jobRuns.CreateRule = nil
+	jobRuns.UpdateRule = nil
+	jobRuns.DeleteRule = nil
+
+	if err := app.Save(jobRuns); err != nil {
+		return err
+	}
+
+	// Create or update dead_letters collection (records ingestion failed to write)
+	deadLetters, err := app.FindCollectionByNameOrId("dead_letters")
+	if err != nil {
+		deadLetters = core.NewBaseCollection("dead_letters")
+	}
+
+	deadLetters.Fields = core.NewFieldsList(
+		&core.TextField{Name: "kind", Required: true, Max: 20},
+		&core.TextField{Name: "source", Max: 50},
+		&core.TextField{Name: "key", Required: true, Max: 100},
+		&core.JSONField{Name: "payload", MaxSize: 1 << 20},
+		&core.TextField{Name: "error", Max: 5000},
+		&core.NumberField{Name: "attempts"},
+		&core.DateField{Name: "first_failed_at"},
+		&core.DateField{Name: "last_failed_at"},
+	)
+	deadLetters.AddIndex("idx_dead_letters_kind_key", true, "kind, key", "")
+
+	// Superuser only: failed payloads are operator data
+	deadLetters.ListRule = nil
+	deadLetters.ViewRule = nil
+	deadLetters.CreateRule = nil
+	deadLetters.UpdateRule = nil
+	deadLetters.DeleteRule = nil
+
+	if err := app.Save(deadLetters); err != nil {
+		return err
+	}
+
+	// Create or update follows collection (per-user watchlists)
+	follows, err := app.FindCollectionByNameOrId("follows")
+	if err != nil {
+		follows = core.NewBaseCollection("follows")
+	}
+
+	follows.Fields = core.NewFieldsList(
+		&core.TextField{Name: "user_id", Required: true, Max: 50},
+		&core.TextField{Name: "kind", Required: true, Max: 20},
+		&core.TextField{Name: "target_id", Required: true, Max: 100},
+		&core.DateField{Name: "created_at"},
+	)
+	follows.AddIndex("idx_follows_user_kind_target", true, "user_id, kind, target_id", "")
+
+	// Superuser only: users are Supabase accounts, so the API, not
+	// PocketBase auth, enforces that each user sees only their own follows
+	follows.ListRule = nil
+	follows.ViewRule = nil
+	follows.CreateRule = nil
+	follows.UpdateRule = nil
+	follows.DeleteRule = nil
+
+	if err := app.Save(follows); err != nil {
+		return err
+	}
+
+	return setupNotificationCollections(app)
+}
+
+// setupNotificationCollections creates or updates the collections of the
+// notification subsystem. Like follows, they are superuser only: users are
+// Supabase accounts, so the API enforces that each user sees only their own.
+func setupNotificationCollections(app core.App) error {
+	subscriptions, err := app.FindCollectionByNameOrId("notification_subscriptions")
+	if err != nil {
+		subscriptions = core.NewBaseCollection("notification_subscriptions")
+	}
+	subscriptions.Fields = core.NewFieldsList(
+		&core.TextField{Name: "user_id", Required: true, Max: 50},
+		&core.JSONField{Name: "event_types", MaxSize: 1 << 10},
+		&core.TextField{Name: "bill_id", Max: 50},
+		&core.TextField{Name: "sponsor_id", Max: 50},
+		&core.BoolField{Name: "followed"},
+		&core.JSONField{Name: "channels", MaxSize: 1 << 10},
+		&core.DateField{Name: "created_at"},
+	)
+	subscriptions.AddIndex("idx_notification_subscriptions_user", false, "user_id", "")
+
+	settings, err := app.FindCollectionByNameOrId("notification_settings")
+	if err != nil {
+		settings = core.NewBaseCollection("notification_settings")
+	}
+	settings.Fields = core.NewFieldsList(
+		&core.TextField{Name: "user_id", Required: true, Max: 50},
+		&core.BoolField{Name: "enabled"},
+		&core.TextField{Name: "email", Max: 320},
+		&core.TextField{Name: "time_zone", Max: 100},
+		&core.NumberField{Name: "quiet_start"},
+		&core.NumberField{Name: "quiet_end"},
+		&core.NumberField{Name: "digest_minutes"},
+		&core.DateField{Name: "last_digest_at"},
+	)
+	settings.AddIndex("idx_notification_settings_user", true, "user_id", "")
+
+	devices, err := app.FindCollectionByNameOrId("notification_devices")
+	if err != nil {
+		devices = core.NewBaseCollection("notification_devices")
+	}
+	devices.Fields = core.NewFieldsList(
+		&core.TextField{Name: "user_id", Required: true, Max: 50},
+		&core.TextField{Name: "channel", Required: true, Max: 20},
+		&core.TextField{Name: "token", Required: true, Max: 2000},
+		&core.TextField{Name: "p256dh", Max: 200},
+		&core.TextField{Name: "auth", Max: 100},
+		&core.DateField{Name: "created_at"},
+	)
+	devices.AddIndex("idx_notification_devices_channel_token", true, "channel, token", "")
+	devices.AddIndex("idx_notification_devices_user", false, "user_id", "")
+
+	notifications, err := app.FindCollectionByNameOrId("notifications")
+	if err != nil {
+		notifications = core.NewBaseCollection("notifications")
+	}
+	notifications.Fields = core.NewFieldsList(
+		&core.TextField{Name: "user_id", Required: true, Max: 50},
+		&core.TextField{Name: "event_type", Max: 50},
+		&core.TextField{Name: "bill_id", Max: 50},
+		&core.TextField{Name: "title", Max: 500},
+		&core.TextField{Name: "body", Max: 5000},
+		&core.JSONField{Name: "channels", MaxSize: 1 << 10},
+		&core.DateField{Name: "created_at"},
+		&core.DateField{Name: "sent_at"},
+	)
+	notifications.AddIndex("idx_notifications_sent_created", false, "sent_at, created_at", "")
+
+	for _, c := range []*core.Collection{subscriptions, settings, devices, notifications} {
+		c.ListRule = nil
+		c.ViewRule = nil
+		c.CreateRule = nil
+		c.UpdateRule = nil
+		c.DeleteRule = nil
+		if err := app.Save(c); err != nil {
+			return err
+		}
+	}
+	return setupWebhookCollections(app)
+}
+
+// setupWebhookCollections creates or updates the webhooks and their
+// delivery log. Webhook secrets are stored here, so both are superuser only.
+func setupWebhookCollections(app core.App) error {
+	webhooks, err := app.FindCollectionByNameOrId("webhooks")
+	if err != nil {
+		webhooks = core.NewBaseCollection("webhooks")
+	}
+	webhooks.Fields = core.NewFieldsList(
+		&core.TextField{Name: "owner_id", Required: true, Max: 50},
+		&core.TextField{Name: "url", Required: true, Max: 2000},
+		&core.TextField{Name: "secret", Required: true, Max: 200},
+		&core.JSONField{Name: "event_types", MaxSize: 1 << 10},
+		&core.TextField{Name: "bill_id", Max: 50},
+		&core.TextField{Name: "sponsor_id", Max: 50},
+		&core.JSONField{Name: "keywords", MaxSize: 1 << 12},
+		&core.DateField{Name: "created_at"},
+	)
+	webhooks.AddIndex("idx_webhooks_owner", false, "owner_id", "")
+
+	deliveries, err := app.FindCollectionByNameOrId("webhook_deliveries")
+	if err != nil {
+		deliveries = core.NewBaseCollection("webhook_deliveries")
+	}
+	deliveries.Fields = core.NewFieldsList(
+		&core.TextField{Name: "webhook_id", Required: true, Max: 50},
+		&core.TextField{Name: "event_id", Max: 50},
+		&core.TextField{Name: "event_type", Max: 50},
+		&core.TextField{Name: "payload", Max: 1 << 20},
+		&core.TextField{Name: "status", Required: true, Max: 20},
+		&core.NumberField{Name: "attempts"},
+		&core.DateField{Name: "next_attempt_at"},
+		&core.DateField{Name: "last_attempt_at"},
+		&core.NumberField{Name: "response_status"},
+		&core.TextField{Name: "last_error", Max: 2000},
+		&core.TextField{Name: "replay_of", Max: 50},
+		&core.DateField{Name: "created_at"},
+	)
+	deliveries.AddIndex("idx_webhook_deliveries_webhook", false, "webhook_id, created_at", "")
+	deliveries.AddIndex("idx_webhook_deliveries_due", false, "status, next_attempt_at", "")
+
+	for _, c := range []*core.Collection{webhooks, deliveries} {
+		c.ListRule = nil
+		c.ViewRule = nil
+		c.CreateRule = nil
+		c.UpdateRule = nil
+		c.DeleteRule = nil
+		if err := app.Save(c); err != nil {
+			return err
+		}
+	}
+	return setupAPIKeyCollection(app)
+}
+
+// setupAPIKeyCollection creates or updates the API keys of third-party
+// clients. Superusers manage them through the admin API or the dashboard;
+// only hashes of the keys are stored.
+func setupAPIKeyCollection(app core.App) error {
+	keys, err := app.FindCollectionByNameOrId("api_keys")
+	if err != nil {
+		keys = core.NewBaseCollection("api_keys")
+	}
+	keys.Fields = core.NewFieldsList(
+		&core.TextField{Name: "name", Required: true, Max: 200},
+		&core.TextField{Name: "prefix", Max: 20},
+		&core.TextField{Name: "hash", Required: true, Max: 64},
+		&core.NumberField{Name: "rate_per_minute"},
+		&core.NumberField{Name: "burst"},
+		&core.BoolField{Name: "revoked"},
+		&core.NumberField{Name: "requests"},
+		&core.NumberField{Name: "throttled"},
+		&core.DateField{Name: "last_used_at"},
+		&core.DateField{Name: "created_at"},
+	)
+	keys.AddIndex("idx_api_keys_hash", true, "hash", "")
+	keys.ListRule = nil
+	keys.ViewRule = nil
+	keys.CreateRule = nil
+	keys.UpdateRule = nil
+	keys.DeleteRule = nil
+	return app.Save(keys)
+}
+
+// backfillTerms opens a current term for every legislator stored before
+// terms were tracked, using the seat on their record. Their real start date
+// is unknown, so, as for a seat first seen by a sync, it is when the term is
+// opened until a sync with the upstream start date moves it back; terms
+// backfilled with no start date get one the same way. It is a no-op once
+// every legislator has a term and every term a start date.
+func backfillTerms(app core.App) error {
+	legislators, err := app.FindAllRecords("legislators")
+	if err != nil {
+		return err
+	}
+	terms, err := app.FindCollectionByNameOrId("legislator_terms")
+	if err != nil {
+		return err
+	}
+	now := types.NowDateTime()
+
+	undated, err := app.FindAllRecords(terms, dbx.HashExp{"start_date": ""})
+	if err != nil {
+		return err
+	}
+	for _, t := range undated {
+		t.Set("start_date", now)
+		if err := app.Save(t); err != nil {
+			return err
+		}
+	}
+
+	for _, l := range legislators {
+		n, err := app.CountRecords(terms, dbx.HashExp{"legislator": l.Id})
+		if err != nil {
+			return err
+		}
+		if n > 0 || l.GetString("chamber") == "" || l.GetInt("district_number") == 0 {
+			continue
+		}
+
+		t := core.NewRecord(terms)
+		t.Set("legislator", l.Id)
+		t.Set("chamber", l.GetString("chamber"))
+		t.Set("district_number", l.GetInt("district_number"))
+		t.Set("start_date", now)
+		if err := app.Save(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
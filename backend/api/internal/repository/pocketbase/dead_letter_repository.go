@@ -0,0 +1,112 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+
+	"api/internal/domain"
+)
+
+// DeadLetterRepository is the PocketBase implementation of repository.DeadLetterRepository.
+type DeadLetterRepository struct {
+	app core.App
+}
+
+// NewDeadLetterRepository creates a new PocketBase-backed DeadLetterRepository.
+func NewDeadLetterRepository(app core.App) *DeadLetterRepository {
+	return &DeadLetterRepository{app: app}
+}
+
+const deadLetterCollection = "dead_letters"
+
+// RecordDeadLetter stores a failure, incrementing the attempt count of a
+// record already dead-lettered.
+func (r *DeadLetterRepository) RecordDeadLetter(ctx context.Context, dl domain.DeadLetter) error {
+	return r.app.RunInTransaction(func(tx core.App) error {
+		now := time.Now().UTC()
+		rec, err := findDeadLetter(tx, dl.Kind, dl.Key)
+		if err != nil {
+			return fmt.Errorf("record dead letter: %w", err)
+		}
+		if rec == nil {
+			collection, err := tx.FindCollectionByNameOrId(deadLetterCollection)
+			if err != nil {
+				return fmt.Errorf("find collection: %w", err)
+			}
+			rec = core.NewRecord(collection)
+			rec.Set("kind", dl.Kind)
+			rec.Set("key", dl.Key)
+			rec.Set("first_failed_at", now)
+		}
+
+		rec.Set("source", dl.Source)
+		rec.Set("payload", types.JSONRaw(dl.Payload))
+		rec.Set("error", dl.Error)
+		rec.Set("attempts", rec.GetInt("attempts")+1)
+		rec.Set("last_failed_at", now)
+		if err := tx.Save(rec); err != nil {
+			return fmt.Errorf("record dead letter %s %s: %w", dl.Kind, dl.Key, err)
+		}
+		return nil
+	})
+}
+
+// ListDeadLetters returns dead letters, oldest first.
+func (r *DeadLetterRepository) ListDeadLetters(ctx context.Context, kind string) ([]domain.DeadLetter, error) {
+	filter := ""
+	if kind != "" {
+		filter = "kind = {:kind}"
+	}
+	records, err := r.app.FindRecordsByFilter(deadLetterCollection, filter, "first_failed_at", 0, 0, dbx.Params{"kind": kind})
+	if err != nil {
+		return nil, fmt.Errorf("list dead letters: %w", err)
+	}
+
+	letters := make([]domain.DeadLetter, 0, len(records))
+	for _, rec := range records {
+		letters = append(letters, domain.DeadLetter{
+			ID:            rec.Id,
+			Kind:          rec.GetString("kind"),
+			Source:        rec.GetString("source"),
+			Key:           rec.GetString("key"),
+			Payload:       []byte(rec.GetString("payload")),
+			Error:         rec.GetString("error"),
+			Attempts:      rec.GetInt("attempts"),
+			FirstFailedAt: rec.GetDateTime("first_failed_at").Time(),
+			LastFailedAt:  rec.GetDateTime("last_failed_at").Time(),
+		})
+	}
+	return letters, nil
+}
+
+// ResolveDeadLetter removes a dead letter.
+func (r *DeadLetterRepository) ResolveDeadLetter(ctx context.Context, kind, key string) error {
+	rec, err := findDeadLetter(r.app, kind, key)
+	if err != nil {
+		return fmt.Errorf("resolve dead letter: %w", err)
+	}
+	if rec == nil {
+		return nil
+	}
+	if err := r.app.Delete(rec); err != nil {
+		return fmt.Errorf("resolve dead letter %s %s: %w", kind, key, err)
+	}
+	return nil
+}
+
+// findDeadLetter returns the dead letter for kind and key, or nil.
+func findDeadLetter(app core.App, kind, key string) (*core.Record, error) {
+	rec, err := app.FindFirstRecordByFilter(deadLetterCollection,
+		"kind = {:kind} && key = {:key}", dbx.Params{"kind": kind, "key": key})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return rec, err
+}
@@ -0,0 +1,94 @@
+package pocketbase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"api/internal/domain"
+)
+
+// JobRunRepository is the PocketBase implementation of repository.JobRunRepository.
+type JobRunRepository struct {
+	app core.App
+}
+
+// NewJobRunRepository creates a new PocketBase-backed JobRunRepository.
+func NewJobRunRepository(app core.App) *JobRunRepository {
+	return &JobRunRepository{app: app}
+}
+
+const jobRunCollection = "job_runs"
+
+// SaveJobRun inserts or updates a run.
+func (r *JobRunRepository) SaveJobRun(ctx context.Context, run *domain.JobRun) error {
+	var rec *core.Record
+	if run.ID == "" {
+		collection, err := r.app.FindCollectionByNameOrId(jobRunCollection)
+		if err != nil {
+			return fmt.Errorf("find collection: %w", err)
+		}
+		rec = core.NewRecord(collection)
+	} else {
+		var err error
+		rec, err = r.app.FindRecordById(jobRunCollection, run.ID)
+		if err != nil {
+			return fmt.Errorf("find job run %s: %w", run.ID, err)
+		}
+	}
+
+	rec.Set("job", run.Job)
+	rec.Set("trigger", run.Trigger)
+	rec.Set("status", run.Status)
+	rec.Set("started_at", run.StartedAt)
+	rec.Set("finished_at", dateOrEmpty(run.FinishedAt))
+	rec.Set("upserted", run.Upserted)
+	rec.Set("failed", run.Failed)
+	rec.Set("error", run.Error)
+	if err := r.app.Save(rec); err != nil {
+		return fmt.Errorf("save job run: %w", err)
+	}
+	run.ID = rec.Id
+	return nil
+}
+
+// ListJobRuns returns the most recent runs, newest first.
+func (r *JobRunRepository) ListJobRuns(ctx context.Context, job string, limit int) ([]domain.JobRun, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	filter := ""
+	if job != "" {
+		filter = "job = {:job}"
+	}
+	records, err := r.app.FindRecordsByFilter(jobRunCollection, filter, "-started_at", limit, 0, dbx.Params{"job": job})
+	if err != nil {
+		return nil, fmt.Errorf("list job runs: %w", err)
+	}
+
+	runs := make([]domain.JobRun, 0, len(records))
+	for _, rec := range records {
+		runs = append(runs, recordToJobRun(rec))
+	}
+	return runs, nil
+}
+
+func recordToJobRun(rec *core.Record) domain.JobRun {
+	run := domain.JobRun{
+		ID:        rec.Id,
+		Job:       rec.GetString("job"),
+		Trigger:   rec.GetString("trigger"),
+		Status:    rec.GetString("status"),
+		StartedAt: rec.GetDateTime("started_at").Time(),
+		Upserted:  rec.GetInt("upserted"),
+		Failed:    rec.GetInt("failed"),
+		Error:     rec.GetString("error"),
+	}
+	if d := rec.GetDateTime("finished_at"); !d.IsZero() {
+		t := d.Time()
+		run.FinishedAt = &t
+	}
+	return run
+}
@@ -5,10 +5,17 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/pocketbase/dbx"
 	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
 
 	"api/internal/domain"
+	"api/internal/merge"
+	"api/internal/repository"
 )
 
 // LegislatorRepository is the PocketBase implementation of repository.LegislatorRepository.
@@ -23,36 +30,91 @@ func NewLegislatorRepository(app core.App) *LegislatorRepository {
 
 const legislatorCollection = "legislators"
 
-// ListLegislators returns all legislators, optionally filtered by chamber.
-func (r *LegislatorRepository) ListLegislators(ctx context.Context, chamber string) ([]domain.Legislator, error) {
-	var records []*core.Record
-	var err error
-
-	if chamber != "" {
-		records, err = r.app.FindRecordsByFilter(
-			legislatorCollection,
-			"chamber = {:chamber}",
-			"chamber, district_number",
-			0,
-			0,
-			map[string]any{"chamber": chamber},
-		)
-	} else {
-		records, err = r.app.FindAllRecords(legislatorCollection)
+// ListLegislators returns legislators matching the filters. By default only
+// current members are returned; see repository.LegislatorFilters.
+//
+// Membership is decided by terms: the matching terms are loaded first, then
+// the people holding them, so each legislator's Chamber, DistrictNumber and
+// term dates reflect the seat that matched.
+func (r *LegislatorRepository) ListLegislators(ctx context.Context, f repository.LegislatorFilters) ([]domain.Legislator, error) {
+	filterParts := []string{}
+	params := map[string]any{}
+
+	if f.Chamber != "" {
+		filterParts = append(filterParts, "chamber = {:chamber}")
+		params["chamber"] = f.Chamber
+	}
+	switch {
+	case f.AsOf != nil:
+		filterParts = append(filterParts,
+			"start_date != '' && start_date <= {:as_of} && (end_date = '' || end_date > {:as_of})")
+		params["as_of"] = f.AsOf.UTC().Format(types.DefaultDateLayout)
+	case !f.IncludeFormer:
+		filterParts = append(filterParts, openTermFilter)
 	}
 
+	termRecords, err := r.app.FindRecordsByFilter(
+		termCollection,
+		strings.Join(filterParts, " && "),
+		"",
+		0,
+		0,
+		params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list legislators: %w", err)
+	}
+
+	// One term per person: the open one if any, else the most recent.
+	best := map[string]domain.Term{}
+	for _, rec := range termRecords {
+		t := recordToTerm(rec)
+		if cur, ok := best[t.LegislatorID]; !ok || laterTerm(t, cur) {
+			best[t.LegislatorID] = t
+		}
+	}
+
+	ids := make([]string, 0, len(best))
+	for id := range best {
+		ids = append(ids, id)
+	}
+	records, err := r.app.FindRecordsByIds(legislatorCollection, ids)
 	if err != nil {
 		return nil, fmt.Errorf("list legislators: %w", err)
 	}
 
 	legislators := make([]domain.Legislator, 0, len(records))
 	for _, rec := range records {
-		legislators = append(legislators, recordToLegislator(rec))
+		l := recordToLegislator(rec)
+		applyTerm(&l, best[l.ID])
+		legislators = append(legislators, l)
 	}
+	if f.AsOf == nil && f.Chamber == "" {
+		termless, err := r.app.FindAllRecords(legislatorCollection,
+			dbx.NewExp("[[id]] NOT IN (SELECT [[legislator]] FROM {{"+termCollection+"}})"))
+		if err != nil {
+			return nil, fmt.Errorf("list legislators: %w", err)
+		}
+		for _, rec := range termless {
+			legislators = append(legislators, recordToLegislator(rec))
+		}
+	}
+
+	sort.Slice(legislators, func(i, j int) bool {
+		a, b := legislators[i], legislators[j]
+		if a.Chamber != b.Chamber {
+			return a.Chamber < b.Chamber
+		}
+		if a.DistrictNumber != b.DistrictNumber {
+			return a.DistrictNumber < b.DistrictNumber
+		}
+		return a.LastName < b.LastName
+	})
 	return legislators, nil
 }
 
-// GetLegislator returns a single legislator by its ID.
+// GetLegislator returns a single legislator by its ID, with every term they
+// have served in Terms.
 func (r *LegislatorRepository) GetLegislator(ctx context.Context, id string) (*domain.Legislator, error) {
 	rec, err := r.app.FindRecordById(legislatorCollection, id)
 	if err != nil {
@@ -62,77 +124,305 @@ func (r *LegislatorRepository) GetLegislator(ctx context.Context, id string) (*d
 		return nil, fmt.Errorf("get legislator: %w", err)
 	}
 	l := recordToLegislator(rec)
+
+	l.Terms, err = r.ListTerms(ctx, l.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get legislator: %w", err)
+	}
+	if len(l.Terms) > 0 {
+		latest := l.Terms[0]
+		for _, t := range l.Terms[1:] {
+			if laterTerm(t, latest) {
+				latest = t
+			}
+		}
+		// Keep the stored seat; the latest term is the same seat for current
+		// members and the last one served for former members.
+		l.TermStart, l.TermEnd, l.Current = termDates(latest)
+	}
 	return &l, nil
 }
 
-// GetLegislatorByDistrict returns the legislator for a given chamber and district number.
+// GetLegislatorByDistrict returns the legislator currently holding the seat
+// for a given chamber and district number.
 func (r *LegislatorRepository) GetLegislatorByDistrict(ctx context.Context, chamber string, districtNumber int) (*domain.Legislator, error) {
-	records, err := r.app.FindRecordsByFilter(
-		legislatorCollection,
-		"chamber = {:chamber} && district_number = {:district_number}",
-		"",
-		1,
-		0,
-		map[string]any{"chamber": chamber, "district_number": districtNumber},
-	)
+	rec, err := currentSeatHolder(r.app, chamber, districtNumber)
 	if err != nil {
 		return nil, fmt.Errorf("get legislator by district: %w", err)
 	}
-	if len(records) == 0 {
+	if rec == nil {
 		return nil, nil
 	}
-	l := recordToLegislator(records[0])
+	l := recordToLegislator(rec)
 	return &l, nil
 }
 
-// UpsertLegislator inserts or updates a legislator record keyed on (chamber, district_number).
-func (r *LegislatorRepository) UpsertLegislator(ctx context.Context, l domain.Legislator) error {
-	// Check if legislator exists by chamber and district
+// ListTerms returns every seat a legislator has held, oldest first.
+func (r *LegislatorRepository) ListTerms(ctx context.Context, legislatorID string) ([]domain.Term, error) {
 	records, err := r.app.FindRecordsByFilter(
-		legislatorCollection,
-		"chamber = {:chamber} && district_number = {:district_number}",
-		"",
-		1,
+		termCollection,
+		"legislator = {:legislator}",
+		"start_date",
+		0,
 		0,
-		map[string]any{"chamber": l.Chamber, "district_number": l.DistrictNumber},
+		map[string]any{"legislator": legislatorID},
 	)
 	if err != nil {
-		return fmt.Errorf("find existing legislator: %w", err)
+		return nil, fmt.Errorf("list terms: %w", err)
+	}
+
+	terms := make([]domain.Term, 0, len(records))
+	for _, rec := range records {
+		terms = append(terms, recordToTerm(rec))
+	}
+	return terms, nil
+}
+
+// UpsertLegislator inserts or updates a person, then records their current
+// seat as a Term.
+//
+// People are identified by upstream IDs (utah_legislature_id, then
+// openstates_id, then legiscan_id), not by seat, so chamber moves and
+// redistricting update the same person instead of overwriting whoever held
+// the seat before. Incoming fields are merged with the stored record
+// according to merge.DefaultLegislatorRules, and per-field provenance is
+// recorded.
+func (r *LegislatorRepository) UpsertLegislator(ctx context.Context, l domain.Legislator) error {
+	return r.UpsertLegislators(ctx, []domain.Legislator{l})
+}
+
+// UpsertLegislators upserts a batch of legislators in a single transaction:
+// either every legislator is written or none is. Stored people are indexed
+// by upstream ID once up front instead of being looked up one by one.
+func (r *LegislatorRepository) UpsertLegislators(ctx context.Context, legislators []domain.Legislator) error {
+	if len(legislators) == 0 {
+		return nil
+	}
+	return r.app.RunInTransaction(func(tx core.App) error {
+		index, err := loadLegislatorIndex(tx)
+		if err != nil {
+			return fmt.Errorf("load existing legislators: %w", err)
+		}
+		now := time.Now().UTC()
+		for _, l := range legislators {
+			if err := upsertLegislator(tx, l, now, index); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RetireLegislators ends the open terms of every legislator with a Utah
+// Legislature ID missing from keep. Legislators without a Utah ID (e.g.
+// seeded from OpenStates only) are left alone, since the Utah roster says
+// nothing about them. An empty keep list is treated as a bad feed and
+// retires no one.
+func (r *LegislatorRepository) RetireLegislators(ctx context.Context, keep []string) (int, error) {
+	if len(keep) == 0 {
+		return 0, nil
+	}
+	keepSet := make(map[string]bool, len(keep))
+	for _, id := range keep {
+		keepSet[id] = true
+	}
+
+	retired := 0
+	err := r.app.RunInTransaction(func(tx core.App) error {
+		open, err := tx.FindRecordsByFilter(termCollection, openTermFilter, "", 0, 0)
+		if err != nil {
+			return fmt.Errorf("find open terms: %w", err)
+		}
+
+		holderIDs := make([]string, 0, len(open))
+		for _, t := range open {
+			holderIDs = append(holderIDs, t.GetString("legislator"))
+		}
+		holders, err := tx.FindRecordsByIds(legislatorCollection, holderIDs)
+		if err != nil {
+			return fmt.Errorf("find seat holders: %w", err)
+		}
+		utahIDs := make(map[string]string, len(holders))
+		for _, h := range holders {
+			utahIDs[h.Id] = h.GetString("utah_legislature_id")
+		}
+
+		now := time.Now().UTC()
+		for _, t := range open {
+			id := utahIDs[t.GetString("legislator")]
+			if id == "" || keepSet[id] {
+				continue
+			}
+			t.Set("end_date", now)
+			if err := tx.Save(t); err != nil {
+				return fmt.Errorf("end term %s: %w", t.Id, err)
+			}
+			retired++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("retire legislators: %w", err)
 	}
+	return retired, nil
+}
 
-	var rec *core.Record
-	if len(records) > 0 {
-		rec = records[0]
+// upsertLegislator does the work of UpsertLegislators for one person inside
+// a transaction, keeping index up to date.
+func upsertLegislator(tx core.App, l domain.Legislator, now time.Time, index legislatorIndex) error {
+	rec, err := resolveLegislator(tx, l, index)
+	if err != nil {
+		return fmt.Errorf("resolve legislator %s %s: %w", l.FirstName, l.LastName, err)
+	}
+
+	var existing *domain.Legislator
+	if rec != nil {
+		current := recordToLegislator(rec)
+		existing = &current
 	} else {
-		collection, err := r.app.FindCollectionByNameOrId(legislatorCollection)
+		collection, err := tx.FindCollectionByNameOrId(legislatorCollection)
 		if err != nil {
 			return fmt.Errorf("find collection: %w", err)
 		}
 		rec = core.NewRecord(collection)
 	}
 
+	// Merge field by field so a lower-priority source can't overwrite
+	// values supplied by a more authoritative one.
+	m := merge.Legislator(existing, l, merge.DefaultLegislatorRules, now)
+
 	// Set fields
-	rec.Set("chamber", l.Chamber)
-	rec.Set("district_number", l.DistrictNumber)
-	rec.Set("first_name", l.FirstName)
-	rec.Set("last_name", l.LastName)
-	rec.Set("party", l.Party)
-	rec.Set("email", l.Email)
-	rec.Set("phone", l.Phone)
-	rec.Set("website", l.Website)
-	rec.Set("image_url", l.ImageURL)
-	rec.Set("utah_legislature_id", l.UtahLegislatureID)
-	rec.Set("legiscan_id", l.LegiscanID)
-	rec.Set("openstates_id", l.OpenStatesID)
-
-	if err := r.app.Save(rec); err != nil {
+	rec.Set("chamber", m.Chamber)
+	rec.Set("district_number", m.DistrictNumber)
+	rec.Set("first_name", m.FirstName)
+	rec.Set("last_name", m.LastName)
+	rec.Set("party", m.Party)
+	rec.Set("email", m.Email)
+	rec.Set("phone", m.Phone)
+	rec.Set("website", m.Website)
+	rec.Set("image_url", m.ImageURL)
+	rec.Set("offices", officesToJSON(m.Offices))
+	rec.Set("twitter", m.Twitter)
+	rec.Set("facebook", m.Facebook)
+	rec.Set("instagram", m.Instagram)
+	rec.Set("youtube", m.YouTube)
+	rec.Set("utah_legislature_id", m.UtahLegislatureID)
+	rec.Set("legiscan_id", m.LegiscanID)
+	rec.Set("openstates_id", m.OpenStatesID)
+	rec.Set(provenanceField, provenanceToJSON(m.Provenance))
+
+	if err := tx.Save(rec); err != nil {
 		return fmt.Errorf("upsert legislator %s %s: %w", l.FirstName, l.LastName, err)
 	}
+	index.add(rec)
+
+	// The seat comes from the merged record so a stale secondary source
+	// can't flip a term the authoritative source already moved.
+	if err := syncCurrentTerm(tx, rec.Id, m.Chamber, m.DistrictNumber, l.TermStart, now); err != nil {
+		return fmt.Errorf("sync term for %s %s: %w", l.FirstName, l.LastName, err)
+	}
 	return nil
 }
 
+// resolveLegislator finds the stored person an incoming record refers to, or
+// nil if it is someone new.
+//
+// Upstream IDs are tried first. Failing that, the current holder of the
+// incoming seat is assumed to be the same person, unless they already carry
+// a different ID from the same source — that is a new member taking over the
+// seat, not the same person.
+func resolveLegislator(tx core.App, l domain.Legislator, index legislatorIndex) (*core.Record, error) {
+	if rec := index.find(l); rec != nil {
+		return rec, nil
+	}
+
+	holder, err := currentSeatHolder(tx, l.Chamber, l.DistrictNumber)
+	if err != nil || holder == nil {
+		return nil, err
+	}
+	if merge.ConflictingIDs(recordToLegislator(holder), l) {
+		return nil, nil
+	}
+	return holder, nil
+}
+
+// legislatorIndex maps upstream IDs to stored legislator records.
+type legislatorIndex struct {
+	utah       map[string]*core.Record
+	openStates map[string]*core.Record
+	legiscan   map[int]*core.Record
+}
+
+// loadLegislatorIndex indexes every stored legislator by upstream ID.
+func loadLegislatorIndex(tx core.App) (legislatorIndex, error) {
+	index := legislatorIndex{
+		utah:       map[string]*core.Record{},
+		openStates: map[string]*core.Record{},
+		legiscan:   map[int]*core.Record{},
+	}
+	records, err := tx.FindAllRecords(legislatorCollection)
+	if err != nil {
+		return index, err
+	}
+	for _, rec := range records {
+		index.add(rec)
+	}
+	return index, nil
+}
+
+// add indexes rec under each upstream ID it carries.
+func (idx legislatorIndex) add(rec *core.Record) {
+	if id := rec.GetString("utah_legislature_id"); id != "" {
+		idx.utah[id] = rec
+	}
+	if id := rec.GetString("openstates_id"); id != "" {
+		idx.openStates[id] = rec
+	}
+	if id := rec.GetInt("legiscan_id"); id != 0 {
+		idx.legiscan[id] = rec
+	}
+}
+
+// find returns the stored record matching l's upstream IDs, in priority
+// order, or nil.
+func (idx legislatorIndex) find(l domain.Legislator) *core.Record {
+	if rec := idx.utah[l.UtahLegislatureID]; l.UtahLegislatureID != "" && rec != nil {
+		return rec
+	}
+	if rec := idx.openStates[l.OpenStatesID]; l.OpenStatesID != "" && rec != nil {
+		return rec
+	}
+	if rec := idx.legiscan[l.LegiscanID]; l.LegiscanID != 0 && rec != nil {
+		return rec
+	}
+	return nil
+}
+
+// officeJSON is the stored shape of a domain.Office in the offices JSON field.
+type officeJSON struct {
+	Classification string `json:"classification"`
+	Address        string `json:"address,omitempty"`
+	Voice          string `json:"voice,omitempty"`
+	Fax            string `json:"fax,omitempty"`
+}
+
+func officesToJSON(offices []domain.Office) []officeJSON {
+	out := make([]officeJSON, 0, len(offices))
+	for _, o := range offices {
+		out = append(out, officeJSON(o))
+	}
+	return out
+}
+
 // recordToLegislator converts a PocketBase record to a domain.Legislator.
 func recordToLegislator(rec *core.Record) domain.Legislator {
+	var stored []officeJSON
+	_ = rec.UnmarshalJSONField("offices", &stored) // empty or malformed JSON leaves no offices
+	var offices []domain.Office
+	for _, o := range stored {
+		offices = append(offices, domain.Office(o))
+	}
+
 	return domain.Legislator{
 		ID:                rec.Id,
 		Chamber:           rec.GetString("chamber"),
@@ -144,8 +434,14 @@ func recordToLegislator(rec *core.Record) domain.Legislator {
 		Phone:             rec.GetString("phone"),
 		Website:           rec.GetString("website"),
 		ImageURL:          rec.GetString("image_url"),
+		Offices:           offices,
+		Twitter:           rec.GetString("twitter"),
+		Facebook:          rec.GetString("facebook"),
+		Instagram:         rec.GetString("instagram"),
+		YouTube:           rec.GetString("youtube"),
 		UtahLegislatureID: rec.GetString("utah_legislature_id"),
 		LegiscanID:        rec.GetInt("legiscan_id"),
 		OpenStatesID:      rec.GetString("openstates_id"),
+		Provenance:        recordProvenance(rec),
 	}
 }
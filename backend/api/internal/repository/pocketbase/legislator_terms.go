@@ -0,0 +1,165 @@
+package pocketbase
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"api/internal/domain"
+)
+
+const termCollection = "legislator_terms"
+
+// openTermFilter matches terms that have not ended.
+const openTermFilter = "end_date = ''"
+
+// currentSeatHolder returns the legislator record holding an open term for
+// the given seat, or nil if the seat has no current holder on record.
+func currentSeatHolder(app core.App, chamber string, districtNumber int) (*core.Record, error) {
+	if chamber == "" || districtNumber == 0 {
+		return nil, nil
+	}
+
+	term, err := app.FindFirstRecordByFilter(
+		termCollection,
+		"chamber = {:chamber} && district_number = {:district_number} && "+openTermFilter,
+		map[string]any{"chamber": chamber, "district_number": districtNumber},
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find current term: %w", err)
+	}
+
+	rec, err := app.FindRecordById(legislatorCollection, term.GetString("legislator"))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return rec, err
+}
+
+// syncCurrentTerm makes (chamber, districtNumber) the one open term for
+// legislatorID. Any other open term the person holds is closed, as is any
+// open term someone else holds on the same seat. A new term is opened if the
+// person doesn't already hold the seat.
+//
+// startHint is the upstream service start date. It only dates a person's
+// first term: for later terms (a chamber move, redistricting, a return to
+// office) it would predate the term, so those start now. If the person's
+// only term is the open one on this seat, as for a backfilled term dated
+// when it was backfilled, a startHint before its start moves the start back.
+func syncCurrentTerm(tx core.App, legislatorID, chamber string, districtNumber int, startHint *time.Time, now time.Time) error {
+	if chamber == "" || districtNumber == 0 {
+		return nil
+	}
+
+	open, err := tx.FindRecordsByFilter(
+		termCollection,
+		"(legislator = {:legislator} || (chamber = {:chamber} && district_number = {:district_number})) && "+openTermFilter,
+		"",
+		0,
+		0,
+		map[string]any{"legislator": legislatorID, "chamber": chamber, "district_number": districtNumber},
+	)
+	if err != nil {
+		return fmt.Errorf("find open terms: %w", err)
+	}
+
+	var held *core.Record
+	for _, t := range open {
+		sameSeat := t.GetString("chamber") == chamber && t.GetInt("district_number") == districtNumber
+		if t.GetString("legislator") == legislatorID && sameSeat {
+			held = t
+			continue
+		}
+		t.Set("end_date", now)
+		if err := tx.Save(t); err != nil {
+			return fmt.Errorf("close term: %w", err)
+		}
+	}
+
+	previous, err := tx.CountRecords(termCollection, dbx.HashExp{"legislator": legislatorID})
+	if err != nil {
+		return fmt.Errorf("count terms: %w", err)
+	}
+	if held != nil {
+		heldStart := held.GetDateTime("start_date")
+		if startHint != nil && previous == 1 && (heldStart.IsZero() || startHint.Before(heldStart.Time())) {
+			held.Set("start_date", *startHint)
+			if err := tx.Save(held); err != nil {
+				return fmt.Errorf("date term: %w", err)
+			}
+		}
+		return nil
+	}
+	start := now
+	if startHint != nil && previous == 0 {
+		start = *startHint
+	}
+
+	collection, err := tx.FindCollectionByNameOrId(termCollection)
+	if err != nil {
+		return fmt.Errorf("find collection: %w", err)
+	}
+	t := core.NewRecord(collection)
+	t.Set("legislator", legislatorID)
+	t.Set("chamber", chamber)
+	t.Set("district_number", districtNumber)
+	t.Set("start_date", start)
+	if err := tx.Save(t); err != nil {
+		return fmt.Errorf("open term: %w", err)
+	}
+	return nil
+}
+
+// recordToTerm converts a PocketBase record to a domain.Term.
+func recordToTerm(rec *core.Record) domain.Term {
+	t := domain.Term{
+		ID:             rec.Id,
+		LegislatorID:   rec.GetString("legislator"),
+		Chamber:        rec.GetString("chamber"),
+		DistrictNumber: rec.GetInt("district_number"),
+		Start:          rec.GetDateTime("start_date").Time(),
+	}
+	if d := rec.GetDateTime("end_date"); !d.IsZero() {
+		end := d.Time()
+		t.End = &end
+	}
+	return t
+}
+
+// laterTerm reports whether a should represent a person over b: an open
+// term beats a closed one, then the later end, then the later start.
+func laterTerm(a, b domain.Term) bool {
+	switch {
+	case a.End == nil && b.End != nil:
+		return true
+	case a.End != nil && b.End == nil:
+		return false
+	case a.End != nil && !a.End.Equal(*b.End):
+		return a.End.After(*b.End)
+	default:
+		return a.Start.After(b.Start)
+	}
+}
+
+// applyTerm sets a legislator's seat and term dates from t.
+func applyTerm(l *domain.Legislator, t domain.Term) {
+	l.Chamber = t.Chamber
+	l.DistrictNumber = t.DistrictNumber
+	l.TermStart, l.TermEnd, l.Current = termDates(t)
+}
+
+// termDates returns a term's start (nil if unknown), end, and whether it is open.
+func termDates(t domain.Term) (start, end *time.Time, current bool) {
+	if !t.Start.IsZero() {
+		s := t.Start
+		start = &s
+	}
+	return start, t.End, t.End == nil
+}
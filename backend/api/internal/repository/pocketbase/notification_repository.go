@@ -0,0 +1,319 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"api/internal/domain"
+)
+
+// NotificationRepository is the PocketBase implementation of repository.NotificationRepository.
+type NotificationRepository struct {
+	app core.App
+}
+
+// NewNotificationRepository creates a new PocketBase-backed NotificationRepository.
+func NewNotificationRepository(app core.App) *NotificationRepository {
+	return &NotificationRepository{app: app}
+}
+
+const (
+	subscriptionCollection         = "notification_subscriptions"
+	notificationSettingsCollection = "notification_settings"
+	deviceCollection               = "notification_devices"
+	notificationCollection         = "notifications"
+)
+
+// SaveSubscription stores a new subscription.
+func (r *NotificationRepository) SaveSubscription(ctx context.Context, s domain.NotificationSubscription) (domain.NotificationSubscription, error) {
+	collection, err := r.app.FindCollectionByNameOrId(subscriptionCollection)
+	if err != nil {
+		return domain.NotificationSubscription{}, fmt.Errorf("find collection: %w", err)
+	}
+	rec := core.NewRecord(collection)
+	rec.Set("user_id", s.UserID)
+	rec.Set("event_types", nonNil(s.EventTypes))
+	rec.Set("bill_id", s.BillID)
+	rec.Set("sponsor_id", s.SponsorID)
+	rec.Set("followed", s.Followed)
+	rec.Set("channels", nonNil(s.Channels))
+	rec.Set("created_at", time.Now().UTC().Truncate(time.Millisecond)) // as stored
+	if err := r.app.Save(rec); err != nil {
+		return domain.NotificationSubscription{}, fmt.Errorf("save subscription: %w", err)
+	}
+	return subscriptionFromRecord(rec), nil
+}
+
+// DeleteSubscription removes one of a user's subscriptions.
+func (r *NotificationRepository) DeleteSubscription(ctx context.Context, userID, id string) error {
+	rec, err := r.app.FindFirstRecordByFilter(subscriptionCollection,
+		"id = {:id} && user_id = {:user}", dbx.Params{"id": id, "user": userID})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("delete subscription: %w", err)
+	}
+	if err := r.app.Delete(rec); err != nil {
+		return fmt.Errorf("delete subscription %s: %w", id, err)
+	}
+	return nil
+}
+
+// ListSubscriptions returns a user's subscriptions, or everyone's.
+func (r *NotificationRepository) ListSubscriptions(ctx context.Context, userID string) ([]domain.NotificationSubscription, error) {
+	filter := ""
+	if userID != "" {
+		filter = "user_id = {:user}"
+	}
+	records, err := r.app.FindRecordsByFilter(subscriptionCollection, filter, "created_at, @rowid", 0, 0,
+		dbx.Params{"user": userID})
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w", err)
+	}
+
+	subs := make([]domain.NotificationSubscription, 0, len(records))
+	for _, rec := range records {
+		subs = append(subs, subscriptionFromRecord(rec))
+	}
+	return subs, nil
+}
+
+// GetNotificationSettings returns a user's settings, or the defaults.
+func (r *NotificationRepository) GetNotificationSettings(ctx context.Context, userID string) (domain.NotificationSettings, error) {
+	rec, err := findNotificationSettings(r.app, userID)
+	if err != nil {
+		return domain.NotificationSettings{}, fmt.Errorf("get notification settings: %w", err)
+	}
+	if rec == nil {
+		return domain.DefaultNotificationSettings(userID), nil
+	}
+	s := domain.NotificationSettings{
+		UserID:         userID,
+		Enabled:        rec.GetBool("enabled"),
+		Email:          rec.GetString("email"),
+		TimeZone:       rec.GetString("time_zone"),
+		QuietStart:     rec.GetInt("quiet_start"),
+		QuietEnd:       rec.GetInt("quiet_end"),
+		DigestInterval: time.Duration(rec.GetInt("digest_minutes")) * time.Minute,
+	}
+	if t := rec.GetDateTime("last_digest_at"); !t.IsZero() {
+		tt := t.Time()
+		s.LastDigestAt = &tt
+	}
+	return s, nil
+}
+
+// SaveNotificationSettings replaces a user's settings.
+func (r *NotificationRepository) SaveNotificationSettings(ctx context.Context, s domain.NotificationSettings) error {
+	return r.app.RunInTransaction(func(tx core.App) error {
+		rec, err := findNotificationSettings(tx, s.UserID)
+		if err != nil {
+			return fmt.Errorf("save notification settings: %w", err)
+		}
+		if rec == nil {
+			collection, err := tx.FindCollectionByNameOrId(notificationSettingsCollection)
+			if err != nil {
+				return fmt.Errorf("find collection: %w", err)
+			}
+			rec = core.NewRecord(collection)
+			rec.Set("user_id", s.UserID)
+		}
+		rec.Set("enabled", s.Enabled)
+		rec.Set("email", s.Email)
+		rec.Set("time_zone", s.TimeZone)
+		rec.Set("quiet_start", s.QuietStart)
+		rec.Set("quiet_end", s.QuietEnd)
+		rec.Set("digest_minutes", int(s.DigestInterval/time.Minute))
+		rec.Set("last_digest_at", dateOrEmpty(s.LastDigestAt))
+		if err := tx.Save(rec); err != nil {
+			return fmt.Errorf("save notification settings: %w", err)
+		}
+		return nil
+	})
+}
+
+// SaveDevice registers a push destination.
+func (r *NotificationRepository) SaveDevice(ctx context.Context, d domain.Device) (domain.Device, error) {
+	var out domain.Device
+	err := r.app.RunInTransaction(func(tx core.App) error {
+		rec, err := tx.FindFirstRecordByFilter(deviceCollection,
+			"channel = {:channel} && token = {:token}", dbx.Params{"channel": d.Channel, "token": d.Token})
+		if errors.Is(err, sql.ErrNoRows) {
+			collection, err := tx.FindCollectionByNameOrId(deviceCollection)
+			if err != nil {
+				return fmt.Errorf("find collection: %w", err)
+			}
+			rec = core.NewRecord(collection)
+			rec.Set("channel", d.Channel)
+			rec.Set("token", d.Token)
+			rec.Set("created_at", time.Now().UTC().Truncate(time.Millisecond))
+		} else if err != nil {
+			return fmt.Errorf("save device: %w", err)
+		}
+		rec.Set("user_id", d.UserID)
+		rec.Set("p256dh", d.P256dh)
+		rec.Set("auth", d.Auth)
+		if err := tx.Save(rec); err != nil {
+			return fmt.Errorf("save device: %w", err)
+		}
+		out = deviceFromRecord(rec)
+		return nil
+	})
+	return out, err
+}
+
+// DeleteDevice removes a user's device.
+func (r *NotificationRepository) DeleteDevice(ctx context.Context, userID, channel, token string) error {
+	rec, err := r.app.FindFirstRecordByFilter(deviceCollection,
+		"user_id = {:user} && channel = {:channel} && token = {:token}",
+		dbx.Params{"user": userID, "channel": channel, "token": token})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("delete device: %w", err)
+	}
+	if err := r.app.Delete(rec); err != nil {
+		return fmt.Errorf("delete device: %w", err)
+	}
+	return nil
+}
+
+// ListDevices returns a user's devices.
+func (r *NotificationRepository) ListDevices(ctx context.Context, userID string) ([]domain.Device, error) {
+	records, err := r.app.FindRecordsByFilter(deviceCollection, "user_id = {:user}", "created_at, @rowid", 0, 0,
+		dbx.Params{"user": userID})
+	if err != nil {
+		return nil, fmt.Errorf("list devices: %w", err)
+	}
+	devices := make([]domain.Device, 0, len(records))
+	for _, rec := range records {
+		devices = append(devices, deviceFromRecord(rec))
+	}
+	return devices, nil
+}
+
+// EnqueueNotifications adds notifications to the outbox in one transaction.
+func (r *NotificationRepository) EnqueueNotifications(ctx context.Context, ns []domain.Notification) error {
+	if len(ns) == 0 {
+		return nil
+	}
+	return r.app.RunInTransaction(func(tx core.App) error {
+		collection, err := tx.FindCollectionByNameOrId(notificationCollection)
+		if err != nil {
+			return fmt.Errorf("find collection: %w", err)
+		}
+		now := time.Now().UTC()
+		for _, n := range ns {
+			rec := core.NewRecord(collection)
+			rec.Set("user_id", n.UserID)
+			rec.Set("event_type", n.EventType)
+			rec.Set("bill_id", n.BillID)
+			rec.Set("title", n.Title)
+			rec.Set("body", n.Body)
+			rec.Set("channels", nonNil(n.Channels))
+			rec.Set("created_at", now)
+			if err := tx.Save(rec); err != nil {
+				return fmt.Errorf("enqueue notification: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// PendingNotifications returns the notifications not yet sent, oldest first.
+func (r *NotificationRepository) PendingNotifications(ctx context.Context) ([]domain.Notification, error) {
+	records, err := r.app.FindRecordsByFilter(notificationCollection, "sent_at = ''", "created_at, @rowid", 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("pending notifications: %w", err)
+	}
+	out := make([]domain.Notification, 0, len(records))
+	for _, rec := range records {
+		var channels []string
+		_ = rec.UnmarshalJSONField("channels", &channels)
+		out = append(out, domain.Notification{
+			ID:        rec.Id,
+			UserID:    rec.GetString("user_id"),
+			EventType: rec.GetString("event_type"),
+			BillID:    rec.GetString("bill_id"),
+			Title:     rec.GetString("title"),
+			Body:      rec.GetString("body"),
+			Channels:  channels,
+			CreatedAt: rec.GetDateTime("created_at").Time(),
+		})
+	}
+	return out, nil
+}
+
+// MarkNotificationsSent records when notifications were delivered.
+func (r *NotificationRepository) MarkNotificationsSent(ctx context.Context, ids []string, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.app.RunInTransaction(func(tx core.App) error {
+		records, err := tx.FindRecordsByIds(notificationCollection, ids)
+		if err != nil {
+			return fmt.Errorf("mark notifications sent: %w", err)
+		}
+		for _, rec := range records {
+			rec.Set("sent_at", at.UTC())
+			if err := tx.Save(rec); err != nil {
+				return fmt.Errorf("mark notification %s sent: %w", rec.Id, err)
+			}
+		}
+		return nil
+	})
+}
+
+// findNotificationSettings returns a user's settings record, or nil.
+func findNotificationSettings(app core.App, userID string) (*core.Record, error) {
+	rec, err := app.FindFirstRecordByFilter(notificationSettingsCollection,
+		"user_id = {:user}", dbx.Params{"user": userID})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return rec, err
+}
+
+func subscriptionFromRecord(rec *core.Record) domain.NotificationSubscription {
+	var eventTypes, channels []string
+	_ = rec.UnmarshalJSONField("event_types", &eventTypes)
+	_ = rec.UnmarshalJSONField("channels", &channels)
+	return domain.NotificationSubscription{
+		ID:         rec.Id,
+		UserID:     rec.GetString("user_id"),
+		EventTypes: eventTypes,
+		BillID:     rec.GetString("bill_id"),
+		SponsorID:  rec.GetString("sponsor_id"),
+		Followed:   rec.GetBool("followed"),
+		Channels:   channels,
+		CreatedAt:  rec.GetDateTime("created_at").Time(),
+	}
+}
+
+func deviceFromRecord(rec *core.Record) domain.Device {
+	return domain.Device{
+		ID:        rec.Id,
+		UserID:    rec.GetString("user_id"),
+		Channel:   rec.GetString("channel"),
+		Token:     rec.GetString("token"),
+		P256dh:    rec.GetString("p256dh"),
+		Auth:      rec.GetString("auth"),
+		CreatedAt: rec.GetDateTime("created_at").Time(),
+	}
+}
+
+// nonNil stores a nil slice as an empty JSON array rather than null.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
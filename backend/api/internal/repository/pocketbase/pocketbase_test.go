@@ -0,0 +1,204 @@
+package pocketbase
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pocketbase/pocketbase/core"
+	_ "github.com/pocketbase/pocketbase/migrations" // system collections
+
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/repository/repositorytest"
+)
+
+// newApp bootstraps a throwaway PocketBase app in a temp directory.
+func newApp(t *testing.T) core.App {
+	t.Helper()
+	// Not t.TempDir: PocketBase's background log writer can still be
+	// flushing when the directory is removed, which fails the test.
+	dir, err := os.MkdirTemp("", "pocketbase-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	app := core.NewBaseApp(core.BaseAppConfig{DataDir: dir})
+	if err := app.Bootstrap(); err != nil {
+		t.Fatalf("bootstrap pocketbase: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = app.ResetBootstrapState()
+		_ = os.RemoveAll(dir)
+	})
+	if err := SetupCollections(app); err != nil {
+		t.Fatalf("setup collections: %v", err)
+	}
+	return app
+}
+
+// newStores returns repositories over a throwaway PocketBase app.
+func newStores(t *testing.T) repositorytest.Stores {
+	t.Helper()
+	app := newApp(t)
+	return repositorytest.Stores{
+		Bills:       NewBillRepository(app),
+		Legislators: NewLegislatorRepository(app),
+		Stats:       NewStatsRepository(app),
+		Sync:        NewSyncRepository(app),
+		Runs:        NewJobRunRepository(app),
+		DeadLetters: NewDeadLetterRepository(app),
+		Watchlist:   NewWatchlistRepository(app),
+
+		Notifications: NewNotificationRepository(app),
+		Webhooks:      NewWebhookRepository(app),
+		APIKeys:       NewAPIKeyRepository(app),
+	}
+}
+
+func TestLegislatorRepository(t *testing.T) {
+	repositorytest.TestLegislatorRepository(t, newStores)
+}
+
+func TestBillRepository(t *testing.T) {
+	repositorytest.TestBillRepository(t, newStores)
+}
+
+func TestStatsRepository(t *testing.T) {
+	repositorytest.TestStatsRepository(t, newStores)
+}
+
+func TestSyncRepository(t *testing.T) {
+	repositorytest.TestSyncRepository(t, newStores)
+}
+
+func TestJobRunRepository(t *testing.T) {
+	repositorytest.TestJobRunRepository(t, newStores)
+}
+
+func TestDeadLetterRepository(t *testing.T) {
+	repositorytest.TestDeadLetterRepository(t, newStores)
+}
+
+// TestBackfillTerms checks that legislators stored before terms were tracked
+// get a dated term, that undated terms get a start date, and that a sync
+// with the upstream start date moves a backfilled start back to it.
+func TestBackfillTerms(t *testing.T) {
+	ctx := context.Background()
+	app := newApp(t)
+	legislators, err := app.FindCollectionByNameOrId(legislatorCollection)
+	if err != nil {
+		t.Fatal(err)
+	}
+	terms, err := app.FindCollectionByNameOrId(termCollection)
+	if err != nil {
+		t.Fatal(err)
+	}
+	person := func(utahID string, district int) *core.Record {
+		rec := core.NewRecord(legislators)
+		rec.Set("chamber", "house")
+		rec.Set("district_number", district)
+		rec.Set("first_name", "Jane")
+		rec.Set("last_name", utahID)
+		rec.Set("utah_legislature_id", utahID)
+		if err := app.Save(rec); err != nil {
+			t.Fatal(err)
+		}
+		return rec
+	}
+	person("UNTRACKED", 1)
+	undated := core.NewRecord(terms)
+	undated.Set("legislator", person("UNDATED", 2).Id)
+	undated.Set("chamber", "house")
+	undated.Set("district_number", 2)
+	if err := app.Save(undated); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := SetupCollections(app); err != nil {
+		t.Fatalf("setup collections: %v", err)
+	}
+
+	repo := NewLegislatorRepository(app)
+	current, err := repo.ListLegislators(ctx, repository.LegislatorFilters{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(current) != 2 {
+		t.Fatalf("got %d current members, want 2", len(current))
+	}
+	for _, l := range current {
+		if !l.Current || l.TermStart == nil {
+			t.Errorf("%s: Current = %v, TermStart = %v; want a dated current term", l.UtahLegislatureID, l.Current, l.TermStart)
+		}
+	}
+
+	start := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for _, utahID := range []string{"UNTRACKED", "UNDATED"} {
+		district := 1
+		if utahID == "UNDATED" {
+			district = 2
+		}
+		err := repo.UpsertLegislator(ctx, domain.Legislator{
+			Chamber:           "house",
+			DistrictNumber:    district,
+			FirstName:         "Jane",
+			LastName:          utahID,
+			UtahLegislatureID: utahID,
+			TermStart:         &start,
+			Source:            domain.SourceUtahLegislature,
+		})
+		if err != nil {
+			t.Fatalf("UpsertLegislator(%s): %v", utahID, err)
+		}
+	}
+
+	asOf := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
+	got, err := repo.ListLegislators(ctx, repository.LegislatorFilters{AsOf: &asOf})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("as of %s got %d members, want 2", asOf.Format(time.DateOnly), len(got))
+	}
+	for _, l := range got {
+		if l.TermStart == nil || !l.TermStart.Equal(start) {
+			t.Errorf("%s: TermStart = %v, want %v", l.UtahLegislatureID, l.TermStart, start)
+		}
+	}
+}
+
+// TestUpsertBillsIsAtomic checks that one invalid bill rolls back the batch.
+func TestUpsertBillsIsAtomic(t *testing.T) {
+	ctx := context.Background()
+	repo := newStores(t).Bills
+	valid := domain.Bill{BillNumber: "HB0001", BillType: "HB", SessionYear: 2026, Title: "Valid", Status: "introduced"}
+	invalid := domain.Bill{BillNumber: "HB0002", BillType: "HB", SessionYear: 2026, Status: "introduced"} // no title
+
+	if err := repo.UpsertBills(ctx, []domain.Bill{valid, invalid}); err == nil {
+		t.Fatal("UpsertBills with an invalid bill succeeded")
+	}
+	bills, err := repo.ListBills(ctx, repository.BillFilters{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bills) != 0 {
+		t.Errorf("stored %d bills after a failed batch, want 0", len(bills))
+	}
+}
+
+func TestWatchlistRepository(t *testing.T) {
+	repositorytest.TestWatchlistRepository(t, newStores)
+}
+
+func TestNotificationRepository(t *testing.T) {
+	repositorytest.TestNotificationRepository(t, newStores)
+}
+
+func TestWebhookRepository(t *testing.T) {
+	repositorytest.TestWebhookRepository(t, newStores)
+}
+
+func TestAPIKeyRepository(t *testing.T) {
+	repositorytest.TestAPIKeyRepository(t, newStores)
+}
@@ -0,0 +1,41 @@
+package pocketbase
+
+import (
+	"time"
+
+	"github.com/pocketbase/pocketbase/core"
+
+	"api/internal/domain"
+)
+
+// provenanceField is the JSON field holding per-field source provenance on
+// both the bills and legislators collections.
+const provenanceField = "provenance"
+
+// fieldSourceJSON is the stored shape of a domain.FieldSource.
+type fieldSourceJSON struct {
+	Source    string    `json:"source"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func provenanceToJSON(p domain.Provenance) map[string]fieldSourceJSON {
+	out := make(map[string]fieldSourceJSON, len(p))
+	for k, v := range p {
+		out[k] = fieldSourceJSON(v)
+	}
+	return out
+}
+
+// recordProvenance reads the provenance JSON field from a record. Records
+// written before provenance tracking have none and return nil.
+func recordProvenance(rec *core.Record) domain.Provenance {
+	var stored map[string]fieldSourceJSON
+	if err := rec.UnmarshalJSONField(provenanceField, &stored); err != nil || len(stored) == 0 {
+		return nil
+	}
+	out := make(domain.Provenance, len(stored))
+	for k, v := range stored {
+		out[k] = domain.FieldSource(v)
+	}
+	return out
+}
@@ -0,0 +1,138 @@
+package pocketbase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"api/internal/domain"
+)
+
+// StatsRepository is the PocketBase implementation of repository.StatsRepository.
+type StatsRepository struct {
+	app core.App
+}
+
+// NewStatsRepository creates a new PocketBase-backed StatsRepository.
+func NewStatsRepository(app core.App) *StatsRepository {
+	return &StatsRepository{app: app}
+}
+
+const statsCollection = "legislator_stats"
+
+// agreementJSON is the stored shape of a domain.Agreement.
+type agreementJSON struct {
+	LegislatorID string  `json:"legislator_id"`
+	SharedVotes  int     `json:"shared_votes"`
+	Rate         float64 `json:"rate"`
+}
+
+// GetLegislatorStats returns a legislator's per-session stats, newest first.
+func (r *StatsRepository) GetLegislatorStats(ctx context.Context, legislatorID string, sessionYear int) ([]domain.LegislatorStats, error) {
+	filter := "legislator = {:legislator}"
+	params := map[string]any{"legislator": legislatorID}
+	if sessionYear != 0 {
+		filter += " && session_year = {:session_year}"
+		params["session_year"] = sessionYear
+	}
+
+	records, err := r.app.FindRecordsByFilter(statsCollection, filter, "-session_year,-session_id", 0, 0, params)
+	if err != nil {
+		return nil, fmt.Errorf("get legislator stats: %w", err)
+	}
+
+	stats := make([]domain.LegislatorStats, 0, len(records))
+	for _, rec := range records {
+		stats = append(stats, recordToStats(rec))
+	}
+	return stats, nil
+}
+
+// SessionDatasetHash returns the dataset hash of a session's stats.
+func (r *StatsRepository) SessionDatasetHash(ctx context.Context, sessionID int) (string, error) {
+	records, err := r.app.FindRecordsByFilter(statsCollection, "session_id = {:session_id}", "", 1, 0, dbx.Params{"session_id": sessionID})
+	if err != nil {
+		return "", fmt.Errorf("session dataset hash: %w", err)
+	}
+	if len(records) == 0 {
+		return "", nil
+	}
+	return records[0].GetString("dataset_hash"), nil
+}
+
+// ReplaceSessionStats deletes a session's stats and writes the new set in
+// one transaction, so readers never see a half-written session.
+func (r *StatsRepository) ReplaceSessionStats(ctx context.Context, sessionID int, stats []domain.LegislatorStats) error {
+	return r.app.RunInTransaction(func(tx core.App) error {
+		collection, err := tx.FindCollectionByNameOrId(statsCollection)
+		if err != nil {
+			return fmt.Errorf("find collection: %w", err)
+		}
+
+		old, err := tx.FindAllRecords(collection, dbx.HashExp{"session_id": sessionID})
+		if err != nil {
+			return fmt.Errorf("find session stats: %w", err)
+		}
+		for _, rec := range old {
+			if err := tx.Delete(rec); err != nil {
+				return fmt.Errorf("delete session stats: %w", err)
+			}
+		}
+
+		for _, s := range stats {
+			agreement := make([]agreementJSON, 0, len(s.Agreement))
+			for _, a := range s.Agreement {
+				agreement = append(agreement, agreementJSON(a))
+			}
+
+			rec := core.NewRecord(collection)
+			rec.Set("legislator", s.LegislatorID)
+			rec.Set("session_id", sessionID)
+			rec.Set("session_name", s.SessionName)
+			rec.Set("session_year", s.SessionYear)
+			rec.Set("eligible_votes", s.EligibleVotes)
+			rec.Set("votes_cast", s.VotesCast)
+			rec.Set("missed_votes", s.MissedVotes)
+			rec.Set("participation_rate", s.ParticipationRate)
+			rec.Set("votes_against_party", s.VotesAgainstParty)
+			rec.Set("bills_sponsored", s.BillsSponsored)
+			rec.Set("bills_passed", s.BillsPassed)
+			rec.Set("agreement", agreement)
+			rec.Set("computed_at", s.ComputedAt)
+			rec.Set("dataset_hash", s.DatasetHash)
+			if err := tx.Save(rec); err != nil {
+				return fmt.Errorf("save stats for %s: %w", s.LegislatorID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// recordToStats converts a PocketBase record to a domain.LegislatorStats.
+func recordToStats(rec *core.Record) domain.LegislatorStats {
+	s := domain.LegislatorStats{
+		LegislatorID:      rec.GetString("legislator"),
+		SessionID:         rec.GetInt("session_id"),
+		SessionName:       rec.GetString("session_name"),
+		SessionYear:       rec.GetInt("session_year"),
+		EligibleVotes:     rec.GetInt("eligible_votes"),
+		VotesCast:         rec.GetInt("votes_cast"),
+		MissedVotes:       rec.GetInt("missed_votes"),
+		ParticipationRate: rec.GetFloat("participation_rate"),
+		VotesAgainstParty: rec.GetInt("votes_against_party"),
+		BillsSponsored:    rec.GetInt("bills_sponsored"),
+		BillsPassed:       rec.GetInt("bills_passed"),
+		ComputedAt:        rec.GetDateTime("computed_at").Time(),
+		DatasetHash:       rec.GetString("dataset_hash"),
+	}
+
+	var agreement []agreementJSON
+	if err := rec.UnmarshalJSONField("agreement", &agreement); err == nil {
+		for _, a := range agreement {
+			s.Agreement = append(s.Agreement, domain.Agreement(a))
+		}
+	}
+	return s
+}
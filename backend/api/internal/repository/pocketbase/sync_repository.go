@@ -0,0 +1,84 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pocketbase/pocketbase/core"
+
+	"api/internal/domain"
+)
+
+// SyncRepository is the PocketBase implementation of repository.SyncRepository.
+type SyncRepository struct {
+	app core.App
+}
+
+// NewSyncRepository creates a new PocketBase-backed SyncRepository.
+func NewSyncRepository(app core.App) *SyncRepository {
+	return &SyncRepository{app: app}
+}
+
+const syncCollection = "sync_versions"
+
+// GetSyncVersion returns the current marker for entity.
+func (r *SyncRepository) GetSyncVersion(ctx context.Context, entity string) (domain.SyncVersion, error) {
+	rec, err := findSyncRecord(r.app, entity)
+	if err != nil {
+		return domain.SyncVersion{}, fmt.Errorf("get sync version: %w", err)
+	}
+	if rec == nil {
+		return domain.SyncVersion{Entity: entity}, nil
+	}
+	return recordToSyncVersion(rec), nil
+}
+
+// BumpSyncVersion increments entity's version and sets SyncedAt to now.
+func (r *SyncRepository) BumpSyncVersion(ctx context.Context, entity string) (domain.SyncVersion, error) {
+	var v domain.SyncVersion
+	err := r.app.RunInTransaction(func(tx core.App) error {
+		rec, err := findSyncRecord(tx, entity)
+		if err != nil {
+			return err
+		}
+		if rec == nil {
+			collection, err := tx.FindCollectionByNameOrId(syncCollection)
+			if err != nil {
+				return fmt.Errorf("find collection: %w", err)
+			}
+			rec = core.NewRecord(collection)
+			rec.Set("entity", entity)
+		}
+		rec.Set("version", rec.GetInt("version")+1)
+		rec.Set("synced_at", time.Now().UTC())
+		if err := tx.Save(rec); err != nil {
+			return err
+		}
+		v = recordToSyncVersion(rec)
+		return nil
+	})
+	if err != nil {
+		return domain.SyncVersion{}, fmt.Errorf("bump sync version: %w", err)
+	}
+	return v, nil
+}
+
+// findSyncRecord returns the marker record for entity, or nil.
+func findSyncRecord(app core.App, entity string) (*core.Record, error) {
+	rec, err := app.FindFirstRecordByData(syncCollection, "entity", entity)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return rec, err
+}
+
+func recordToSyncVersion(rec *core.Record) domain.SyncVersion {
+	return domain.SyncVersion{
+		Entity:   rec.GetString("entity"),
+		Version:  int64(rec.GetInt("version")),
+		SyncedAt: rec.GetDateTime("synced_at").Time(),
+	}
+}
@@ -0,0 +1,109 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"api/internal/domain"
+)
+
+// WatchlistRepository is the PocketBase implementation of repository.WatchlistRepository.
+type WatchlistRepository struct {
+	app core.App
+}
+
+// NewWatchlistRepository creates a new PocketBase-backed WatchlistRepository.
+func NewWatchlistRepository(app core.App) *WatchlistRepository {
+	return &WatchlistRepository{app: app}
+}
+
+const followCollection = "follows"
+
+// Follow adds an item to a user's watchlist.
+func (r *WatchlistRepository) Follow(ctx context.Context, f domain.Follow) (domain.Follow, error) {
+	var out domain.Follow
+	err := r.app.RunInTransaction(func(tx core.App) error {
+		rec, err := findFollow(tx, f.UserID, f.Kind, f.TargetID)
+		if err != nil {
+			return fmt.Errorf("follow: %w", err)
+		}
+		if rec == nil {
+			collection, err := tx.FindCollectionByNameOrId(followCollection)
+			if err != nil {
+				return fmt.Errorf("find collection: %w", err)
+			}
+			rec = core.NewRecord(collection)
+			rec.Set("user_id", f.UserID)
+			rec.Set("kind", f.Kind)
+			rec.Set("target_id", f.TargetID)
+			rec.Set("created_at", time.Now().UTC().Truncate(time.Millisecond)) // as stored
+			if err := tx.Save(rec); err != nil {
+				return fmt.Errorf("follow %s %s: %w", f.Kind, f.TargetID, err)
+			}
+		}
+		out = followFromRecord(rec)
+		return nil
+	})
+	return out, err
+}
+
+// Unfollow removes an item from a user's watchlist.
+func (r *WatchlistRepository) Unfollow(ctx context.Context, userID, kind, targetID string) error {
+	rec, err := findFollow(r.app, userID, kind, targetID)
+	if err != nil {
+		return fmt.Errorf("unfollow: %w", err)
+	}
+	if rec == nil {
+		return nil
+	}
+	if err := r.app.Delete(rec); err != nil {
+		return fmt.Errorf("unfollow %s %s: %w", kind, targetID, err)
+	}
+	return nil
+}
+
+// ListFollows returns a user's follows, newest first.
+func (r *WatchlistRepository) ListFollows(ctx context.Context, userID, kind string) ([]domain.Follow, error) {
+	filter := "user_id = {:user}"
+	if kind != "" {
+		filter += " && kind = {:kind}"
+	}
+	records, err := r.app.FindRecordsByFilter(followCollection, filter, "-created_at, -id", 0, 0,
+		dbx.Params{"user": userID, "kind": kind})
+	if err != nil {
+		return nil, fmt.Errorf("list follows: %w", err)
+	}
+
+	follows := make([]domain.Follow, 0, len(records))
+	for _, rec := range records {
+		follows = append(follows, followFromRecord(rec))
+	}
+	return follows, nil
+}
+
+// findFollow returns the follow of user, kind and target, or nil.
+func findFollow(app core.App, userID, kind, targetID string) (*core.Record, error) {
+	rec, err := app.FindFirstRecordByFilter(followCollection,
+		"user_id = {:user} && kind = {:kind} && target_id = {:target}",
+		dbx.Params{"user": userID, "kind": kind, "target": targetID})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return rec, err
+}
+
+func followFromRecord(rec *core.Record) domain.Follow {
+	return domain.Follow{
+		ID:        rec.Id,
+		UserID:    rec.GetString("user_id"),
+		Kind:      rec.GetString("kind"),
+		TargetID:  rec.GetString("target_id"),
+		CreatedAt: rec.GetDateTime("created_at").Time(),
+	}
+}
@@ -0,0 +1,254 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+
+	"api/internal/domain"
+)
+
+// WebhookRepository is the PocketBase implementation of repository.WebhookRepository.
+type WebhookRepository struct {
+	app core.App
+}
+
+// NewWebhookRepository creates a new PocketBase-backed WebhookRepository.
+func NewWebhookRepository(app core.App) *WebhookRepository {
+	return &WebhookRepository{app: app}
+}
+
+const (
+	webhookCollection  = "webhooks"
+	deliveryCollection = "webhook_deliveries"
+)
+
+// CreateWebhook stores a new webhook.
+func (r *WebhookRepository) CreateWebhook(ctx context.Context, w domain.Webhook) (domain.Webhook, error) {
+	collection, err := r.app.FindCollectionByNameOrId(webhookCollection)
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("find collection: %w", err)
+	}
+	rec := core.NewRecord(collection)
+	rec.Set("owner_id", w.OwnerID)
+	rec.Set("url", w.URL)
+	rec.Set("secret", w.Secret)
+	rec.Set("event_types", nonNil(w.EventTypes))
+	rec.Set("bill_id", w.BillID)
+	rec.Set("sponsor_id", w.SponsorID)
+	rec.Set("keywords", nonNil(w.Keywords))
+	rec.Set("created_at", time.Now().UTC().Truncate(time.Millisecond)) // as stored
+	if err := r.app.Save(rec); err != nil {
+		return domain.Webhook{}, fmt.Errorf("create webhook: %w", err)
+	}
+	return webhookFromRecord(rec), nil
+}
+
+// GetWebhook returns a webhook by ID.
+func (r *WebhookRepository) GetWebhook(ctx context.Context, id string) (*domain.Webhook, error) {
+	rec, err := r.app.FindRecordById(webhookCollection, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get webhook %s: %w", id, err)
+	}
+	w := webhookFromRecord(rec)
+	return &w, nil
+}
+
+// ListWebhooks returns an owner's webhooks, or everyone's.
+func (r *WebhookRepository) ListWebhooks(ctx context.Context, ownerID string) ([]domain.Webhook, error) {
+	filter := ""
+	if ownerID != "" {
+		filter = "owner_id = {:owner}"
+	}
+	records, err := r.app.FindRecordsByFilter(webhookCollection, filter, "created_at, @rowid", 0, 0,
+		dbx.Params{"owner": ownerID})
+	if err != nil {
+		return nil, fmt.Errorf("list webhooks: %w", err)
+	}
+	out := make([]domain.Webhook, 0, len(records))
+	for _, rec := range records {
+		out = append(out, webhookFromRecord(rec))
+	}
+	return out, nil
+}
+
+// DeleteWebhook removes one of an owner's webhooks and its deliveries in
+// one transaction.
+func (r *WebhookRepository) DeleteWebhook(ctx context.Context, ownerID, id string) error {
+	return r.app.RunInTransaction(func(tx core.App) error {
+		rec, err := tx.FindFirstRecordByFilter(webhookCollection,
+			"id = {:id} && owner_id = {:owner}", dbx.Params{"id": id, "owner": ownerID})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("delete webhook: %w", err)
+		}
+		deliveries, err := tx.FindAllRecords(deliveryCollection, dbx.HashExp{"webhook_id": id})
+		if err != nil {
+			return fmt.Errorf("delete webhook deliveries: %w", err)
+		}
+		for _, d := range deliveries {
+			if err := tx.Delete(d); err != nil {
+				return fmt.Errorf("delete webhook delivery %s: %w", d.Id, err)
+			}
+		}
+		if err := tx.Delete(rec); err != nil {
+			return fmt.Errorf("delete webhook %s: %w", id, err)
+		}
+		return nil
+	})
+}
+
+// EnqueueDeliveries adds deliveries to the log in one transaction.
+func (r *WebhookRepository) EnqueueDeliveries(ctx context.Context, ds []domain.WebhookDelivery) ([]domain.WebhookDelivery, error) {
+	out := make([]domain.WebhookDelivery, 0, len(ds))
+	if len(ds) == 0 {
+		return out, nil
+	}
+	err := r.app.RunInTransaction(func(tx core.App) error {
+		collection, err := tx.FindCollectionByNameOrId(deliveryCollection)
+		if err != nil {
+			return fmt.Errorf("find collection: %w", err)
+		}
+		now := time.Now().UTC()
+		for _, d := range ds {
+			rec := core.NewRecord(collection)
+			rec.Set("webhook_id", d.WebhookID)
+			rec.Set("event_id", d.EventID)
+			rec.Set("event_type", d.EventType)
+			rec.Set("payload", string(d.Payload))
+			rec.Set("replay_of", d.ReplayOf)
+			rec.Set("created_at", now)
+			setDeliveryAttempt(rec, d)
+			if err := tx.Save(rec); err != nil {
+				return fmt.Errorf("enqueue webhook delivery: %w", err)
+			}
+			out = append(out, deliveryFromRecord(rec))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DueDeliveries returns the pending deliveries due by now, oldest first.
+func (r *WebhookRepository) DueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	records, err := r.app.FindRecordsByFilter(deliveryCollection,
+		"status = {:status} && next_attempt_at <= {:now}", "created_at, @rowid", max(limit, 0), 0,
+		dbx.Params{"status": domain.DeliveryPending, "now": now.UTC().Format(types.DefaultDateLayout)})
+	if err != nil {
+		return nil, fmt.Errorf("due webhook deliveries: %w", err)
+	}
+	return deliveriesFromRecords(records), nil
+}
+
+// UpdateDelivery stores the outcome of an attempt.
+func (r *WebhookRepository) UpdateDelivery(ctx context.Context, d domain.WebhookDelivery) error {
+	rec, err := r.app.FindRecordById(deliveryCollection, d.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("update webhook delivery %s: %w", d.ID, err)
+	}
+	setDeliveryAttempt(rec, d)
+	if err := r.app.Save(rec); err != nil {
+		return fmt.Errorf("update webhook delivery %s: %w", d.ID, err)
+	}
+	return nil
+}
+
+// GetDelivery returns a delivery by ID.
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	rec, err := r.app.FindRecordById(deliveryCollection, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get webhook delivery %s: %w", id, err)
+	}
+	d := deliveryFromRecord(rec)
+	return &d, nil
+}
+
+// ListDeliveries returns a webhook's most recent deliveries, newest first.
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, webhookID string, limit int) ([]domain.WebhookDelivery, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	records, err := r.app.FindRecordsByFilter(deliveryCollection, "webhook_id = {:webhook}", "-created_at, -@rowid", limit, 0,
+		dbx.Params{"webhook": webhookID})
+	if err != nil {
+		return nil, fmt.Errorf("list webhook deliveries: %w", err)
+	}
+	return deliveriesFromRecords(records), nil
+}
+
+// setDeliveryAttempt sets the fields UpdateDelivery stores.
+func setDeliveryAttempt(rec *core.Record, d domain.WebhookDelivery) {
+	rec.Set("status", d.Status)
+	rec.Set("attempts", d.Attempts)
+	rec.Set("next_attempt_at", d.NextAttemptAt.UTC())
+	rec.Set("last_attempt_at", dateOrEmpty(d.LastAttemptAt))
+	rec.Set("response_status", d.ResponseStatus)
+	rec.Set("last_error", d.LastError)
+}
+
+func webhookFromRecord(rec *core.Record) domain.Webhook {
+	var eventTypes, keywords []string
+	_ = rec.UnmarshalJSONField("event_types", &eventTypes)
+	_ = rec.UnmarshalJSONField("keywords", &keywords)
+	return domain.Webhook{
+		ID:         rec.Id,
+		OwnerID:    rec.GetString("owner_id"),
+		URL:        rec.GetString("url"),
+		Secret:     rec.GetString("secret"),
+		EventTypes: eventTypes,
+		BillID:     rec.GetString("bill_id"),
+		SponsorID:  rec.GetString("sponsor_id"),
+		Keywords:   keywords,
+		CreatedAt:  rec.GetDateTime("created_at").Time(),
+	}
+}
+
+func deliveriesFromRecords(records []*core.Record) []domain.WebhookDelivery {
+	out := make([]domain.WebhookDelivery, 0, len(records))
+	for _, rec := range records {
+		out = append(out, deliveryFromRecord(rec))
+	}
+	return out
+}
+
+func deliveryFromRecord(rec *core.Record) domain.WebhookDelivery {
+	d := domain.WebhookDelivery{
+		ID:             rec.Id,
+		WebhookID:      rec.GetString("webhook_id"),
+		EventID:        rec.GetString("event_id"),
+		EventType:      rec.GetString("event_type"),
+		Payload:        []byte(rec.GetString("payload")),
+		Status:         rec.GetString("status"),
+		Attempts:       rec.GetInt("attempts"),
+		NextAttemptAt:  rec.GetDateTime("next_attempt_at").Time(),
+		ResponseStatus: rec.GetInt("response_status"),
+		LastError:      rec.GetString("last_error"),
+		ReplayOf:       rec.GetString("replay_of"),
+		CreatedAt:      rec.GetDateTime("created_at").Time(),
+	}
+	if t := rec.GetDateTime("last_attempt_at"); !t.IsZero() {
+		tt := t.Time()
+		d.LastAttemptAt = &tt
+	}
+	return d
+}
@@ -0,0 +1,282 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"api/internal/domain"
+	"api/internal/merge"
+	"api/internal/repository"
+)
+
+// BillRepository is the Postgres implementation of repository.BillRepository.
+type BillRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewBillRepository creates a new Postgres-backed BillRepository.
+func NewBillRepository(db *pgxpool.Pool) *BillRepository {
+	return &BillRepository{db: db}
+}
+
+// billSelect loads bills with their sponsor in a single query.
+var billSelect = `SELECT
+	b.id::text, b.bill_number, b.bill_type, b.session_year, b.title,
+	COALESCE(b.description, ''), b.status, COALESCE(b.sponsor_id::text, ''), COALESCE(b.sponsor_utah_id, ''),
+	COALESCE(b.full_text_url, ''), COALESCE(b.last_action, ''), b.last_action_date,
+	COALESCE(b.fiscal_note_url, ''), b.effective_date,
+	COALESCE(b.utah_legislature_id, ''), COALESCE(b.legiscan_id, 0),
+	COALESCE(b.provenance, '{}'::jsonb), ` + legislatorColumns("s") + `
+	FROM utah_bills b
+	LEFT JOIN utah_legislators s ON s.id = b.sponsor_id`
+
+// ListBills returns bills filtered by the given criteria.
+func (r *BillRepository) ListBills(ctx context.Context, f repository.BillFilters) ([]domain.Bill, error) {
+	where := []string{}
+	args := []any{}
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if f.SessionYear > 0 {
+		where = append(where, "b.session_year = "+arg(f.SessionYear))
+	}
+	if f.Status != "" {
+		where = append(where, "b.status = "+arg(f.Status))
+	}
+	if f.SponsorID != "" {
+		if !validUUID(f.SponsorID) {
+			return []domain.Bill{}, nil
+		}
+		where = append(where, "b.sponsor_id = "+arg(f.SponsorID))
+	}
+	if f.IDs != nil {
+		ids := make([]string, 0, len(f.IDs))
+		for _, id := range f.IDs {
+			if validUUID(id) {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			return []domain.Bill{}, nil
+		}
+		where = append(where, "b.id = ANY("+arg(ids)+"::uuid[])")
+	}
+	if f.BillNumbers != nil {
+		if len(f.BillNumbers) == 0 {
+			return []domain.Bill{}, nil
+		}
+		where = append(where, "b.bill_number = ANY("+arg(f.BillNumbers)+"::text[])")
+	}
+
+	pageSize := f.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	page := f.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	query := billSelect
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	order := "b.session_year DESC, b.bill_number"
+	if f.ByActivity {
+		order = "b.last_action_date DESC NULLS LAST, " + order
+	}
+	query += " ORDER BY " + order + " LIMIT " + arg(pageSize) + " OFFSET " + arg((page-1)*pageSize)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list bills: %w", err)
+	}
+	defer rows.Close()
+
+	bills := []domain.Bill{}
+	for rows.Next() {
+		b, err := scanBill(rows)
+		if err != nil {
+			return nil, fmt.Errorf("list bills: %w", err)
+		}
+		if f.OmitSponsor {
+			b.Sponsor = nil
+		}
+		bills = append(bills, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list bills: %w", err)
+	}
+	return bills, nil
+}
+
+// GetBill returns a single bill by its ID, with the sponsor populated.
+func (r *BillRepository) GetBill(ctx context.Context, id string) (*domain.Bill, error) {
+	if !validUUID(id) {
+		return nil, nil
+	}
+	b, err := findBill(ctx, r.db, "b.id = $1", id)
+	if err != nil {
+		return nil, fmt.Errorf("get bill: %w", err)
+	}
+	return b, nil
+}
+
+// UpsertBill inserts or updates a bill keyed on (bill_number, session_year).
+// Incoming fields are merged with the stored row according to
+// merge.DefaultBillRules, and per-field provenance is recorded.
+func (r *BillRepository) UpsertBill(ctx context.Context, b domain.Bill) error {
+	return r.UpsertBills(ctx, []domain.Bill{b})
+}
+
+// UpsertBills upserts a batch of bills in a single transaction: either every
+// bill is written or none is.
+func (r *BillRepository) UpsertBills(ctx context.Context, bills []domain.Bill) error {
+	if len(bills) == 0 {
+		return nil
+	}
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		now := time.Now().UTC()
+		for _, b := range bills {
+			if err := upsertBill(ctx, tx, b, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// upsertBill does the work of UpsertBills for one bill inside a transaction.
+func upsertBill(ctx context.Context, tx pgx.Tx, b domain.Bill, now time.Time) error {
+	existing, err := findBill(ctx, tx,
+		"b.bill_number = $1 AND b.session_year = $2 FOR UPDATE OF b",
+		b.BillNumber, b.SessionYear,
+	)
+	if err != nil {
+		return fmt.Errorf("find existing bill: %w", err)
+	}
+
+	// Merge field by field so a lower-priority source can't overwrite
+	// values supplied by a more authoritative one.
+	m := merge.Bill(existing, b, merge.DefaultBillRules, now)
+
+	args := []any{
+		m.BillNumber, m.BillType, m.SessionYear, m.Title, nullIfEmpty(m.Description), m.Status,
+		nullIfEmpty(m.SponsorID), nullIfEmpty(m.FullTextURL), nullIfEmpty(m.LastAction), m.LastActionDate,
+		nullIfEmpty(m.FiscalNoteURL), m.EffectiveDate, nullIfEmpty(m.UtahLegislatureID), nullIfZero(m.LegiscanID),
+		provenanceToJSON(m.Provenance), nullIfEmpty(m.SponsorUtahID),
+	}
+	if existing == nil {
+		_, err = tx.Exec(ctx,
+			`INSERT INTO utah_bills (
+				bill_number, bill_type, session_year, title, description, status,
+				sponsor_id, full_text_url, last_action, last_action_date,
+				fiscal_note_url, effective_date, utah_legislature_id, legiscan_id,
+				provenance, sponsor_utah_id
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`,
+			args...,
+		)
+	} else {
+		_, err = tx.Exec(ctx,
+			`UPDATE utah_bills SET
+				bill_number = $1, bill_type = $2, session_year = $3, title = $4, description = $5, status = $6,
+				sponsor_id = $7, full_text_url = $8, last_action = $9, last_action_date = $10,
+				fiscal_note_url = $11, effective_date = $12, utah_legislature_id = $13, legiscan_id = $14,
+				provenance = $15, sponsor_utah_id = $16
+			WHERE id = $17`,
+			append(args, existing.ID)...,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("upsert bill %s: %w", b.BillNumber, err)
+	}
+	return nil
+}
+
+// LinkSponsors links bills with no sponsor to the legislator with their
+// SponsorUtahID.
+func (r *BillRepository) LinkSponsors(ctx context.Context) (int, error) {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE utah_bills b SET sponsor_id = l.id
+		FROM utah_legislators l
+		WHERE b.sponsor_id IS NULL AND b.sponsor_utah_id = l.utah_legislature_id`,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("link sponsors: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// UnresolvedSponsors returns the sponsor IDs of bills with no sponsor linked.
+func (r *BillRepository) UnresolvedSponsors(ctx context.Context) ([]domain.UnresolvedSponsor, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT sponsor_utah_id, COUNT(*)
+		FROM utah_bills
+		WHERE sponsor_id IS NULL AND sponsor_utah_id <> ''
+		GROUP BY sponsor_utah_id
+		ORDER BY COUNT(*) DESC, sponsor_utah_id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unresolved sponsors: %w", err)
+	}
+	defer rows.Close()
+
+	out := []domain.UnresolvedSponsor{}
+	for rows.Next() {
+		var u domain.UnresolvedSponsor
+		if err := rows.Scan(&u.SponsorUtahID, &u.Bills); err != nil {
+			return nil, fmt.Errorf("unresolved sponsors: %w", err)
+		}
+		out = append(out, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("unresolved sponsors: %w", err)
+	}
+	return out, nil
+}
+
+// findBill returns the first bill matching where, or nil if there is none.
+func findBill(ctx context.Context, q querier, where string, args ...any) (*domain.Bill, error) {
+	b, err := scanBill(q.QueryRow(ctx, billSelect+" WHERE "+where, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// scanBill scans a billSelect row into a domain.Bill.
+func scanBill(row pgx.Row) (domain.Bill, error) {
+	var b domain.Bill
+	var provenance map[string]fieldSourceJSON
+	var sponsor legislatorScan
+	dest := append([]any{
+		&b.ID, &b.BillNumber, &b.BillType, &b.SessionYear, &b.Title,
+		&b.Description, &b.Status, &b.SponsorID, &b.SponsorUtahID,
+		&b.FullTextURL, &b.LastAction, &b.LastActionDate,
+		&b.FiscalNoteURL, &b.EffectiveDate,
+		&b.UtahLegislatureID, &b.LegiscanID,
+		&provenance,
+	}, sponsor.dest()...)
+	if err := row.Scan(dest...); err != nil {
+		return domain.Bill{}, err
+	}
+
+	b.Provenance = provenanceFromJSON(provenance)
+	if sponsor.l.ID != "" {
+		l := sponsor.legislator()
+		b.Sponsor = &l
+	}
+	return b, nil
+}
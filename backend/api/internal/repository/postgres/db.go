@@ -0,0 +1,90 @@
+// Package postgres implements the repository interfaces on the Supabase
+// Postgres schema (utah_legislators, utah_legislator_terms, utah_bills, ...),
+// so the API and the Flutter app read the same database. The schema is owned
+// by backend/supabase/migrations; this package never creates tables.
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"api/internal/domain"
+)
+
+// Connect opens a connection pool for databaseURL and checks that the
+// database is reachable.
+func Connect(ctx context.Context, databaseURL string) (*pgxpool.Pool, error) {
+	pool, err := pgxpool.New(ctx, databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("open postgres pool: %w", err)
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("ping postgres: %w", err)
+	}
+	return pool, nil
+}
+
+// querier is satisfied by both *pgxpool.Pool and pgx.Tx, so helpers can run
+// inside or outside a transaction.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// validUUID reports whether s parses as a UUID. IDs from requests are
+// checked first so a malformed ID reads as "not found", as it does in the
+// PocketBase implementation, rather than as a database error.
+func validUUID(s string) bool {
+	var u pgtype.UUID
+	return u.Scan(s) == nil
+}
+
+// nullIfEmpty maps "" to SQL NULL for optional text and UUID columns.
+func nullIfEmpty(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// nullIfZero maps 0 to SQL NULL for optional integer columns.
+func nullIfZero(n int) any {
+	if n == 0 {
+		return nil
+	}
+	return n
+}
+
+// fieldSourceJSON is the stored shape of a domain.FieldSource in the
+// provenance JSONB columns.
+type fieldSourceJSON struct {
+	Source    string    `json:"source"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func provenanceToJSON(p domain.Provenance) map[string]fieldSourceJSON {
+	out := make(map[string]fieldSourceJSON, len(p))
+	for k, v := range p {
+		out[k] = fieldSourceJSON(v)
+	}
+	return out
+}
+
+func provenanceFromJSON(stored map[string]fieldSourceJSON) domain.Provenance {
+	if len(stored) == 0 {
+		return nil
+	}
+	out := make(domain.Provenance, len(stored))
+	for k, v := range stored {
+		out[k] = domain.FieldSource(v)
+	}
+	return out
+}
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"api/internal/domain"
+)
+
+// DeadLetterRepository is the Postgres implementation of repository.DeadLetterRepository.
+type DeadLetterRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewDeadLetterRepository creates a new Postgres-backed DeadLetterRepository.
+func NewDeadLetterRepository(db *pgxpool.Pool) *DeadLetterRepository {
+	return &DeadLetterRepository{db: db}
+}
+
+// RecordDeadLetter stores a failure, incrementing the attempt count of a
+// record already dead-lettered.
+func (r *DeadLetterRepository) RecordDeadLetter(ctx context.Context, dl domain.DeadLetter) error {
+	var payload any
+	if len(dl.Payload) > 0 {
+		payload = string(dl.Payload)
+	}
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO dead_letters (kind, source, key, payload, error)
+		VALUES ($1, $2, $3, $4::jsonb, $5)
+		ON CONFLICT (kind, key) DO UPDATE SET
+			source = EXCLUDED.source, payload = EXCLUDED.payload, error = EXCLUDED.error,
+			attempts = dead_letters.attempts + 1, last_failed_at = NOW()`,
+		dl.Kind, nullIfEmpty(dl.Source), dl.Key, payload, nullIfEmpty(dl.Error),
+	)
+	if err != nil {
+		return fmt.Errorf("record dead letter %s %s: %w", dl.Kind, dl.Key, err)
+	}
+	return nil
+}
+
+// ListDeadLetters returns dead letters, oldest first.
+func (r *DeadLetterRepository) ListDeadLetters(ctx context.Context, kind string) ([]domain.DeadLetter, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT id::text, kind, COALESCE(source, ''), key, COALESCE(payload::text, ''),
+			COALESCE(error, ''), attempts, first_failed_at, last_failed_at
+		FROM dead_letters
+		WHERE $1 = '' OR kind = $1
+		ORDER BY first_failed_at, id`,
+		kind,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list dead letters: %w", err)
+	}
+	defer rows.Close()
+
+	letters := []domain.DeadLetter{}
+	for rows.Next() {
+		var dl domain.DeadLetter
+		var payload string
+		err := rows.Scan(&dl.ID, &dl.Kind, &dl.Source, &dl.Key, &payload,
+			&dl.Error, &dl.Attempts, &dl.FirstFailedAt, &dl.LastFailedAt)
+		if err != nil {
+			return nil, fmt.Errorf("list dead letters: %w", err)
+		}
+		if payload != "" {
+			dl.Payload = []byte(payload)
+		}
+		letters = append(letters, dl)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list dead letters: %w", err)
+	}
+	return letters, nil
+}
+
+// ResolveDeadLetter removes a dead letter.
+func (r *DeadLetterRepository) ResolveDeadLetter(ctx context.Context, kind, key string) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM dead_letters WHERE kind = $1 AND key = $2`, kind, key); err != nil {
+		return fmt.Errorf("resolve dead letter %s %s: %w", kind, key, err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"api/internal/domain"
+)
+
+// JobRunRepository is the Postgres implementation of repository.JobRunRepository.
+type JobRunRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewJobRunRepository creates a new Postgres-backed JobRunRepository.
+func NewJobRunRepository(db *pgxpool.Pool) *JobRunRepository {
+	return &JobRunRepository{db: db}
+}
+
+// SaveJobRun inserts or updates a run.
+func (r *JobRunRepository) SaveJobRun(ctx context.Context, run *domain.JobRun) error {
+	args := []any{
+		run.Job, run.Trigger, run.Status, run.StartedAt, run.FinishedAt,
+		run.Upserted, run.Failed, nullIfEmpty(run.Error),
+	}
+	if run.ID == "" {
+		err := r.db.QueryRow(ctx,
+			`INSERT INTO job_runs (job, trigger, status, started_at, finished_at, upserted, failed, error)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			RETURNING id::text`,
+			args...,
+		).Scan(&run.ID)
+		if err != nil {
+			return fmt.Errorf("save job run: %w", err)
+		}
+		return nil
+	}
+
+	if !validUUID(run.ID) {
+		return fmt.Errorf("save job run: %s not found", run.ID)
+	}
+	tag, err := r.db.Exec(ctx,
+		`UPDATE job_runs SET
+			job = $1, trigger = $2, status = $3, started_at = $4, finished_at = $5,
+			upserted = $6, failed = $7, error = $8
+		WHERE id = $9`,
+		append(args, run.ID)...,
+	)
+	if err != nil {
+		return fmt.Errorf("save job run: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("save job run: %s not found", run.ID)
+	}
+	return nil
+}
+
+// ListJobRuns returns the most recent runs, newest first.
+func (r *JobRunRepository) ListJobRuns(ctx context.Context, job string, limit int) ([]domain.JobRun, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT id::text, job, trigger, status, started_at, finished_at, upserted, failed, COALESCE(error, '')
+		FROM job_runs
+		WHERE $1 = '' OR job = $1
+		ORDER BY started_at DESC
+		LIMIT $2`,
+		job, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list job runs: %w", err)
+	}
+	defer rows.Close()
+
+	runs := []domain.JobRun{}
+	for rows.Next() {
+		var run domain.JobRun
+		err := rows.Scan(&run.ID, &run.Job, &run.Trigger, &run.Status, &run.StartedAt,
+			&run.FinishedAt, &run.Upserted, &run.Failed, &run.Error)
+		if err != nil {
+			return nil, fmt.Errorf("list job runs: %w", err)
+		}
+		runs = append(runs, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list job runs: %w", err)
+	}
+	return runs, nil
+}
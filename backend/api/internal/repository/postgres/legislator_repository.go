@@ -0,0 +1,409 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"api/internal/domain"
+	"api/internal/merge"
+	"api/internal/repository"
+)
+
+// LegislatorRepository is the Postgres implementation of repository.LegislatorRepository.
+type LegislatorRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewLegislatorRepository creates a new Postgres-backed LegislatorRepository.
+func NewLegislatorRepository(db *pgxpool.Pool) *LegislatorRepository {
+	return &LegislatorRepository{db: db}
+}
+
+// legislatorColumns selects a legislator from the utah_legislators row
+// aliased as alias. Every column is coalesced so the same scan works for the
+// nullable side of a LEFT JOIN.
+func legislatorColumns(alias string) string {
+	cols := []string{
+		"COALESCE(%[1]s.id::text, '')",
+		"COALESCE(%[1]s.chamber, '')",
+		"COALESCE(%[1]s.district_number, 0)",
+		"COALESCE(%[1]s.first_name, '')",
+		"COALESCE(%[1]s.last_name, '')",
+		"COALESCE(%[1]s.party, '')",
+		"COALESCE(%[1]s.email, '')",
+		"COALESCE(%[1]s.phone, '')",
+		"COALESCE(%[1]s.website, '')",
+		"COALESCE(%[1]s.image_url, '')",
+		"COALESCE(%[1]s.offices, '[]'::jsonb)",
+		"COALESCE(%[1]s.twitter, '')",
+		"COALESCE(%[1]s.facebook, '')",
+		"COALESCE(%[1]s.instagram, '')",
+		"COALESCE(%[1]s.youtube, '')",
+		"COALESCE(%[1]s.utah_legislature_id, '')",
+		"COALESCE(%[1]s.legiscan_id, 0)",
+		"COALESCE(%[1]s.openstates_id, '')",
+		"COALESCE(%[1]s.provenance, '{}'::jsonb)",
+	}
+	return fmt.Sprintf(strings.Join(cols, ", "), alias)
+}
+
+// legislatorScan holds scan targets for legislatorColumns.
+type legislatorScan struct {
+	l          domain.Legislator
+	offices    []officeJSON
+	provenance map[string]fieldSourceJSON
+}
+
+func (s *legislatorScan) dest() []any {
+	return []any{
+		&s.l.ID, &s.l.Chamber, &s.l.DistrictNumber, &s.l.FirstName, &s.l.LastName,
+		&s.l.Party, &s.l.Email, &s.l.Phone, &s.l.Website, &s.l.ImageURL,
+		&s.offices, &s.l.Twitter, &s.l.Facebook, &s.l.Instagram, &s.l.YouTube,
+		&s.l.UtahLegislatureID, &s.l.LegiscanID, &s.l.OpenStatesID, &s.provenance,
+	}
+}
+
+func (s *legislatorScan) legislator() domain.Legislator {
+	l := s.l
+	for _, o := range s.offices {
+		l.Offices = append(l.Offices, domain.Office(o))
+	}
+	l.Provenance = provenanceFromJSON(s.provenance)
+	return l
+}
+
+// officeJSON is the stored shape of a domain.Office in the offices column.
+type officeJSON struct {
+	Classification string `json:"classification"`
+	Address        string `json:"address,omitempty"`
+	Voice          string `json:"voice,omitempty"`
+	Fax            string `json:"fax,omitempty"`
+}
+
+func officesToJSON(offices []domain.Office) []officeJSON {
+	out := make([]officeJSON, 0, len(offices))
+	for _, o := range offices {
+		out = append(out, officeJSON(o))
+	}
+	return out
+}
+
+// ListLegislators returns legislators matching the filters. By default only
+// current members are returned; see repository.LegislatorFilters.
+//
+// Membership is decided by terms: each legislator is returned once, with the
+// seat and dates of their best matching term (open first, then most recent).
+func (r *LegislatorRepository) ListLegislators(ctx context.Context, f repository.LegislatorFilters) ([]domain.Legislator, error) {
+	where := []string{}
+	args := []any{}
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if f.Chamber != "" {
+		where = append(where, "t.chamber = "+arg(f.Chamber))
+	}
+	switch {
+	case f.AsOf != nil:
+		p := arg(f.AsOf.UTC())
+		where = append(where, fmt.Sprintf(
+			"t.start_date <= %[1]s::date AND (t.end_date IS NULL OR t.end_date > %[1]s::date)", p))
+	case !f.IncludeFormer:
+		where = append(where, "t.end_date IS NULL")
+	}
+
+	// People with no terms are listed only when nothing filters on a seat.
+	cond := "t.id IS NOT NULL"
+	if len(where) > 0 {
+		cond += " AND " + strings.Join(where, " AND ")
+	}
+	if f.AsOf == nil && f.Chamber == "" {
+		cond = "t.id IS NULL OR (" + cond + ")"
+	}
+
+	query := `SELECT DISTINCT ON (l.id) ` + legislatorColumns("l") + `,
+		t.chamber, t.district_number, t.start_date, t.end_date
+		FROM utah_legislators l
+		LEFT JOIN utah_legislator_terms t ON t.legislator_id = l.id
+		WHERE ` + cond
+	query += " ORDER BY l.id, t.end_date DESC NULLS FIRST, t.start_date DESC NULLS LAST"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list legislators: %w", err)
+	}
+	defer rows.Close()
+
+	var legislators []domain.Legislator
+	for rows.Next() {
+		var s legislatorScan
+		var t domain.Term
+		var chamber *string
+		var district *int
+		var start *time.Time
+		if err := rows.Scan(append(s.dest(), &chamber, &district, &start, &t.End)...); err != nil {
+			return nil, fmt.Errorf("list legislators: %w", err)
+		}
+		l := s.legislator()
+		if chamber != nil {
+			t.Chamber, t.DistrictNumber = *chamber, *district
+			if start != nil {
+				t.Start = *start
+			}
+			applyTerm(&l, t)
+		}
+		legislators = append(legislators, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list legislators: %w", err)
+	}
+
+	sort.Slice(legislators, func(i, j int) bool {
+		a, b := legislators[i], legislators[j]
+		if a.Chamber != b.Chamber {
+			return a.Chamber < b.Chamber
+		}
+		if a.DistrictNumber != b.DistrictNumber {
+			return a.DistrictNumber < b.DistrictNumber
+		}
+		return a.LastName < b.LastName
+	})
+	return legislators, nil
+}
+
+// GetLegislator returns a single legislator by its ID, with every term they
+// have served in Terms.
+func (r *LegislatorRepository) GetLegislator(ctx context.Context, id string) (*domain.Legislator, error) {
+	if !validUUID(id) {
+		return nil, nil
+	}
+	l, err := findLegislator(ctx, r.db, "l.id = $1", id)
+	if err != nil {
+		return nil, fmt.Errorf("get legislator: %w", err)
+	}
+	if l == nil {
+		return nil, nil
+	}
+
+	l.Terms, err = r.ListTerms(ctx, l.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get legislator: %w", err)
+	}
+	if len(l.Terms) > 0 {
+		latest := l.Terms[0]
+		for _, t := range l.Terms[1:] {
+			if laterTerm(t, latest) {
+				latest = t
+			}
+		}
+		// Keep the stored seat; the latest term is the same seat for current
+		// members and the last one served for former members.
+		l.TermStart, l.TermEnd, l.Current = termDates(latest)
+	}
+	return l, nil
+}
+
+// GetLegislatorByDistrict returns the legislator currently holding the seat
+// for a given chamber and district number.
+func (r *LegislatorRepository) GetLegislatorByDistrict(ctx context.Context, chamber string, districtNumber int) (*domain.Legislator, error) {
+	l, err := currentSeatHolder(ctx, r.db, chamber, districtNumber)
+	if err != nil {
+		return nil, fmt.Errorf("get legislator by district: %w", err)
+	}
+	return l, nil
+}
+
+// ListTerms returns every seat a legislator has held, oldest first.
+func (r *LegislatorRepository) ListTerms(ctx context.Context, legislatorID string) ([]domain.Term, error) {
+	if !validUUID(legislatorID) {
+		return nil, nil
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT id::text, legislator_id::text, chamber, district_number, start_date, end_date
+		FROM utah_legislator_terms
+		WHERE legislator_id = $1
+		ORDER BY start_date NULLS FIRST`,
+		legislatorID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list terms: %w", err)
+	}
+	defer rows.Close()
+
+	var terms []domain.Term
+	for rows.Next() {
+		t, err := scanTerm(rows)
+		if err != nil {
+			return nil, fmt.Errorf("list terms: %w", err)
+		}
+		terms = append(terms, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list terms: %w", err)
+	}
+	return terms, nil
+}
+
+// UpsertLegislator inserts or updates a person, then records their current
+// seat as a Term. Identity resolution and merging follow the PocketBase
+// implementation: upstream IDs first, then the current seat holder, with
+// fields merged according to merge.DefaultLegislatorRules.
+func (r *LegislatorRepository) UpsertLegislator(ctx context.Context, l domain.Legislator) error {
+	return r.UpsertLegislators(ctx, []domain.Legislator{l})
+}
+
+// UpsertLegislators upserts a batch of legislators in a single transaction:
+// either every legislator is written or none is.
+func (r *LegislatorRepository) UpsertLegislators(ctx context.Context, legislators []domain.Legislator) error {
+	if len(legislators) == 0 {
+		return nil
+	}
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		now := time.Now().UTC()
+		for _, l := range legislators {
+			if err := upsertLegislator(ctx, tx, l, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RetireLegislators ends the open terms of every legislator with a Utah
+// Legislature ID missing from keep. Legislators without a Utah ID are left
+// alone. An empty keep list is treated as a bad feed and retires no one.
+func (r *LegislatorRepository) RetireLegislators(ctx context.Context, keep []string) (int, error) {
+	if len(keep) == 0 {
+		return 0, nil
+	}
+	tag, err := r.db.Exec(ctx,
+		`UPDATE utah_legislator_terms t
+		SET end_date = $1
+		FROM utah_legislators l
+		WHERE l.id = t.legislator_id
+			AND t.end_date IS NULL
+			AND COALESCE(l.utah_legislature_id, '') <> ''
+			AND NOT (l.utah_legislature_id = ANY($2))`,
+		time.Now().UTC(), keep,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("retire legislators: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// upsertLegislator does the work of UpsertLegislators for one person inside
+// a transaction.
+func upsertLegislator(ctx context.Context, tx pgx.Tx, l domain.Legislator, now time.Time) error {
+	existing, err := resolveLegislator(ctx, tx, l)
+	if err != nil {
+		return fmt.Errorf("resolve legislator %s %s: %w", l.FirstName, l.LastName, err)
+	}
+
+	// Merge field by field so a lower-priority source can't overwrite
+	// values supplied by a more authoritative one.
+	m := merge.Legislator(existing, l, merge.DefaultLegislatorRules, now)
+
+	args := []any{
+		m.Chamber, m.DistrictNumber, m.FirstName, m.LastName,
+		nullIfEmpty(m.Party), nullIfEmpty(m.Email), nullIfEmpty(m.Phone), nullIfEmpty(m.Website), nullIfEmpty(m.ImageURL),
+		officesToJSON(m.Offices),
+		nullIfEmpty(m.Twitter), nullIfEmpty(m.Facebook), nullIfEmpty(m.Instagram), nullIfEmpty(m.YouTube),
+		nullIfEmpty(m.UtahLegislatureID), nullIfZero(m.LegiscanID), nullIfEmpty(m.OpenStatesID),
+		provenanceToJSON(m.Provenance),
+	}
+
+	id := ""
+	if existing == nil {
+		err = tx.QueryRow(ctx,
+			`INSERT INTO utah_legislators (
+				chamber, district_number, first_name, last_name,
+				party, email, phone, website, image_url,
+				offices, twitter, facebook, instagram, youtube,
+				utah_legislature_id, legiscan_id, openstates_id, provenance
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+			RETURNING id::text`,
+			args...,
+		).Scan(&id)
+	} else {
+		id = existing.ID
+		_, err = tx.Exec(ctx,
+			`UPDATE utah_legislators SET
+				chamber = $1, district_number = $2, first_name = $3, last_name = $4,
+				party = $5, email = $6, phone = $7, website = $8, image_url = $9,
+				offices = $10, twitter = $11, facebook = $12, instagram = $13, youtube = $14,
+				utah_legislature_id = $15, legiscan_id = $16, openstates_id = $17, provenance = $18
+			WHERE id = $19`,
+			append(args, id)...,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("upsert legislator %s %s: %w", l.FirstName, l.LastName, err)
+	}
+
+	// The seat comes from the merged record so a stale secondary source
+	// can't flip a term the authoritative source already moved.
+	if err := syncCurrentTerm(ctx, tx, id, m.Chamber, m.DistrictNumber, l.TermStart, now); err != nil {
+		return fmt.Errorf("sync term for %s %s: %w", l.FirstName, l.LastName, err)
+	}
+	return nil
+}
+
+// resolveLegislator finds the stored person an incoming record refers to, or
+// nil if it is someone new. See the PocketBase implementation for the rules.
+func resolveLegislator(ctx context.Context, q querier, l domain.Legislator) (*domain.Legislator, error) {
+	ids := []struct {
+		column string
+		value  any
+		set    bool
+	}{
+		{"utah_legislature_id", l.UtahLegislatureID, l.UtahLegislatureID != ""},
+		{"openstates_id", l.OpenStatesID, l.OpenStatesID != ""},
+		{"legiscan_id", l.LegiscanID, l.LegiscanID != 0},
+	}
+	for _, id := range ids {
+		if !id.set {
+			continue
+		}
+		found, err := findLegislator(ctx, q, "l."+id.column+" = $1 FOR UPDATE", id.value)
+		if err != nil || found != nil {
+			return found, err
+		}
+	}
+
+	holder, err := currentSeatHolder(ctx, q, l.Chamber, l.DistrictNumber)
+	if err != nil || holder == nil {
+		return nil, err
+	}
+	if merge.ConflictingIDs(*holder, l) {
+		return nil, nil
+	}
+	return holder, nil
+}
+
+// findLegislator returns the first legislator matching where (with a single
+// $1 argument), or nil if there is none.
+func findLegislator(ctx context.Context, q querier, where string, arg any) (*domain.Legislator, error) {
+	var s legislatorScan
+	err := q.QueryRow(ctx,
+		"SELECT "+legislatorColumns("l")+" FROM utah_legislators l WHERE "+where,
+		arg,
+	).Scan(s.dest()...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	l := s.legislator()
+	return &l, nil
+}
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"api/internal/domain"
+)
+
+// currentSeatHolder returns the legislator holding an open term for the
+// given seat, or nil if the seat has no current holder on record.
+func currentSeatHolder(ctx context.Context, q querier, chamber string, districtNumber int) (*domain.Legislator, error) {
+	if chamber == "" || districtNumber == 0 {
+		return nil, nil
+	}
+
+	var s legislatorScan
+	err := q.QueryRow(ctx,
+		`SELECT `+legislatorColumns("l")+`
+		FROM utah_legislator_terms t
+		JOIN utah_legislators l ON l.id = t.legislator_id
+		WHERE t.chamber = $1 AND t.district_number = $2 AND t.end_date IS NULL
+		LIMIT 1`,
+		chamber, districtNumber,
+	).Scan(s.dest()...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find current term: %w", err)
+	}
+	l := s.legislator()
+	return &l, nil
+}
+
+// syncCurrentTerm makes (chamber, districtNumber) the one open term for
+// legislatorID, closing any other open term the person holds and any open
+// term someone else holds on the same seat. startHint only dates a person's
+// first term; later terms start now. If that first term is already open on
+// this seat, as a backfilled one is, a startHint before its start moves the
+// start back.
+func syncCurrentTerm(ctx context.Context, tx pgx.Tx, legislatorID, chamber string, districtNumber int, startHint *time.Time, now time.Time) error {
+	if chamber == "" || districtNumber == 0 {
+		return nil
+	}
+
+	// Close every other open term touching this person or this seat.
+	_, err := tx.Exec(ctx,
+		`UPDATE utah_legislator_terms
+		SET end_date = $4
+		WHERE end_date IS NULL
+			AND (legislator_id = $1 OR (chamber = $2 AND district_number = $3))
+			AND NOT (legislator_id = $1 AND chamber = $2 AND district_number = $3)`,
+		legislatorID, chamber, districtNumber, now,
+	)
+	if err != nil {
+		return fmt.Errorf("close terms: %w", err)
+	}
+
+	var held bool
+	var previous int
+	err = tx.QueryRow(ctx,
+		`SELECT
+			COUNT(*) FILTER (WHERE chamber = $2 AND district_number = $3 AND end_date IS NULL) > 0,
+			COUNT(*)
+		FROM utah_legislator_terms
+		WHERE legislator_id = $1`,
+		legislatorID, chamber, districtNumber,
+	).Scan(&held, &previous)
+	if err != nil {
+		return fmt.Errorf("count terms: %w", err)
+	}
+	if held {
+		if startHint == nil || previous != 1 {
+			return nil
+		}
+		_, err = tx.Exec(ctx,
+			`UPDATE utah_legislator_terms
+			SET start_date = $2
+			WHERE legislator_id = $1 AND end_date IS NULL
+				AND (start_date IS NULL OR start_date > $2::date)`,
+			legislatorID, *startHint,
+		)
+		if err != nil {
+			return fmt.Errorf("date term: %w", err)
+		}
+		return nil
+	}
+
+	start := now
+	if startHint != nil && previous == 0 {
+		start = *startHint
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO utah_legislator_terms (legislator_id, chamber, district_number, start_date)
+		VALUES ($1, $2, $3, $4)`,
+		legislatorID, chamber, districtNumber, start,
+	)
+	if err != nil {
+		return fmt.Errorf("open term: %w", err)
+	}
+	return nil
+}
+
+// scanTerm scans the columns id, legislator_id, chamber, district_number,
+// start_date, end_date into a domain.Term.
+func scanTerm(row pgx.Row) (domain.Term, error) {
+	var t domain.Term
+	var start *time.Time
+	if err := row.Scan(&t.ID, &t.LegislatorID, &t.Chamber, &t.DistrictNumber, &start, &t.End); err != nil {
+		return domain.Term{}, err
+	}
+	if start != nil {
+		t.Start = *start
+	}
+	return t, nil
+}
+
+// laterTerm reports whether a should represent a person over b: an open
+// term beats a closed one, then the later end, then the later start.
+func laterTerm(a, b domain.Term) bool {
+	switch {
+	case a.End == nil && b.End != nil:
+		return true
+	case a.End != nil && b.End == nil:
+		return false
+	case a.End != nil && !a.End.Equal(*b.End):
+		return a.End.After(*b.End)
+	default:
+		return a.Start.After(b.Start)
+	}
+}
+
+// applyTerm sets a legislator's seat and term dates from t.
+func applyTerm(l *domain.Legislator, t domain.Term) {
+	l.Chamber = t.Chamber
+	l.DistrictNumber = t.DistrictNumber
+	l.TermStart, l.TermEnd, l.Current = termDates(t)
+}
+
+// termDates returns a term's start (nil if unknown), end, and whether it is open.
+func termDates(t domain.Term) (start, end *time.Time, current bool) {
+	if !t.Start.IsZero() {
+		s := t.Start
+		start = &s
+	}
+	return start, t.End, t.End == nil
+}
@@ -0,0 +1,279 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"api/internal/domain"
+)
+
+// NotificationRepository is the Postgres implementation of repository.NotificationRepository.
+// Settings are stored on the app's existing settings table.
+type NotificationRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewNotificationRepository creates a new Postgres-backed NotificationRepository.
+func NewNotificationRepository(db *pgxpool.Pool) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+// SaveSubscription stores a new subscription.
+func (r *NotificationRepository) SaveSubscription(ctx context.Context, s domain.NotificationSubscription) (domain.NotificationSubscription, error) {
+	if !validUUID(s.UserID) {
+		return domain.NotificationSubscription{}, fmt.Errorf("save subscription: invalid user id %q", s.UserID)
+	}
+	err := r.db.QueryRow(ctx,
+		`INSERT INTO notification_subscriptions (user_id, event_types, bill_id, sponsor_id, followed, channels)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id::text, created_at`,
+		s.UserID, nonNil(s.EventTypes), nullIfEmpty(s.BillID), nullIfEmpty(s.SponsorID), s.Followed, nonNil(s.Channels),
+	).Scan(&s.ID, &s.CreatedAt)
+	if err != nil {
+		return domain.NotificationSubscription{}, fmt.Errorf("save subscription: %w", err)
+	}
+	return s, nil
+}
+
+// DeleteSubscription removes one of a user's subscriptions.
+func (r *NotificationRepository) DeleteSubscription(ctx context.Context, userID, id string) error {
+	if !validUUID(userID) || !validUUID(id) {
+		return nil
+	}
+	_, err := r.db.Exec(ctx,
+		`DELETE FROM notification_subscriptions WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("delete subscription %s: %w", id, err)
+	}
+	return nil
+}
+
+// ListSubscriptions returns a user's subscriptions, or everyone's.
+func (r *NotificationRepository) ListSubscriptions(ctx context.Context, userID string) ([]domain.NotificationSubscription, error) {
+	subs := []domain.NotificationSubscription{}
+	if userID != "" && !validUUID(userID) {
+		return subs, nil
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT id::text, user_id::text, event_types, COALESCE(bill_id, ''), COALESCE(sponsor_id, ''),
+			followed, channels, created_at
+		FROM notification_subscriptions
+		WHERE $1 = '' OR user_id = NULLIF($1, '')::uuid
+		ORDER BY created_at, id`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var s domain.NotificationSubscription
+		err := rows.Scan(&s.ID, &s.UserID, &s.EventTypes, &s.BillID, &s.SponsorID, &s.Followed, &s.Channels, &s.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("list subscriptions: %w", err)
+		}
+		subs = append(subs, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w", err)
+	}
+	return subs, nil
+}
+
+// GetNotificationSettings returns a user's settings, or the defaults.
+func (r *NotificationRepository) GetNotificationSettings(ctx context.Context, userID string) (domain.NotificationSettings, error) {
+	if !validUUID(userID) {
+		return domain.DefaultNotificationSettings(userID), nil
+	}
+	s := domain.NotificationSettings{UserID: userID}
+	var digestMinutes int
+	err := r.db.QueryRow(ctx,
+		`SELECT COALESCE(notification_enabled, true), COALESCE(notification_email, ''), COALESCE(timezone, ''),
+			quiet_hours_start, quiet_hours_end, digest_interval_minutes, last_digest_at
+		FROM settings WHERE user_id = $1`,
+		userID,
+	).Scan(&s.Enabled, &s.Email, &s.TimeZone, &s.QuietStart, &s.QuietEnd, &digestMinutes, &s.LastDigestAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.DefaultNotificationSettings(userID), nil
+	}
+	if err != nil {
+		return domain.NotificationSettings{}, fmt.Errorf("get notification settings: %w", err)
+	}
+	s.DigestInterval = time.Duration(digestMinutes) * time.Minute
+	return s, nil
+}
+
+// SaveNotificationSettings replaces a user's notification settings, leaving
+// the app's other settings alone.
+func (r *NotificationRepository) SaveNotificationSettings(ctx context.Context, s domain.NotificationSettings) error {
+	if !validUUID(s.UserID) {
+		return fmt.Errorf("save notification settings: invalid user id %q", s.UserID)
+	}
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO settings (
+			user_id, notification_enabled, notification_email, timezone,
+			quiet_hours_start, quiet_hours_end, digest_interval_minutes, last_digest_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (user_id) DO UPDATE SET
+			notification_enabled = EXCLUDED.notification_enabled,
+			notification_email = EXCLUDED.notification_email,
+			timezone = EXCLUDED.timezone,
+			quiet_hours_start = EXCLUDED.quiet_hours_start,
+			quiet_hours_end = EXCLUDED.quiet_hours_end,
+			digest_interval_minutes = EXCLUDED.digest_interval_minutes,
+			last_digest_at = EXCLUDED.last_digest_at`,
+		s.UserID, s.Enabled, nullIfEmpty(s.Email), nullIfEmpty(s.TimeZone),
+		s.QuietStart, s.QuietEnd, int(s.DigestInterval/time.Minute), s.LastDigestAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save notification settings: %w", err)
+	}
+	return nil
+}
+
+// SaveDevice registers a push destination.
+func (r *NotificationRepository) SaveDevice(ctx context.Context, d domain.Device) (domain.Device, error) {
+	if !validUUID(d.UserID) {
+		return domain.Device{}, fmt.Errorf("save device: invalid user id %q", d.UserID)
+	}
+	err := r.db.QueryRow(ctx,
+		`INSERT INTO notification_devices (user_id, channel, token, p256dh, auth)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (channel, token) DO UPDATE SET
+			user_id = EXCLUDED.user_id, p256dh = EXCLUDED.p256dh, auth = EXCLUDED.auth
+		RETURNING id::text, created_at`,
+		d.UserID, d.Channel, d.Token, nullIfEmpty(d.P256dh), nullIfEmpty(d.Auth),
+	).Scan(&d.ID, &d.CreatedAt)
+	if err != nil {
+		return domain.Device{}, fmt.Errorf("save device: %w", err)
+	}
+	return d, nil
+}
+
+// DeleteDevice removes a user's device.
+func (r *NotificationRepository) DeleteDevice(ctx context.Context, userID, channel, token string) error {
+	if !validUUID(userID) {
+		return nil
+	}
+	_, err := r.db.Exec(ctx,
+		`DELETE FROM notification_devices WHERE user_id = $1 AND channel = $2 AND token = $3`,
+		userID, channel, token)
+	if err != nil {
+		return fmt.Errorf("delete device: %w", err)
+	}
+	return nil
+}
+
+// ListDevices returns a user's devices.
+func (r *NotificationRepository) ListDevices(ctx context.Context, userID string) ([]domain.Device, error) {
+	devices := []domain.Device{}
+	if !validUUID(userID) {
+		return devices, nil
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT id::text, user_id::text, channel, token, COALESCE(p256dh, ''), COALESCE(auth, ''), created_at
+		FROM notification_devices
+		WHERE user_id = $1
+		ORDER BY created_at, id`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list devices: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var d domain.Device
+		if err := rows.Scan(&d.ID, &d.UserID, &d.Channel, &d.Token, &d.P256dh, &d.Auth, &d.CreatedAt); err != nil {
+			return nil, fmt.Errorf("list devices: %w", err)
+		}
+		devices = append(devices, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list devices: %w", err)
+	}
+	return devices, nil
+}
+
+// EnqueueNotifications adds notifications to the outbox in one transaction.
+// created_at defaults to clock_timestamp(), so they keep their order.
+func (r *NotificationRepository) EnqueueNotifications(ctx context.Context, ns []domain.Notification) error {
+	if len(ns) == 0 {
+		return nil
+	}
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		for _, n := range ns {
+			_, err := tx.Exec(ctx,
+				`INSERT INTO notifications (user_id, event_type, bill_id, title, body, channels)
+				VALUES ($1, $2, $3, $4, $5, $6)`,
+				n.UserID, n.EventType, nullIfEmpty(n.BillID), n.Title, n.Body, nonNil(n.Channels),
+			)
+			if err != nil {
+				return fmt.Errorf("enqueue notification: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// PendingNotifications returns the notifications not yet sent, oldest first.
+func (r *NotificationRepository) PendingNotifications(ctx context.Context) ([]domain.Notification, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT id::text, user_id::text, event_type, COALESCE(bill_id, ''), title, body, channels, created_at
+		FROM notifications
+		WHERE sent_at IS NULL
+		ORDER BY created_at, id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("pending notifications: %w", err)
+	}
+	defer rows.Close()
+
+	out := []domain.Notification{}
+	for rows.Next() {
+		var n domain.Notification
+		err := rows.Scan(&n.ID, &n.UserID, &n.EventType, &n.BillID, &n.Title, &n.Body, &n.Channels, &n.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("pending notifications: %w", err)
+		}
+		out = append(out, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("pending notifications: %w", err)
+	}
+	return out, nil
+}
+
+// MarkNotificationsSent records when notifications were delivered.
+func (r *NotificationRepository) MarkNotificationsSent(ctx context.Context, ids []string, at time.Time) error {
+	valid := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if validUUID(id) {
+			valid = append(valid, id)
+		}
+	}
+	if len(valid) == 0 {
+		return nil
+	}
+	_, err := r.db.Exec(ctx,
+		`UPDATE notifications SET sent_at = $2 WHERE id = ANY($1::uuid[])`, valid, at)
+	if err != nil {
+		return fmt.Errorf("mark notifications sent: %w", err)
+	}
+	return nil
+}
+
+// nonNil stores a nil slice as an empty array, since the array columns are
+// NOT NULL.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"api/internal/repository/repositorytest"
+)
+
+// newStores connects to TEST_DATABASE_URL, a disposable database with the
+// Supabase migrations applied, and empties the tables the suite touches.
+// The tests are skipped when it is unset; CI sets it to a Supabase local
+// database (supabase db start) and fails if they skip.
+func newStores(t *testing.T) repositorytest.Stores {
+	t.Helper()
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+
+	ctx := context.Background()
+	pool, err := Connect(ctx, url)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	_, err = pool.Exec(ctx,
+		"TRUNCATE webhook_deliveries, webhooks, notifications, notification_devices, notification_subscriptions, settings, follows, dead_letters, job_runs, sync_versions, utah_legislator_stats, utah_bills, utah_legislator_terms, utah_legislators CASCADE")
+	if err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+	// Follows and notifications reference Supabase accounts.
+	_, err = pool.Exec(ctx, "INSERT INTO auth.users (id) VALUES ($1), ($2) ON CONFLICT DO NOTHING",
+		repositorytest.UserA, repositorytest.UserB)
+	if err != nil {
+		t.Fatalf("create users: %v", err)
+	}
+	return repositorytest.Stores{
+		Bills:       NewBillRepository(pool),
+		Legislators: NewLegislatorRepository(pool),
+		Stats:       NewStatsRepository(pool),
+		Sync:        NewSyncRepository(pool),
+		Runs:        NewJobRunRepository(pool),
+		DeadLetters: NewDeadLetterRepository(pool),
+		Watchlist:   NewWatchlistRepository(pool),
+
+		Notifications: NewNotificationRepository(pool),
+		Webhooks:      NewWebhookRepository(pool),
+	}
+}
+
+func TestLegislatorRepository(t *testing.T) {
+	repositorytest.TestLegislatorRepository(t, newStores)
+}
+
+func TestBillRepository(t *testing.T) {
+	repositorytest.TestBillRepository(t, newStores)
+}
+
+func TestStatsRepository(t *testing.T) {
+	repositorytest.TestStatsRepository(t, newStores)
+}
+
+func TestSyncRepository(t *testing.T) {
+	repositorytest.TestSyncRepository(t, newStores)
+}
+
+func TestJobRunRepository(t *testing.T) {
+	repositorytest.TestJobRunRepository(t, newStores)
+}
+
+func TestDeadLetterRepository(t *testing.T) {
+	repositorytest.TestDeadLetterRepository(t, newStores)
+}
+
+func TestWatchlistRepository(t *testing.T) {
+	repositorytest.TestWatchlistRepository(t, newStores)
+}
+
+func TestNotificationRepository(t *testing.T) {
+	repositorytest.TestNotificationRepository(t, newStores)
+}
+
+func TestWebhookRepository(t *testing.T) {
+	repositorytest.TestWebhookRepository(t, newStores)
+}
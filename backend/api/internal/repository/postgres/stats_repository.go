@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"api/internal/domain"
+)
+
+// StatsRepository is the Postgres implementation of repository.StatsRepository.
+type StatsRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewStatsRepository creates a new Postgres-backed StatsRepository.
+func NewStatsRepository(db *pgxpool.Pool) *StatsRepository {
+	return &StatsRepository{db: db}
+}
+
+// agreementJSON is the stored shape of a domain.Agreement.
+type agreementJSON struct {
+	LegislatorID string  `json:"legislator_id"`
+	SharedVotes  int     `json:"shared_votes"`
+	Rate         float64 `json:"rate"`
+}
+
+// GetLegislatorStats returns a legislator's per-session stats, newest first.
+func (r *StatsRepository) GetLegislatorStats(ctx context.Context, legislatorID string, sessionYear int) ([]domain.LegislatorStats, error) {
+	if !validUUID(legislatorID) {
+		return nil, nil
+	}
+
+	query := `SELECT legislator_id::text, session_id, COALESCE(session_name, ''), session_year,
+		eligible_votes, votes_cast, missed_votes, participation_rate, votes_against_party,
+		bills_sponsored, bills_passed, agreement, computed_at, dataset_hash
+		FROM utah_legislator_stats
+		WHERE legislator_id = $1`
+	args := []any{legislatorID}
+	if sessionYear != 0 {
+		query += " AND session_year = $2"
+		args = append(args, sessionYear)
+	}
+	query += " ORDER BY session_year DESC, session_id DESC"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get legislator stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []domain.LegislatorStats
+	for rows.Next() {
+		var s domain.LegislatorStats
+		var agreement []agreementJSON
+		err := rows.Scan(
+			&s.LegislatorID, &s.SessionID, &s.SessionName, &s.SessionYear,
+			&s.EligibleVotes, &s.VotesCast, &s.MissedVotes, &s.ParticipationRate, &s.VotesAgainstParty,
+			&s.BillsSponsored, &s.BillsPassed, &agreement, &s.ComputedAt, &s.DatasetHash,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("get legislator stats: %w", err)
+		}
+		for _, a := range agreement {
+			s.Agreement = append(s.Agreement, domain.Agreement(a))
+		}
+		stats = append(stats, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get legislator stats: %w", err)
+	}
+	return stats, nil
+}
+
+// SessionDatasetHash returns the dataset hash of a session's stats.
+func (r *StatsRepository) SessionDatasetHash(ctx context.Context, sessionID int) (string, error) {
+	var hash string
+	err := r.db.QueryRow(ctx,
+		"SELECT dataset_hash FROM utah_legislator_stats WHERE session_id = $1 LIMIT 1", sessionID,
+	).Scan(&hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("session dataset hash: %w", err)
+	}
+	return hash, nil
+}
+
+// ReplaceSessionStats deletes a session's stats and writes the new set in
+// one transaction, so readers never see a half-written session.
+func (r *StatsRepository) ReplaceSessionStats(ctx context.Context, sessionID int, stats []domain.LegislatorStats) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "DELETE FROM utah_legislator_stats WHERE session_id = $1", sessionID); err != nil {
+			return fmt.Errorf("delete session stats: %w", err)
+		}
+
+		for _, s := range stats {
+			agreement := make([]agreementJSON, 0, len(s.Agreement))
+			for _, a := range s.Agreement {
+				agreement = append(agreement, agreementJSON(a))
+			}
+			_, err := tx.Exec(ctx,
+				`INSERT INTO utah_legislator_stats (
+					legislator_id, session_id, session_name, session_year,
+					eligible_votes, votes_cast, missed_votes, participation_rate, votes_against_party,
+					bills_sponsored, bills_passed, agreement, computed_at, dataset_hash
+				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+				s.LegislatorID, sessionID, s.SessionName, s.SessionYear,
+				s.EligibleVotes, s.VotesCast, s.MissedVotes, s.ParticipationRate, s.VotesAgainstParty,
+				s.BillsSponsored, s.BillsPassed, agreement, s.ComputedAt, s.DatasetHash,
+			)
+			if err != nil {
+				return fmt.Errorf("save stats for %s: %w", s.LegislatorID, err)
+			}
+		}
+		return nil
+	})
+}
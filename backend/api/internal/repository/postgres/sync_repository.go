@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"api/internal/domain"
+)
+
+// SyncRepository is the Postgres implementation of repository.SyncRepository.
+type SyncRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewSyncRepository creates a new Postgres-backed SyncRepository.
+func NewSyncRepository(db *pgxpool.Pool) *SyncRepository {
+	return &SyncRepository{db: db}
+}
+
+// GetSyncVersion returns the current marker for entity.
+func (r *SyncRepository) GetSyncVersion(ctx context.Context, entity string) (domain.SyncVersion, error) {
+	v := domain.SyncVersion{Entity: entity}
+	err := r.db.QueryRow(ctx,
+		`SELECT version, synced_at FROM sync_versions WHERE entity = $1`, entity,
+	).Scan(&v.Version, &v.SyncedAt)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return domain.SyncVersion{}, fmt.Errorf("get sync version: %w", err)
+	}
+	return v, nil
+}
+
+// BumpSyncVersion increments entity's version and sets SyncedAt to now.
+func (r *SyncRepository) BumpSyncVersion(ctx context.Context, entity string) (domain.SyncVersion, error) {
+	v := domain.SyncVersion{Entity: entity}
+	err := r.db.QueryRow(ctx,
+		`INSERT INTO sync_versions (entity, version, synced_at) VALUES ($1, 1, $2)
+		ON CONFLICT (entity) DO UPDATE
+			SET version = sync_versions.version + 1, synced_at = EXCLUDED.synced_at
+		RETURNING version, synced_at`,
+		entity, time.Now().UTC(),
+	).Scan(&v.Version, &v.SyncedAt)
+	if err != nil {
+		return domain.SyncVersion{}, fmt.Errorf("bump sync version: %w", err)
+	}
+	return v, nil
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"api/internal/domain"
+)
+
+// WatchlistRepository is the Postgres implementation of repository.WatchlistRepository.
+type WatchlistRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewWatchlistRepository creates a new Postgres-backed WatchlistRepository.
+func NewWatchlistRepository(db *pgxpool.Pool) *WatchlistRepository {
+	return &WatchlistRepository{db: db}
+}
+
+// Follow adds an item to a user's watchlist. User IDs are Supabase
+// auth.users ids, so they must be UUIDs.
+func (r *WatchlistRepository) Follow(ctx context.Context, f domain.Follow) (domain.Follow, error) {
+	if !validUUID(f.UserID) {
+		return domain.Follow{}, fmt.Errorf("follow: invalid user id %q", f.UserID)
+	}
+	// The no-op update makes RETURNING yield the existing row on conflict.
+	err := r.db.QueryRow(ctx,
+		`INSERT INTO follows (user_id, kind, target_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, kind, target_id) DO UPDATE SET kind = EXCLUDED.kind
+		RETURNING id::text, created_at`,
+		f.UserID, f.Kind, f.TargetID,
+	).Scan(&f.ID, &f.CreatedAt)
+	if err != nil {
+		return domain.Follow{}, fmt.Errorf("follow %s %s: %w", f.Kind, f.TargetID, err)
+	}
+	return f, nil
+}
+
+// Unfollow removes an item from a user's watchlist.
+func (r *WatchlistRepository) Unfollow(ctx context.Context, userID, kind, targetID string) error {
+	if !validUUID(userID) {
+		return nil
+	}
+	_, err := r.db.Exec(ctx,
+		`DELETE FROM follows WHERE user_id = $1 AND kind = $2 AND target_id = $3`,
+		userID, kind, targetID)
+	if err != nil {
+		return fmt.Errorf("unfollow %s %s: %w", kind, targetID, err)
+	}
+	return nil
+}
+
+// ListFollows returns a user's follows, newest first.
+func (r *WatchlistRepository) ListFollows(ctx context.Context, userID, kind string) ([]domain.Follow, error) {
+	follows := []domain.Follow{}
+	if !validUUID(userID) {
+		return follows, nil
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT id::text, user_id::text, kind, target_id, created_at
+		FROM follows
+		WHERE user_id = $1 AND ($2 = '' OR kind = $2)
+		ORDER BY created_at DESC, id DESC`,
+		userID, kind,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list follows: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var f domain.Follow
+		if err := rows.Scan(&f.ID, &f.UserID, &f.Kind, &f.TargetID, &f.CreatedAt); err != nil {
+			return nil, fmt.Errorf("list follows: %w", err)
+		}
+		follows = append(follows, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list follows: %w", err)
+	}
+	return follows, nil
+}
@@ -0,0 +1,243 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"api/internal/domain"
+)
+
+// WebhookRepository is the Postgres implementation of repository.WebhookRepository.
+type WebhookRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewWebhookRepository creates a new Postgres-backed WebhookRepository.
+func NewWebhookRepository(db *pgxpool.Pool) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+const webhookColumns = `id::text, owner_id::text, url, secret, event_types,
+	COALESCE(bill_id, ''), COALESCE(sponsor_id, ''), keywords, created_at`
+
+const deliveryColumns = `id::text, webhook_id::text, event_id, event_type, payload::text, status, attempts,
+	next_attempt_at, last_attempt_at, COALESCE(response_status, 0), COALESCE(last_error, ''),
+	COALESCE(replay_of::text, ''), created_at`
+
+// CreateWebhook stores a new webhook.
+func (r *WebhookRepository) CreateWebhook(ctx context.Context, w domain.Webhook) (domain.Webhook, error) {
+	if !validUUID(w.OwnerID) {
+		return domain.Webhook{}, fmt.Errorf("create webhook: invalid owner id %q", w.OwnerID)
+	}
+	err := r.db.QueryRow(ctx,
+		`INSERT INTO webhooks (owner_id, url, secret, event_types, bill_id, sponsor_id, keywords)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id::text, created_at`,
+		w.OwnerID, w.URL, w.Secret, nonNil(w.EventTypes), nullIfEmpty(w.BillID), nullIfEmpty(w.SponsorID), nonNil(w.Keywords),
+	).Scan(&w.ID, &w.CreatedAt)
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("create webhook: %w", err)
+	}
+	return w, nil
+}
+
+// GetWebhook returns a webhook by ID.
+func (r *WebhookRepository) GetWebhook(ctx context.Context, id string) (*domain.Webhook, error) {
+	if !validUUID(id) {
+		return nil, nil
+	}
+	w, err := scanWebhook(r.db.QueryRow(ctx, `SELECT `+webhookColumns+` FROM webhooks WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get webhook %s: %w", id, err)
+	}
+	return &w, nil
+}
+
+// ListWebhooks returns an owner's webhooks, or everyone's.
+func (r *WebhookRepository) ListWebhooks(ctx context.Context, ownerID string) ([]domain.Webhook, error) {
+	out := []domain.Webhook{}
+	if ownerID != "" && !validUUID(ownerID) {
+		return out, nil
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT `+webhookColumns+`
+		FROM webhooks
+		WHERE $1 = '' OR owner_id = NULLIF($1, '')::uuid
+		ORDER BY created_at, id`,
+		ownerID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("list webhooks: %w", err)
+		}
+		out = append(out, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list webhooks: %w", err)
+	}
+	return out, nil
+}
+
+// DeleteWebhook removes one of an owner's webhooks; its deliveries go with
+// it by cascade.
+func (r *WebhookRepository) DeleteWebhook(ctx context.Context, ownerID, id string) error {
+	if !validUUID(ownerID) || !validUUID(id) {
+		return nil
+	}
+	_, err := r.db.Exec(ctx, `DELETE FROM webhooks WHERE id = $1 AND owner_id = $2`, id, ownerID)
+	if err != nil {
+		return fmt.Errorf("delete webhook %s: %w", id, err)
+	}
+	return nil
+}
+
+// EnqueueDeliveries adds deliveries to the log in one transaction.
+// created_at defaults to clock_timestamp(), so they keep their order.
+func (r *WebhookRepository) EnqueueDeliveries(ctx context.Context, ds []domain.WebhookDelivery) ([]domain.WebhookDelivery, error) {
+	out := make([]domain.WebhookDelivery, 0, len(ds))
+	if len(ds) == 0 {
+		return out, nil
+	}
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		for _, d := range ds {
+			if !validUUID(d.WebhookID) {
+				return fmt.Errorf("enqueue webhook delivery: invalid webhook id %q", d.WebhookID)
+			}
+			err := tx.QueryRow(ctx,
+				`INSERT INTO webhook_deliveries (
+					webhook_id, event_id, event_type, payload, status, attempts,
+					next_attempt_at, last_attempt_at, response_status, last_error, replay_of
+				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+				RETURNING id::text, created_at`,
+				d.WebhookID, d.EventID, d.EventType, string(d.Payload), d.Status, d.Attempts,
+				d.NextAttemptAt, d.LastAttemptAt, nullIfZero(d.ResponseStatus), nullIfEmpty(d.LastError), nullIfEmpty(d.ReplayOf),
+			).Scan(&d.ID, &d.CreatedAt)
+			if err != nil {
+				return fmt.Errorf("enqueue webhook delivery: %w", err)
+			}
+			out = append(out, d)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DueDeliveries returns the pending deliveries due by now, oldest first.
+func (r *WebhookRepository) DueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+deliveryColumns+`
+		FROM webhook_deliveries
+		WHERE status = 'pending' AND next_attempt_at <= $1
+		ORDER BY created_at, id
+		LIMIT NULLIF($2, 0)`,
+		now, max(limit, 0),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("due webhook deliveries: %w", err)
+	}
+	return collectDeliveries(rows)
+}
+
+// UpdateDelivery stores the outcome of an attempt.
+func (r *WebhookRepository) UpdateDelivery(ctx context.Context, d domain.WebhookDelivery) error {
+	if !validUUID(d.ID) {
+		return nil
+	}
+	_, err := r.db.Exec(ctx,
+		`UPDATE webhook_deliveries SET
+			status = $2, attempts = $3, next_attempt_at = $4, last_attempt_at = $5,
+			response_status = $6, last_error = $7
+		WHERE id = $1`,
+		d.ID, d.Status, d.Attempts, d.NextAttemptAt, d.LastAttemptAt, nullIfZero(d.ResponseStatus), nullIfEmpty(d.LastError),
+	)
+	if err != nil {
+		return fmt.Errorf("update webhook delivery %s: %w", d.ID, err)
+	}
+	return nil
+}
+
+// GetDelivery returns a delivery by ID.
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	if !validUUID(id) {
+		return nil, nil
+	}
+	d, err := scanDelivery(r.db.QueryRow(ctx, `SELECT `+deliveryColumns+` FROM webhook_deliveries WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get webhook delivery %s: %w", id, err)
+	}
+	return &d, nil
+}
+
+// ListDeliveries returns a webhook's most recent deliveries, newest first.
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, webhookID string, limit int) ([]domain.WebhookDelivery, error) {
+	if !validUUID(webhookID) {
+		return []domain.WebhookDelivery{}, nil
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT `+deliveryColumns+`
+		FROM webhook_deliveries
+		WHERE webhook_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2`,
+		webhookID, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook deliveries: %w", err)
+	}
+	return collectDeliveries(rows)
+}
+
+func scanWebhook(row pgx.Row) (domain.Webhook, error) {
+	var w domain.Webhook
+	err := row.Scan(&w.ID, &w.OwnerID, &w.URL, &w.Secret, &w.EventTypes, &w.BillID, &w.SponsorID, &w.Keywords, &w.CreatedAt)
+	return w, err
+}
+
+func scanDelivery(row pgx.Row) (domain.WebhookDelivery, error) {
+	var d domain.WebhookDelivery
+	var payload string
+	err := row.Scan(&d.ID, &d.WebhookID, &d.EventID, &d.EventType, &payload, &d.Status, &d.Attempts,
+		&d.NextAttemptAt, &d.LastAttemptAt, &d.ResponseStatus, &d.LastError, &d.ReplayOf, &d.CreatedAt)
+	d.Payload = []byte(payload)
+	return d, err
+}
+
+// collectDeliveries scans and closes rows of deliveryColumns.
+func collectDeliveries(rows pgx.Rows) ([]domain.WebhookDelivery, error) {
+	defer rows.Close()
+	out := []domain.WebhookDelivery{}
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan webhook delivery: %w", err)
+		}
+		out = append(out, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("scan webhook delivery: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,1417 @@
+// Package repositorytest is a conformance suite for repository
+// implementations. Each store's tests call TestLegislatorRepository,
+// TestBillRepository, TestStatsRepository, TestSyncRepository,
+// TestJobRunRepository, TestDeadLetterRepository, TestWatchlistRepository,
+// TestNotificationRepository, TestWebhookRepository and
+// TestAPIKeyRepository with a factory for empty stores, so every
+// implementation is held to the same contract.
+package repositorytest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// Stores is a fresh, empty set of repositories backed by one store. Stats,
+// Sync, Runs, DeadLetters, Watchlist, Notifications, Webhooks and APIKeys
+// may be nil for stores that don't implement them.
+type Stores struct {
+	Bills       repository.BillRepository
+	Legislators repository.LegislatorRepository
+	Stats       repository.StatsRepository
+	Sync        repository.SyncRepository
+	Runs        repository.JobRunRepository
+	DeadLetters repository.DeadLetterRepository
+	Watchlist   repository.WatchlistRepository
+
+	Notifications repository.NotificationRepository
+	Webhooks      repository.WebhookRepository
+	APIKeys       repository.APIKeyRepository
+}
+
+// Users the watchlist, notification and webhook tests act as. Stores that check user IDs
+// against an accounts table must have these users.
+const (
+	UserA = "00000000-0000-4000-8000-00000000000a"
+	UserB = "00000000-0000-4000-8000-00000000000b"
+)
+
+// Factory returns empty stores for a single subtest.
+type Factory func(t *testing.T) Stores
+
+// utahLegislator returns a legislator as the Utah API would send it.
+func utahLegislator(utahID, chamber string, district int, first, last string) domain.Legislator {
+	return domain.Legislator{
+		Chamber:           chamber,
+		DistrictNumber:    district,
+		FirstName:         first,
+		LastName:          last,
+		Party:             "Republican",
+		Email:             utahID + "@le.utah.gov",
+		UtahLegislatureID: utahID,
+		Source:            domain.SourceUtahLegislature,
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func mustUpsertLegislator(t *testing.T, repo repository.LegislatorRepository, l domain.Legislator) {
+	t.Helper()
+	if err := repo.UpsertLegislator(context.Background(), l); err != nil {
+		t.Fatalf("UpsertLegislator(%s %s): %v", l.FirstName, l.LastName, err)
+	}
+}
+
+func mustList(t *testing.T, repo repository.LegislatorRepository, f repository.LegislatorFilters) []domain.Legislator {
+	t.Helper()
+	ls, err := repo.ListLegislators(context.Background(), f)
+	if err != nil {
+		t.Fatalf("ListLegislators(%+v): %v", f, err)
+	}
+	return ls
+}
+
+// byUtahID returns the legislator with the given Utah ID from ls.
+func byUtahID(t *testing.T, ls []domain.Legislator, utahID string) domain.Legislator {
+	t.Helper()
+	for _, l := range ls {
+		if l.UtahLegislatureID == utahID {
+			return l
+		}
+	}
+	t.Fatalf("legislator %s not in list of %d", utahID, len(ls))
+	return domain.Legislator{}
+}
+
+// TestLegislatorRepository checks the repository.LegislatorRepository contract.
+func TestLegislatorRepository(t *testing.T, newStores Factory) {
+	ctx := context.Background()
+
+	t.Run("UpsertIsIdempotent", func(t *testing.T) {
+		repo := newStores(t).Legislators
+		l := utahLegislator("SMITHJ", "house", 1, "Jane", "Smith")
+		mustUpsertLegislator(t, repo, l)
+		mustUpsertLegislator(t, repo, l)
+
+		ls := mustList(t, repo, repository.LegislatorFilters{IncludeFormer: true})
+		if len(ls) != 1 {
+			t.Fatalf("got %d legislators, want 1", len(ls))
+		}
+		got := ls[0]
+		if got.ID == "" || got.FirstName != "Jane" || got.Email != l.Email || got.Chamber != "house" || got.DistrictNumber != 1 {
+			t.Errorf("stored legislator = %+v", got)
+		}
+		if !got.Current || got.TermEnd != nil {
+			t.Errorf("Current = %v, TermEnd = %v; want current", got.Current, got.TermEnd)
+		}
+		terms, err := repo.ListTerms(ctx, got.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(terms) != 1 {
+			t.Errorf("got %d terms, want 1", len(terms))
+		}
+	})
+
+	t.Run("UpsertUpdatesByUpstreamID", func(t *testing.T) {
+		repo := newStores(t).Legislators
+		l := utahLegislator("SMITHJ", "house", 1, "Jane", "Smith")
+		mustUpsertLegislator(t, repo, l)
+		l.Email = "new@le.utah.gov"
+		l.Phone = "801-555-0100"
+		mustUpsertLegislator(t, repo, l)
+
+		got := byUtahID(t, mustList(t, repo, repository.LegislatorFilters{}), "SMITHJ")
+		if got.Email != "new@le.utah.gov" || got.Phone != "801-555-0100" {
+			t.Errorf("Email, Phone = %q, %q; want updated values", got.Email, got.Phone)
+		}
+		if got.Provenance["email"].Source != domain.SourceUtahLegislature {
+			t.Errorf("email provenance = %+v", got.Provenance["email"])
+		}
+	})
+
+	t.Run("LowerPrioritySourceOnlyFillsGaps", func(t *testing.T) {
+		repo := newStores(t).Legislators
+		mustUpsertLegislator(t, repo, utahLegislator("SMITHJ", "house", 1, "Jane", "Smith"))
+		mustUpsertLegislator(t, repo, domain.Legislator{
+			Chamber:        "house",
+			DistrictNumber: 1,
+			FirstName:      "Janet",
+			LastName:       "Smith",
+			Email:          "jane@example.com",
+			Twitter:        "janesmith",
+			OpenStatesID:   "ocd-person/1",
+			Source:         domain.SourceOpenStates,
+		})
+
+		ls := mustList(t, repo, repository.LegislatorFilters{IncludeFormer: true})
+		if len(ls) != 1 {
+			t.Fatalf("got %d legislators, want the OpenStates record merged into 1", len(ls))
+		}
+		got := ls[0]
+		if got.FirstName != "Jane" || got.Email != "SMITHJ@le.utah.gov" {
+			t.Errorf("OpenStates overwrote Utah fields: %+v", got)
+		}
+		if got.Twitter != "janesmith" || got.OpenStatesID != "ocd-person/1" {
+			t.Errorf("OpenStates gaps not filled: Twitter = %q, OpenStatesID = %q", got.Twitter, got.OpenStatesID)
+		}
+	})
+
+	t.Run("ChamberMoveKeepsIdentity", func(t *testing.T) {
+		repo := newStores(t).Legislators
+		l := utahLegislator("SMITHJ", "house", 1, "Jane", "Smith")
+		mustUpsertLegislator(t, repo, l)
+		l.Chamber, l.DistrictNumber = "senate", 4
+		mustUpsertLegislator(t, repo, l)
+
+		all := mustList(t, repo, repository.LegislatorFilters{IncludeFormer: true})
+		if len(all) != 1 {
+			t.Fatalf("got %d people, want 1", len(all))
+		}
+		if all[0].Chamber != "senate" || all[0].DistrictNumber != 4 {
+			t.Errorf("seat = %s %d, want senate 4", all[0].Chamber, all[0].DistrictNumber)
+		}
+		if house := mustList(t, repo, repository.LegislatorFilters{Chamber: "house"}); len(house) != 0 {
+			t.Errorf("current house members = %d, want 0", len(house))
+		}
+
+		got, err := repo.GetLegislator(ctx, all[0].ID)
+		if err != nil || got == nil {
+			t.Fatalf("GetLegislator = %v, %v", got, err)
+		}
+		if len(got.Terms) != 2 {
+			t.Fatalf("got %d terms, want 2", len(got.Terms))
+		}
+		open := 0
+		for _, term := range got.Terms {
+			if term.End == nil {
+				open++
+			}
+		}
+		if open != 1 || !got.Current {
+			t.Errorf("open terms = %d, Current = %v; want exactly one open term", open, got.Current)
+		}
+	})
+
+	t.Run("NewMemberTakesOverSeat", func(t *testing.T) {
+		repo := newStores(t).Legislators
+		mustUpsertLegislator(t, repo, utahLegislator("SMITHJ", "house", 1, "Jane", "Smith"))
+		mustUpsertLegislator(t, repo, utahLegislator("JONESB", "house", 1, "Bob", "Jones"))
+
+		current := mustList(t, repo, repository.LegislatorFilters{})
+		if len(current) != 1 || current[0].UtahLegislatureID != "JONESB" {
+			t.Fatalf("current members = %+v, want only JONESB", current)
+		}
+		former := byUtahID(t, mustList(t, repo, repository.LegislatorFilters{IncludeFormer: true}), "SMITHJ")
+		if former.Current || former.TermEnd == nil {
+			t.Errorf("SMITHJ Current = %v, TermEnd = %v; want former", former.Current, former.TermEnd)
+		}
+
+		holder, err := repo.GetLegislatorByDistrict(ctx, "house", 1)
+		if err != nil || holder == nil || holder.UtahLegislatureID != "JONESB" {
+			t.Errorf("GetLegislatorByDistrict = %+v, %v; want JONESB", holder, err)
+		}
+	})
+
+	t.Run("BatchUpsert", func(t *testing.T) {
+		repo := newStores(t).Legislators
+		moved := utahLegislator("SMITHJ", "senate", 4, "Jane", "Smith")
+		err := repo.UpsertLegislators(ctx, []domain.Legislator{
+			utahLegislator("SMITHJ", "house", 1, "Jane", "Smith"),
+			utahLegislator("JONESB", "house", 2, "Bob", "Jones"),
+			moved, // later entries see earlier ones in the same batch
+		})
+		if err != nil {
+			t.Fatalf("UpsertLegislators: %v", err)
+		}
+
+		ls := mustList(t, repo, repository.LegislatorFilters{IncludeFormer: true})
+		if len(ls) != 2 {
+			t.Fatalf("got %d legislators, want 2", len(ls))
+		}
+		got := byUtahID(t, ls, "SMITHJ")
+		if got.Chamber != "senate" || got.DistrictNumber != 4 {
+			t.Errorf("SMITHJ seat = %s %d, want senate 4", got.Chamber, got.DistrictNumber)
+		}
+		if err := repo.UpsertLegislators(ctx, nil); err != nil {
+			t.Errorf("UpsertLegislators(nil) = %v", err)
+		}
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		repo := newStores(t).Legislators
+		mustUpsertLegislator(t, repo, utahLegislator("H2", "house", 2, "B", "Beta"))
+		mustUpsertLegislator(t, repo, utahLegislator("H1", "house", 1, "A", "Alpha"))
+		mustUpsertLegislator(t, repo, utahLegislator("S1", "senate", 1, "C", "Gamma"))
+
+		all := mustList(t, repo, repository.LegislatorFilters{})
+		var order []string
+		for _, l := range all {
+			order = append(order, l.UtahLegislatureID)
+		}
+		if fmt.Sprint(order) != "[H1 H2 S1]" {
+			t.Errorf("order = %v, want [H1 H2 S1] (chamber, district)", order)
+		}
+
+		senate := mustList(t, repo, repository.LegislatorFilters{Chamber: "senate"})
+		if len(senate) != 1 || senate[0].UtahLegislatureID != "S1" {
+			t.Errorf("senate = %+v, want only S1", senate)
+		}
+	})
+
+	t.Run("AsOf", func(t *testing.T) {
+		repo := newStores(t).Legislators
+		l := utahLegislator("SMITHJ", "house", 1, "Jane", "Smith")
+		start := date(2021, time.January, 1)
+		l.TermStart = &start
+		mustUpsertLegislator(t, repo, l)
+
+		before := date(2020, time.June, 1)
+		if got := mustList(t, repo, repository.LegislatorFilters{AsOf: &before}); len(got) != 0 {
+			t.Errorf("as of %s got %d members, want 0", before.Format(time.DateOnly), len(got))
+		}
+		after := date(2022, time.June, 1)
+		got := mustList(t, repo, repository.LegislatorFilters{AsOf: &after})
+		if len(got) != 1 {
+			t.Fatalf("as of %s got %d members, want 1", after.Format(time.DateOnly), len(got))
+		}
+		if got[0].TermStart == nil || !got[0].TermStart.Equal(start) {
+			t.Errorf("TermStart = %v, want %v", got[0].TermStart, start)
+		}
+	})
+
+	t.Run("AsOfUnknownStart", func(t *testing.T) {
+		repo := newStores(t).Legislators
+		mustUpsertLegislator(t, repo, utahLegislator("SMITHJ", "house", 1, "Jane", "Smith"))
+
+		// With no start sent, the term is dated from when it was first
+		// seen, so it doesn't match dates before that.
+		before := date(2020, time.June, 1)
+		if got := mustList(t, repo, repository.LegislatorFilters{AsOf: &before}); len(got) != 0 {
+			t.Errorf("as of %s got %d members, want 0", before.Format(time.DateOnly), len(got))
+		}
+		tomorrow := time.Now().Add(24 * time.Hour)
+		got := mustList(t, repo, repository.LegislatorFilters{AsOf: &tomorrow})
+		if len(got) != 1 || got[0].TermStart == nil {
+			t.Errorf("as of tomorrow = %+v, want SMITHJ with a TermStart", got)
+		}
+	})
+
+	t.Run("LaterStartHintDatesFirstTerm", func(t *testing.T) {
+		repo := newStores(t).Legislators
+		l := utahLegislator("SMITHJ", "house", 1, "Jane", "Smith")
+		mustUpsertLegislator(t, repo, l)
+
+		start := date(2019, time.January, 1)
+		l.TermStart = &start
+		mustUpsertLegislator(t, repo, l)
+
+		asOf := date(2020, time.June, 1)
+		got := mustList(t, repo, repository.LegislatorFilters{AsOf: &asOf})
+		if len(got) != 1 {
+			t.Fatalf("as of %s got %d members, want 1", asOf.Format(time.DateOnly), len(got))
+		}
+		if got[0].TermStart == nil || !got[0].TermStart.Equal(start) {
+			t.Errorf("TermStart = %v, want %v", got[0].TermStart, start)
+		}
+
+		// A later start never moves it forward.
+		later := date(2021, time.January, 1)
+		l.TermStart = &later
+		mustUpsertLegislator(t, repo, l)
+		if got := mustList(t, repo, repository.LegislatorFilters{AsOf: &asOf}); len(got) != 1 {
+			t.Errorf("after a later start, as of %s got %d members, want 1", asOf.Format(time.DateOnly), len(got))
+		}
+	})
+
+	t.Run("NoSeat", func(t *testing.T) {
+		repo := newStores(t).Legislators
+		mustUpsertLegislator(t, repo, utahLegislator("H1", "house", 1, "A", "Alpha"))
+		mustUpsertLegislator(t, repo, utahLegislator("NODIST", "house", 0, "B", "Beta"))
+
+		for _, f := range []repository.LegislatorFilters{{}, {IncludeFormer: true}} {
+			got := mustList(t, repo, f)
+			if len(got) != 2 {
+				t.Fatalf("%+v got %d legislators, want 2", f, len(got))
+			}
+			if l := byUtahID(t, got, "NODIST"); l.Current || len(l.Terms) != 0 {
+				t.Errorf("%+v: NODIST Current = %v, Terms = %v; want no seat", f, l.Current, l.Terms)
+			}
+		}
+
+		// Filters on a seat never match someone holding none.
+		tomorrow := time.Now().Add(24 * time.Hour)
+		for _, f := range []repository.LegislatorFilters{{Chamber: "house"}, {AsOf: &tomorrow}} {
+			got := mustList(t, repo, f)
+			if len(got) != 1 || got[0].UtahLegislatureID != "H1" {
+				t.Errorf("%+v = %+v, want only H1", f, got)
+			}
+		}
+	})
+
+	t.Run("RetireLegislators", func(t *testing.T) {
+		repo := newStores(t).Legislators
+		mustUpsertLegislator(t, repo, utahLegislator("KEEP", "house", 1, "A", "Keep"))
+		mustUpsertLegislator(t, repo, utahLegislator("GONE", "house", 2, "B", "Gone"))
+
+		if n, err := repo.RetireLegislators(ctx, nil); err != nil || n != 0 {
+			t.Errorf("RetireLegislators(nil) = %d, %v; want 0 (empty roster retires no one)", n, err)
+		}
+		n, err := repo.RetireLegislators(ctx, []string{"KEEP"})
+		if err != nil || n != 1 {
+			t.Fatalf("RetireLegislators = %d, %v; want 1", n, err)
+		}
+
+		current := mustList(t, repo, repository.LegislatorFilters{})
+		if len(current) != 1 || current[0].UtahLegislatureID != "KEEP" {
+			t.Errorf("current = %+v, want only KEEP", current)
+		}
+		if all := mustList(t, repo, repository.LegislatorFilters{IncludeFormer: true}); len(all) != 2 {
+			t.Errorf("including former got %d, want 2", len(all))
+		}
+		if n, _ := repo.RetireLegislators(ctx, []string{"KEEP"}); n != 0 {
+			t.Errorf("second RetireLegislators = %d, want 0", n)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := newStores(t).Legislators
+		if l, err := repo.GetLegislator(ctx, "missing"); err != nil || l != nil {
+			t.Errorf("GetLegislator(missing) = %v, %v; want nil, nil", l, err)
+		}
+		if l, err := repo.GetLegislatorByDistrict(ctx, "house", 99); err != nil || l != nil {
+			t.Errorf("GetLegislatorByDistrict(house 99) = %v, %v; want nil, nil", l, err)
+		}
+		if terms, err := repo.ListTerms(ctx, "missing"); err != nil || len(terms) != 0 {
+			t.Errorf("ListTerms(missing) = %v, %v; want none", terms, err)
+		}
+		if ls := mustList(t, repo, repository.LegislatorFilters{}); len(ls) != 0 {
+			t.Errorf("empty store listed %d legislators", len(ls))
+		}
+	})
+}
+
+func testBill(number string, year int, status string) domain.Bill {
+	return domain.Bill{
+		BillNumber:  number,
+		BillType:    number[:2],
+		SessionYear: year,
+		Title:       "Title of " + number,
+		Status:      status,
+		Source:      domain.SourceUtahLegislature,
+	}
+}
+
+func mustUpsertBill(t *testing.T, repo repository.BillRepository, b domain.Bill) {
+	t.Helper()
+	if err := repo.UpsertBill(context.Background(), b); err != nil {
+		t.Fatalf("UpsertBill(%s): %v", b.BillNumber, err)
+	}
+}
+
+func mustListBills(t *testing.T, repo repository.BillRepository, f repository.BillFilters) []domain.Bill {
+	t.Helper()
+	bills, err := repo.ListBills(context.Background(), f)
+	if err != nil {
+		t.Fatalf("ListBills(%+v): %v", f, err)
+	}
+	return bills
+}
+
+func billNumbers(bills []domain.Bill) string {
+	var out []string
+	for _, b := range bills {
+		out = append(out, fmt.Sprintf("%s/%d", b.BillNumber, b.SessionYear))
+	}
+	return fmt.Sprint(out)
+}
+
+// TestBillRepository checks the repository.BillRepository contract.
+func TestBillRepository(t *testing.T, newStores Factory) {
+	ctx := context.Background()
+
+	t.Run("UpsertIsIdempotent", func(t *testing.T) {
+		repo := newStores(t).Bills
+		b := testBill("HB0001", 2026, "introduced")
+		mustUpsertBill(t, repo, b)
+		mustUpsertBill(t, repo, b)
+		b.Status = "passed"
+		mustUpsertBill(t, repo, b)
+
+		bills := mustListBills(t, repo, repository.BillFilters{})
+		if len(bills) != 1 {
+			t.Fatalf("got %d bills, want 1", len(bills))
+		}
+		if bills[0].Status != "passed" || bills[0].Title != "Title of HB0001" {
+			t.Errorf("stored bill = %+v", bills[0])
+		}
+	})
+
+	t.Run("BatchUpsert", func(t *testing.T) {
+		repo := newStores(t).Bills
+		mustUpsertBill(t, repo, testBill("HB0001", 2026, "introduced"))
+
+		updated := testBill("HB0001", 2026, "passed")
+		err := repo.UpsertBills(ctx, []domain.Bill{
+			testBill("HB0002", 2026, "introduced"),
+			testBill("HB0002", 2026, "failed"), // the later duplicate wins
+			updated,
+			testBill("HB0001", 2025, "passed"),
+		})
+		if err != nil {
+			t.Fatalf("UpsertBills: %v", err)
+		}
+
+		bills := mustListBills(t, repo, repository.BillFilters{})
+		if got := billNumbers(bills); got != "[HB0001/2026 HB0002/2026 HB0001/2025]" {
+			t.Fatalf("bills = %s", got)
+		}
+		if bills[0].Status != "passed" || bills[1].Status != "failed" {
+			t.Errorf("statuses = %q, %q; want passed, failed", bills[0].Status, bills[1].Status)
+		}
+		if err := repo.UpsertBills(ctx, nil); err != nil {
+			t.Errorf("UpsertBills(nil) = %v", err)
+		}
+	})
+
+	t.Run("IDsAndActivity", func(t *testing.T) {
+		repo := newStores(t).Bills
+		for i, number := range []string{"HB0001", "HB0002", "HB0003", "HB0004"} {
+			b := testBill(number, 2026, "introduced")
+			if i > 0 { // HB0001 has had no action
+				d := date(2026, time.February, i)
+				b.LastAction, b.LastActionDate = "action", &d
+			}
+			mustUpsertBill(t, repo, b)
+		}
+		ids := map[string]string{}
+		for _, b := range mustListBills(t, repo, repository.BillFilters{}) {
+			ids[b.BillNumber] = b.ID
+		}
+
+		f := repository.BillFilters{IDs: []string{ids["HB0001"], ids["HB0002"], ids["HB0004"], "no-such-bill"}, ByActivity: true}
+		if got := billNumbers(mustListBills(t, repo, f)); got != "[HB0004/2026 HB0002/2026 HB0001/2026]" {
+			t.Errorf("followed bills by activity = %s, want HB0004, HB0002, HB0001", got)
+		}
+		f.Page, f.PageSize = 2, 2
+		if got := billNumbers(mustListBills(t, repo, f)); got != "[HB0001/2026]" {
+			t.Errorf("page 2 = %s, want HB0001", got)
+		}
+		if got := mustListBills(t, repo, repository.BillFilters{IDs: []string{}}); len(got) != 0 {
+			t.Errorf("empty IDs matched %s", billNumbers(got))
+		}
+	})
+
+	t.Run("LinkSponsors", func(t *testing.T) {
+		stores := newStores(t)
+		early := testBill("HB0001", 2026, "introduced")
+		early.SponsorUtahID = "SMITHJ"
+		other := testBill("HB0002", 2026, "introduced")
+		other.SponsorUtahID = "DOEJ"
+		mustUpsertBill(t, stores.Bills, early)
+		mustUpsertBill(t, stores.Bills, other)
+
+		unresolved, err := stores.Bills.UnresolvedSponsors(ctx)
+		if err != nil || len(unresolved) != 2 {
+			t.Fatalf("UnresolvedSponsors = %+v, %v; want DOEJ and SMITHJ", unresolved, err)
+		}
+
+		// The sponsor arrives after their bill.
+		mustUpsertLegislator(t, stores.Legislators, utahLegislator("SMITHJ", "house", 1, "Jane", "Smith"))
+		sponsor := byUtahID(t, mustList(t, stores.Legislators, repository.LegislatorFilters{}), "SMITHJ")
+		linked, err := stores.Bills.LinkSponsors(ctx)
+		if err != nil || linked != 1 {
+			t.Fatalf("LinkSponsors = %d, %v; want 1", linked, err)
+		}
+
+		bills := mustListBills(t, stores.Bills, repository.BillFilters{SponsorID: sponsor.ID})
+		if len(bills) != 1 || bills[0].BillNumber != "HB0001" || bills[0].SponsorUtahID != "SMITHJ" {
+			t.Fatalf("bills sponsored by SMITHJ = %+v", bills)
+		}
+		if bills[0].Sponsor == nil || bills[0].Sponsor.LastName != "Smith" {
+			t.Errorf("sponsor = %+v, want Smith", bills[0].Sponsor)
+		}
+
+		// A later sync that can't resolve the sponsor keeps the link.
+		mustUpsertBill(t, stores.Bills, early)
+		if got := mustListBills(t, stores.Bills, repository.BillFilters{SponsorID: sponsor.ID}); len(got) != 1 {
+			t.Errorf("link lost after re-sync: %s", billNumbers(got))
+		}
+
+		unresolved, err = stores.Bills.UnresolvedSponsors(ctx)
+		if err != nil || len(unresolved) != 1 || unresolved[0] != (domain.UnresolvedSponsor{SponsorUtahID: "DOEJ", Bills: 1}) {
+			t.Errorf("UnresolvedSponsors = %+v, %v; want DOEJ with 1 bill", unresolved, err)
+		}
+		if linked, err := stores.Bills.LinkSponsors(ctx); err != nil || linked != 0 {
+			t.Errorf("second LinkSponsors = %d, %v; want 0", linked, err)
+		}
+	})
+
+	t.Run("KeyedOnNumberAndSession", func(t *testing.T) {
+		repo := newStores(t).Bills
+		mustUpsertBill(t, repo, testBill("HB0001", 2025, "passed"))
+		mustUpsertBill(t, repo, testBill("HB0001", 2026, "introduced"))
+
+		if got := billNumbers(mustListBills(t, repo, repository.BillFilters{})); got != "[HB0001/2026 HB0001/2025]" {
+			t.Errorf("bills = %s, want one per session", got)
+		}
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		stores := newStores(t)
+		mustUpsertLegislator(t, stores.Legislators, utahLegislator("SMITHJ", "house", 1, "Jane", "Smith"))
+		sponsor := byUtahID(t, mustList(t, stores.Legislators, repository.LegislatorFilters{}), "SMITHJ")
+
+		sponsored := testBill("HB0002", 2026, "passed")
+		sponsored.SponsorID = sponsor.ID
+		mustUpsertBill(t, stores.Bills, sponsored)
+		mustUpsertBill(t, stores.Bills, testBill("HB0001", 2026, "introduced"))
+		mustUpsertBill(t, stores.Bills, testBill("SB0001", 2025, "passed"))
+
+		cases := []struct {
+			filters repository.BillFilters
+			want    string
+		}{
+			{repository.BillFilters{SessionYear: 2026}, "[HB0001/2026 HB0002/2026]"},
+			{repository.BillFilters{Status: "passed"}, "[HB0002/2026 SB0001/2025]"},
+			{repository.BillFilters{SponsorID: sponsor.ID}, "[HB0002/2026]"},
+			{repository.BillFilters{SessionYear: 2025, Status: "introduced"}, "[]"},
+			{repository.BillFilters{SessionYear: 2026, BillNumbers: []string{"HB0001", "SB0001"}}, "[HB0001/2026]"},
+			{repository.BillFilters{BillNumbers: []string{"SB0001", "HB0002"}}, "[HB0002/2026 SB0001/2025]"},
+			{repository.BillFilters{BillNumbers: []string{}}, "[]"},
+		}
+		for _, c := range cases {
+			if got := billNumbers(mustListBills(t, stores.Bills, c.filters)); got != c.want {
+				t.Errorf("ListBills(%+v) = %s, want %s", c.filters, got, c.want)
+			}
+		}
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		repo := newStores(t).Bills
+		for i := 5; i >= 1; i-- {
+			mustUpsertBill(t, repo, testBill(fmt.Sprintf("HB%04d", i), 2026, "introduced"))
+		}
+
+		pages := []string{"[HB0001/2026 HB0002/2026]", "[HB0003/2026 HB0004/2026]", "[HB0005/2026]", "[]"}
+		for i, want := range pages {
+			got := billNumbers(mustListBills(t, repo, repository.BillFilters{Page: i + 1, PageSize: 2}))
+			if got != want {
+				t.Errorf("page %d = %s, want %s", i+1, got, want)
+			}
+		}
+		if got := mustListBills(t, repo, repository.BillFilters{}); len(got) != 5 {
+			t.Errorf("default page got %d bills, want all 5", len(got))
+		}
+	})
+
+	t.Run("GetBill", func(t *testing.T) {
+		stores := newStores(t)
+		mustUpsertLegislator(t, stores.Legislators, utahLegislator("SMITHJ", "house", 1, "Jane", "Smith"))
+		sponsor := byUtahID(t, mustList(t, stores.Legislators, repository.LegislatorFilters{}), "SMITHJ")
+
+		b := testBill("HB0001", 2026, "introduced")
+		b.SponsorID = sponsor.ID
+		effective := date(2026, time.May, 6)
+		b.EffectiveDate = &effective
+		mustUpsertBill(t, stores.Bills, b)
+
+		id := mustListBills(t, stores.Bills, repository.BillFilters{})[0].ID
+		got, err := stores.Bills.GetBill(ctx, id)
+		if err != nil || got == nil {
+			t.Fatalf("GetBill = %v, %v", got, err)
+		}
+		if got.Sponsor == nil || got.Sponsor.ID != sponsor.ID || got.SponsorID != sponsor.ID {
+			t.Errorf("Sponsor = %+v, SponsorID = %q; want %s", got.Sponsor, got.SponsorID, sponsor.ID)
+		}
+		if got.EffectiveDate == nil || !got.EffectiveDate.Equal(effective) {
+			t.Errorf("EffectiveDate = %v, want %v", got.EffectiveDate, effective)
+		}
+	})
+
+	t.Run("ListSponsors", func(t *testing.T) {
+		stores := newStores(t)
+		mustUpsertLegislator(t, stores.Legislators, utahLegislator("SMITHJ", "house", 1, "Jane", "Smith"))
+		sponsor := byUtahID(t, mustList(t, stores.Legislators, repository.LegislatorFilters{}), "SMITHJ")
+		for _, n := range []string{"HB0001", "HB0002"} {
+			b := testBill(n, 2026, "introduced")
+			b.SponsorID = sponsor.ID
+			mustUpsertBill(t, stores.Bills, b)
+		}
+		mustUpsertBill(t, stores.Bills, testBill("HB0003", 2026, "introduced"))
+
+		bills := mustListBills(t, stores.Bills, repository.BillFilters{})
+		for _, b := range bills[:2] {
+			if b.Sponsor == nil || b.Sponsor.LastName != "Smith" {
+				t.Errorf("%s Sponsor = %+v, want Smith", b.BillNumber, b.Sponsor)
+			}
+		}
+		if bills[2].Sponsor != nil || bills[2].SponsorID != "" {
+			t.Errorf("%s Sponsor = %+v, want none", bills[2].BillNumber, bills[2].Sponsor)
+		}
+
+		for _, b := range mustListBills(t, stores.Bills, repository.BillFilters{OmitSponsor: true}) {
+			if b.Sponsor != nil {
+				t.Errorf("OmitSponsor: %s Sponsor = %+v, want nil", b.BillNumber, b.Sponsor)
+			}
+		}
+		if got := mustListBills(t, stores.Bills, repository.BillFilters{OmitSponsor: true})[0]; got.SponsorID != sponsor.ID {
+			t.Errorf("OmitSponsor: SponsorID = %q, want %s", got.SponsorID, sponsor.ID)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := newStores(t).Bills
+		if b, err := repo.GetBill(ctx, "missing"); err != nil || b != nil {
+			t.Errorf("GetBill(missing) = %v, %v; want nil, nil", b, err)
+		}
+		if bills := mustListBills(t, repo, repository.BillFilters{}); len(bills) != 0 {
+			t.Errorf("empty store listed %d bills", len(bills))
+		}
+	})
+}
+
+// TestStatsRepository checks the repository.StatsRepository contract.
+func TestStatsRepository(t *testing.T, newStores Factory) {
+	ctx := context.Background()
+
+	t.Run("ReplaceAndGet", func(t *testing.T) {
+		stores := newStores(t)
+		if stores.Stats == nil {
+			t.Skip("store has no stats repository")
+		}
+		mustUpsertLegislator(t, stores.Legislators, utahLegislator("A", "house", 1, "A", "Alpha"))
+		mustUpsertLegislator(t, stores.Legislators, utahLegislator("B", "house", 2, "B", "Beta"))
+		ls := mustList(t, stores.Legislators, repository.LegislatorFilters{})
+		a, b := ls[0].ID, ls[1].ID
+
+		computed := date(2026, time.March, 10)
+		stats := func(session, year, cast int) []domain.LegislatorStats {
+			hash := fmt.Sprintf("hash-%d-%d", session, cast)
+			return []domain.LegislatorStats{
+				{LegislatorID: a, SessionID: session, SessionYear: year, VotesCast: cast, ComputedAt: computed, DatasetHash: hash,
+					Agreement: []domain.Agreement{{LegislatorID: b, SharedVotes: 4, Rate: 0.75}}},
+				{LegislatorID: b, SessionID: session, SessionYear: year, VotesCast: cast, ComputedAt: computed, DatasetHash: hash},
+			}
+		}
+		if hash, err := stores.Stats.SessionDatasetHash(ctx, 2); err != nil || hash != "" {
+			t.Errorf("SessionDatasetHash before any stats = %q, %v; want none", hash, err)
+		}
+		for _, s := range []struct{ session, year int }{{1, 2025}, {2, 2026}} {
+			if err := stores.Stats.ReplaceSessionStats(ctx, s.session, stats(s.session, s.year, 1)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// Replacing a session overwrites it rather than adding to it.
+		if err := stores.Stats.ReplaceSessionStats(ctx, 2, stats(2, 2026, 9)); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := stores.Stats.GetLegislatorStats(ctx, a, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 2 || got[0].SessionID != 2 || got[1].SessionID != 1 {
+			t.Fatalf("sessions = %+v, want 2 then 1", got)
+		}
+		if got[0].VotesCast != 9 {
+			t.Errorf("VotesCast = %d, want the replaced value 9", got[0].VotesCast)
+		}
+		if hash, err := stores.Stats.SessionDatasetHash(ctx, 2); err != nil || hash != "hash-2-9" || got[0].DatasetHash != hash {
+			t.Errorf("SessionDatasetHash = %q, %v; stats have %q; want hash-2-9", hash, err, got[0].DatasetHash)
+		}
+		if len(got[0].Agreement) != 1 || got[0].Agreement[0] != (domain.Agreement{LegislatorID: b, SharedVotes: 4, Rate: 0.75}) {
+			t.Errorf("Agreement = %+v", got[0].Agreement)
+		}
+
+		only2025, err := stores.Stats.GetLegislatorStats(ctx, a, 2025)
+		if err != nil || len(only2025) != 1 || only2025[0].SessionYear != 2025 {
+			t.Errorf("GetLegislatorStats(2025) = %+v, %v", only2025, err)
+		}
+		if none, err := stores.Stats.GetLegislatorStats(ctx, "missing", 0); err != nil || len(none) != 0 {
+			t.Errorf("GetLegislatorStats(missing) = %+v, %v; want none", none, err)
+		}
+	})
+}
+
+// TestSyncRepository checks the repository.SyncRepository contract.
+func TestSyncRepository(t *testing.T, newStores Factory) {
+	ctx := context.Background()
+
+	t.Run("BumpAndGet", func(t *testing.T) {
+		repo := newStores(t).Sync
+		if repo == nil {
+			t.Skip("store has no sync repository")
+		}
+
+		v, err := repo.GetSyncVersion(ctx, domain.EntityBills)
+		if err != nil || v.Version != 0 || !v.SyncedAt.IsZero() {
+			t.Fatalf("GetSyncVersion before bump = %+v, %v; want zero", v, err)
+		}
+
+		before := time.Now().Add(-time.Second)
+		for want := int64(1); want <= 2; want++ {
+			bumped, err := repo.BumpSyncVersion(ctx, domain.EntityBills)
+			if err != nil {
+				t.Fatalf("BumpSyncVersion: %v", err)
+			}
+			if bumped.Version != want || bumped.SyncedAt.Before(before) {
+				t.Errorf("BumpSyncVersion = %+v, want version %d", bumped, want)
+			}
+		}
+
+		v, err = repo.GetSyncVersion(ctx, domain.EntityBills)
+		if err != nil || v.Version != 2 || v.Entity != domain.EntityBills {
+			t.Errorf("GetSyncVersion = %+v, %v; want version 2", v, err)
+		}
+		other, err := repo.GetSyncVersion(ctx, domain.EntityLegislators)
+		if err != nil || other.Version != 0 {
+			t.Errorf("GetSyncVersion(legislators) = %+v, %v; want untouched", other, err)
+		}
+	})
+}
+
+// TestJobRunRepository checks the repository.JobRunRepository contract.
+func TestJobRunRepository(t *testing.T, newStores Factory) {
+	ctx := context.Background()
+
+	t.Run("SaveAndList", func(t *testing.T) {
+		repo := newStores(t).Runs
+		if repo == nil {
+			t.Skip("store has no job run repository")
+		}
+
+		start := time.Date(2026, time.February, 1, 12, 0, 0, 0, time.UTC)
+		var saved []domain.JobRun
+		for i, job := range []string{"legislators", "bills", "bills"} {
+			run := domain.JobRun{
+				Job:       job,
+				Trigger:   domain.TriggerSchedule,
+				Status:    domain.JobRunning,
+				StartedAt: start.Add(time.Duration(i) * time.Hour),
+			}
+			if err := repo.SaveJobRun(ctx, &run); err != nil {
+				t.Fatalf("SaveJobRun(%s): %v", job, err)
+			}
+			if run.ID == "" {
+				t.Fatalf("SaveJobRun(%s) left ID empty", job)
+			}
+			saved = append(saved, run)
+		}
+
+		finished := start.Add(90 * time.Minute)
+		done := saved[1]
+		done.Status, done.FinishedAt, done.Upserted, done.Failed, done.Error = domain.JobFailed, &finished, 10, 1, "boom"
+		if err := repo.SaveJobRun(ctx, &done); err != nil {
+			t.Fatalf("SaveJobRun(update): %v", err)
+		}
+
+		runs, err := repo.ListJobRuns(ctx, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(runs) != 3 || runs[0].ID != saved[2].ID || runs[2].ID != saved[0].ID {
+			t.Fatalf("ListJobRuns = %+v, want newest first", runs)
+		}
+		got := runs[1]
+		if got.Status != domain.JobFailed || got.Upserted != 10 || got.Failed != 1 || got.Error != "boom" ||
+			got.FinishedAt == nil || !got.FinishedAt.Equal(finished) || !got.StartedAt.Equal(saved[1].StartedAt) {
+			t.Errorf("updated run = %+v", got)
+		}
+
+		bills, err := repo.ListJobRuns(ctx, "bills", 1)
+		if err != nil || len(bills) != 1 || bills[0].ID != saved[2].ID {
+			t.Errorf("ListJobRuns(bills, 1) = %+v, %v; want latest bills run", bills, err)
+		}
+	})
+}
+
+// TestDeadLetterRepository checks the repository.DeadLetterRepository contract.
+func TestDeadLetterRepository(t *testing.T, newStores Factory) {
+	ctx := context.Background()
+
+	t.Run("RecordListResolve", func(t *testing.T) {
+		repo := newStores(t).DeadLetters
+		if repo == nil {
+			t.Skip("store has no dead letter repository")
+		}
+
+		record := func(kind, key, errMsg string) {
+			t.Helper()
+			err := repo.RecordDeadLetter(ctx, domain.DeadLetter{
+				Kind:    kind,
+				Source:  domain.SourceUtahLegislature,
+				Key:     key,
+				Payload: []byte(`{"key":"` + key + `"}`),
+				Error:   errMsg,
+			})
+			if err != nil {
+				t.Fatalf("RecordDeadLetter(%s %s): %v", kind, key, err)
+			}
+		}
+		record(domain.DeadLetterBill, "2026GS/HB0001", "first")
+		time.Sleep(10 * time.Millisecond) // order by first failure
+		record(domain.DeadLetterLegislator, "SMITHJ", "boom")
+		record(domain.DeadLetterBill, "2026GS/HB0001", "second")
+
+		all, err := repo.ListDeadLetters(ctx, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(all) != 2 || all[0].Key != "2026GS/HB0001" || all[1].Key != "SMITHJ" {
+			t.Fatalf("ListDeadLetters = %+v, want HB0001 then SMITHJ", all)
+		}
+		bill := all[0]
+		if bill.ID == "" || bill.Attempts != 2 || bill.Error != "second" || bill.Source != domain.SourceUtahLegislature {
+			t.Errorf("bill dead letter = %+v, want 2 attempts with the latest error", bill)
+		}
+		var payload map[string]string
+		if err := json.Unmarshal(bill.Payload, &payload); err != nil || payload["key"] != "2026GS/HB0001" {
+			t.Errorf("payload = %s, want the latest record", bill.Payload)
+		}
+		if bill.LastFailedAt.Before(bill.FirstFailedAt) || bill.FirstFailedAt.IsZero() {
+			t.Errorf("failure times = %v .. %v", bill.FirstFailedAt, bill.LastFailedAt)
+		}
+
+		legislators, err := repo.ListDeadLetters(ctx, domain.DeadLetterLegislator)
+		if err != nil || len(legislators) != 1 || legislators[0].Attempts != 1 {
+			t.Errorf("ListDeadLetters(legislator) = %+v, %v; want SMITHJ alone", legislators, err)
+		}
+
+		if err := repo.ResolveDeadLetter(ctx, domain.DeadLetterBill, "2026GS/HB0001"); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.ResolveDeadLetter(ctx, domain.DeadLetterBill, "2026GS/HB9999"); err != nil {
+			t.Errorf("ResolveDeadLetter of a missing key: %v", err)
+		}
+		bills, err := repo.ListDeadLetters(ctx, domain.DeadLetterBill)
+		if err != nil || len(bills) != 0 {
+			t.Errorf("ListDeadLetters(bill) after resolve = %+v, %v; want none", bills, err)
+		}
+	})
+}
+
+// TestWatchlistRepository checks the repository.WatchlistRepository contract.
+func TestWatchlistRepository(t *testing.T, newStores Factory) {
+	ctx := context.Background()
+
+	t.Run("FollowListUnfollow", func(t *testing.T) {
+		repo := newStores(t).Watchlist
+		if repo == nil {
+			t.Skip("store has no watchlist repository")
+		}
+
+		follow := func(user, kind, target string) domain.Follow {
+			t.Helper()
+			f, err := repo.Follow(ctx, domain.Follow{UserID: user, Kind: kind, TargetID: target})
+			if err != nil {
+				t.Fatalf("Follow(%s %s): %v", kind, target, err)
+			}
+			return f
+		}
+		first := follow(UserA, domain.FollowBill, "bill-1")
+		time.Sleep(10 * time.Millisecond) // order by follow time
+		follow(UserA, domain.FollowCodeTitle, "63G")
+		follow(UserB, domain.FollowBill, "bill-1")
+		again := follow(UserA, domain.FollowBill, "bill-1")
+
+		if first.ID == "" || first.CreatedAt.IsZero() || first.UserID != UserA {
+			t.Errorf("Follow = %+v", first)
+		}
+		if again.ID != first.ID || !again.CreatedAt.Equal(first.CreatedAt) {
+			t.Errorf("second Follow = %+v, want the first %+v", again, first)
+		}
+
+		all, err := repo.ListFollows(ctx, UserA, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(all) != 2 || all[0].TargetID != "63G" || all[1].TargetID != "bill-1" {
+			t.Fatalf("ListFollows = %+v, want 63G then bill-1", all)
+		}
+		bills, err := repo.ListFollows(ctx, UserA, domain.FollowBill)
+		if err != nil || len(bills) != 1 || bills[0].Kind != domain.FollowBill {
+			t.Errorf("ListFollows(bill) = %+v, %v; want bill-1 alone", bills, err)
+		}
+
+		if err := repo.Unfollow(ctx, UserA, domain.FollowBill, "bill-1"); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.Unfollow(ctx, UserA, domain.FollowBill, "bill-2"); err != nil {
+			t.Errorf("Unfollow of an item not followed: %v", err)
+		}
+		bills, err = repo.ListFollows(ctx, UserA, domain.FollowBill)
+		if err != nil || len(bills) != 0 {
+			t.Errorf("ListFollows(bill) after Unfollow = %+v, %v; want none", bills, err)
+		}
+		others, err := repo.ListFollows(ctx, UserB, "")
+		if err != nil || len(others) != 1 {
+			t.Errorf("other user's follows = %+v, %v; want bill-1", others, err)
+		}
+	})
+}
+
+// TestNotificationRepository checks the repository.NotificationRepository contract.
+func TestNotificationRepository(t *testing.T, newStores Factory) {
+	ctx := context.Background()
+
+	repoOrSkip := func(t *testing.T) repository.NotificationRepository {
+		repo := newStores(t).Notifications
+		if repo == nil {
+			t.Skip("store has no notification repository")
+		}
+		return repo
+	}
+
+	t.Run("Subscriptions", func(t *testing.T) {
+		repo := repoOrSkip(t)
+		save := func(s domain.NotificationSubscription) domain.NotificationSubscription {
+			t.Helper()
+			saved, err := repo.SaveSubscription(ctx, s)
+			if err != nil {
+				t.Fatalf("SaveSubscription: %v", err)
+			}
+			return saved
+		}
+		first := save(domain.NotificationSubscription{
+			UserID:     UserA,
+			EventTypes: []string{domain.EventBillStatusChanged},
+			BillID:     "bill-1",
+			Channels:   []string{domain.ChannelEmail, domain.ChannelFCM},
+		})
+		time.Sleep(10 * time.Millisecond) // order by creation
+		second := save(domain.NotificationSubscription{UserID: UserA, Followed: true})
+		save(domain.NotificationSubscription{UserID: UserB, SponsorID: "legislator-1"})
+
+		if first.ID == "" || first.CreatedAt.IsZero() {
+			t.Errorf("SaveSubscription = %+v, want ID and CreatedAt set", first)
+		}
+		mine, err := repo.ListSubscriptions(ctx, UserA)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(mine) != 2 || mine[0].ID != first.ID || mine[1].ID != second.ID {
+			t.Fatalf("ListSubscriptions(A) = %+v, want the two saved, oldest first", mine)
+		}
+		got := mine[0]
+		if got.BillID != "bill-1" || fmt.Sprint(got.EventTypes) != "[bill.status_changed]" ||
+			fmt.Sprint(got.Channels) != "[email fcm]" || got.Followed {
+			t.Errorf("stored subscription = %+v", got)
+		}
+		if !mine[1].Followed || len(mine[1].EventTypes) != 0 || len(mine[1].Channels) != 0 {
+			t.Errorf("stored followed subscription = %+v", mine[1])
+		}
+		all, err := repo.ListSubscriptions(ctx, "")
+		if err != nil || len(all) != 3 {
+			t.Errorf("ListSubscriptions(all) = %d, %v; want 3", len(all), err)
+		}
+
+		// Users can only delete their own.
+		if err := repo.DeleteSubscription(ctx, UserB, first.ID); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.DeleteSubscription(ctx, UserA, first.ID); err != nil {
+			t.Fatal(err)
+		}
+		if mine, _ = repo.ListSubscriptions(ctx, UserA); len(mine) != 1 || mine[0].ID != second.ID {
+			t.Errorf("after delete = %+v, want the followed subscription", mine)
+		}
+	})
+
+	t.Run("Settings", func(t *testing.T) {
+		repo := repoOrSkip(t)
+		got, err := repo.GetNotificationSettings(ctx, UserA)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != domain.DefaultNotificationSettings(UserA) {
+			t.Errorf("unsaved settings = %+v, want defaults", got)
+		}
+
+		last := time.Date(2026, time.March, 1, 8, 0, 0, 0, time.UTC)
+		want := domain.NotificationSettings{
+			UserID:         UserA,
+			Enabled:        true,
+			Email:          "a@example.com",
+			TimeZone:       "America/Denver",
+			QuietStart:     22 * 60,
+			QuietEnd:       7 * 60,
+			DigestInterval: time.Hour,
+			LastDigestAt:   &last,
+		}
+		if err := repo.SaveNotificationSettings(ctx, want); err != nil {
+			t.Fatal(err)
+		}
+		got, err = repo.GetNotificationSettings(ctx, UserA)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.LastDigestAt == nil || !got.LastDigestAt.Equal(last) {
+			t.Errorf("LastDigestAt = %v, want %v", got.LastDigestAt, last)
+		}
+		got.LastDigestAt, want.LastDigestAt = nil, nil
+		if got != want {
+			t.Errorf("settings = %+v, want %+v", got, want)
+		}
+
+		off := domain.NotificationSettings{UserID: UserA}
+		if err := repo.SaveNotificationSettings(ctx, off); err != nil {
+			t.Fatal(err)
+		}
+		if got, _ = repo.GetNotificationSettings(ctx, UserA); got != off {
+			t.Errorf("settings after disabling = %+v, want %+v", got, off)
+		}
+	})
+
+	t.Run("Devices", func(t *testing.T) {
+		repo := repoOrSkip(t)
+		push := domain.Device{UserID: UserA, Channel: domain.ChannelWebPush, Token: "https://push.example/1", P256dh: "key", Auth: "secret"}
+		saved, err := repo.SaveDevice(ctx, push)
+		if err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+		if _, err := repo.SaveDevice(ctx, domain.Device{UserID: UserA, Channel: domain.ChannelFCM, Token: "fcm-1"}); err != nil {
+			t.Fatal(err)
+		}
+
+		// Re-registering updates the device in place.
+		push.Auth = "new-secret"
+		again, err := repo.SaveDevice(ctx, push)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again.ID != saved.ID {
+			t.Errorf("re-registered device ID = %s, want %s", again.ID, saved.ID)
+		}
+		devices, err := repo.ListDevices(ctx, UserA)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(devices) != 2 || devices[0].Token != push.Token || devices[0].Auth != "new-secret" || devices[0].P256dh != "key" ||
+			devices[1].Channel != domain.ChannelFCM {
+			t.Fatalf("ListDevices = %+v", devices)
+		}
+
+		// A token moves to whoever registers it last.
+		if _, err := repo.SaveDevice(ctx, domain.Device{UserID: UserB, Channel: domain.ChannelFCM, Token: "fcm-1"}); err != nil {
+			t.Fatal(err)
+		}
+		if devices, _ = repo.ListDevices(ctx, UserA); len(devices) != 1 {
+			t.Errorf("user A devices after B took fcm-1 = %+v", devices)
+		}
+
+		if err := repo.DeleteDevice(ctx, UserA, domain.ChannelFCM, "fcm-1"); err != nil {
+			t.Fatal(err)
+		}
+		if devices, _ = repo.ListDevices(ctx, UserB); len(devices) != 1 {
+			t.Errorf("user A deleted user B's device: %+v", devices)
+		}
+		if err := repo.DeleteDevice(ctx, UserB, domain.ChannelFCM, "fcm-1"); err != nil {
+			t.Fatal(err)
+		}
+		if devices, _ = repo.ListDevices(ctx, UserB); len(devices) != 0 {
+			t.Errorf("devices after delete = %+v", devices)
+		}
+	})
+
+	t.Run("Outbox", func(t *testing.T) {
+		repo := repoOrSkip(t)
+		err := repo.EnqueueNotifications(ctx, []domain.Notification{
+			{UserID: UserA, EventType: domain.EventBillStatusChanged, BillID: "bill-1", Title: "HB1", Body: "passed", Channels: []string{domain.ChannelEmail}},
+			{UserID: UserB, EventType: domain.EventBillAction, Title: "HB2", Body: "read"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+		if err := repo.EnqueueNotifications(ctx, []domain.Notification{{UserID: UserA, EventType: domain.EventBillAction, Title: "HB3", Body: "read"}}); err != nil {
+			t.Fatal(err)
+		}
+
+		pending, err := repo.PendingNotifications(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(pending) != 3 || pending[0].Title != "HB1" || pending[1].Title != "HB2" || pending[2].Title != "HB3" {
+			t.Fatalf("PendingNotifications = %+v, want HB1, HB2, HB3", pending)
+		}
+		n := pending[0]
+		if n.ID == "" || n.CreatedAt.IsZero() || n.UserID != UserA || n.BillID != "bill-1" || n.Body != "passed" ||
+			fmt.Sprint(n.Channels) != "[email]" {
+			t.Errorf("pending notification = %+v", n)
+		}
+
+		if err := repo.MarkNotificationsSent(ctx, []string{pending[0].ID, pending[2].ID}, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.MarkNotificationsSent(ctx, nil, time.Now()); err != nil {
+			t.Errorf("MarkNotificationsSent(nil): %v", err)
+		}
+		pending, err = repo.PendingNotifications(ctx)
+		if err != nil || len(pending) != 1 || pending[0].Title != "HB2" {
+			t.Errorf("PendingNotifications after sending = %+v, %v; want HB2", pending, err)
+		}
+	})
+}
+
+// TestWebhookRepository runs the WebhookRepository conformance suite.
+func TestWebhookRepository(t *testing.T, newStores Factory) {
+	ctx := context.Background()
+
+	repoOrSkip := func(t *testing.T) repository.WebhookRepository {
+		repo := newStores(t).Webhooks
+		if repo == nil {
+			t.Skip("store has no webhook repository")
+		}
+		return repo
+	}
+	create := func(t *testing.T, repo repository.WebhookRepository, w domain.Webhook) domain.Webhook {
+		t.Helper()
+		created, err := repo.CreateWebhook(ctx, w)
+		if err != nil {
+			t.Fatalf("CreateWebhook: %v", err)
+		}
+		return created
+	}
+
+	t.Run("Webhooks", func(t *testing.T) {
+		repo := repoOrSkip(t)
+		first := create(t, repo, domain.Webhook{
+			OwnerID:    UserA,
+			URL:        "https://hooks.example.org/a",
+			Secret:     "secret-a",
+			EventTypes: []string{domain.EventBillStatusChanged, domain.EventBillNewVersion},
+			BillID:     "bill-1",
+			Keywords:   []string{"water", "tax"},
+		})
+		time.Sleep(10 * time.Millisecond) // order by creation
+		second := create(t, repo, domain.Webhook{OwnerID: UserA, URL: "https://hooks.example.org/b", Secret: "secret-b", SponsorID: "legislator-1"})
+		create(t, repo, domain.Webhook{OwnerID: UserB, URL: "https://hooks.example.org/c", Secret: "secret-c"})
+
+		if first.ID == "" || first.CreatedAt.IsZero() {
+			t.Errorf("CreateWebhook = %+v, want ID and CreatedAt set", first)
+		}
+		mine, err := repo.ListWebhooks(ctx, UserA)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(mine) != 2 || mine[0].ID != first.ID || mine[1].ID != second.ID {
+			t.Fatalf("ListWebhooks(A) = %+v, want the two created, oldest first", mine)
+		}
+		got, err := repo.GetWebhook(ctx, first.ID)
+		if err != nil || got == nil {
+			t.Fatalf("GetWebhook = %v, %v", got, err)
+		}
+		if got.URL != "https://hooks.example.org/a" || got.Secret != "secret-a" || got.BillID != "bill-1" ||
+			fmt.Sprint(got.EventTypes) != "[bill.status_changed bill.new_version]" || fmt.Sprint(got.Keywords) != "[water tax]" {
+			t.Errorf("stored webhook = %+v", got)
+		}
+		if mine[1].SponsorID != "legislator-1" || len(mine[1].EventTypes) != 0 || len(mine[1].Keywords) != 0 {
+			t.Errorf("stored sponsor webhook = %+v", mine[1])
+		}
+		if all, err := repo.ListWebhooks(ctx, ""); err != nil || len(all) != 3 {
+			t.Errorf("ListWebhooks(all) = %d, %v; want 3", len(all), err)
+		}
+		if missing, err := repo.GetWebhook(ctx, "00000000-0000-4000-8000-000000000000"); err != nil || missing != nil {
+			t.Errorf("GetWebhook(missing) = %v, %v; want nil, nil", missing, err)
+		}
+
+		// Owners can only delete their own.
+		if err := repo.DeleteWebhook(ctx, UserB, first.ID); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.DeleteWebhook(ctx, UserA, first.ID); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.DeleteWebhook(ctx, UserA, "00000000-0000-4000-8000-000000000000"); err != nil {
+			t.Errorf("DeleteWebhook(missing): %v", err)
+		}
+		if mine, _ = repo.ListWebhooks(ctx, UserA); len(mine) != 1 || mine[0].ID != second.ID {
+			t.Errorf("webhooks after delete = %+v", mine)
+		}
+	})
+
+	t.Run("Deliveries", func(t *testing.T) {
+		repo := repoOrSkip(t)
+		hook := create(t, repo, domain.Webhook{OwnerID: UserA, URL: "https://hooks.example.org/a", Secret: "s"})
+		other := create(t, repo, domain.Webhook{OwnerID: UserB, URL: "https://hooks.example.org/b", Secret: "s"})
+
+		now := time.Now().UTC().Truncate(time.Second)
+		payload := []byte(`{"id":"evt-1","type":"bill.status_changed","data":{"previous":"introduced"}}`)
+		queued, err := repo.EnqueueDeliveries(ctx, []domain.WebhookDelivery{
+			{WebhookID: hook.ID, EventID: "evt-1", EventType: domain.EventBillStatusChanged, Payload: payload, Status: domain.DeliveryPending, NextAttemptAt: now},
+			{WebhookID: other.ID, EventID: "evt-1", EventType: domain.EventBillStatusChanged, Payload: payload, Status: domain.DeliveryPending, NextAttemptAt: now},
+			{WebhookID: hook.ID, EventID: "evt-2", EventType: domain.EventBillAction, Payload: []byte(`{}`), Status: domain.DeliveryPending, NextAttemptAt: now.Add(time.Hour)},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(queued) != 3 || queued[0].ID == "" || queued[0].CreatedAt.IsZero() || queued[2].EventID != "evt-2" {
+			t.Fatalf("EnqueueDeliveries = %+v, want the three with IDs set", queued)
+		}
+
+		due, err := repo.DueDeliveries(ctx, now, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(due) != 2 || due[0].ID != queued[0].ID || due[1].ID != queued[1].ID {
+			t.Fatalf("DueDeliveries = %+v, want the two due now, oldest first", due)
+		}
+		d := due[0]
+		if d.ID == "" || d.CreatedAt.IsZero() || d.EventID != "evt-1" || string(d.Payload) != string(payload) ||
+			d.Attempts != 0 || d.LastAttemptAt != nil || !d.NextAttemptAt.Equal(now) {
+			t.Errorf("due delivery = %+v", d)
+		}
+		if limited, err := repo.DueDeliveries(ctx, now, 1); err != nil || len(limited) != 1 || limited[0].ID != d.ID {
+			t.Errorf("DueDeliveries(limit 1) = %+v, %v", limited, err)
+		}
+
+		// A failed attempt is retried later; a succeeded one is done.
+		d.Attempts, d.ResponseStatus, d.LastError = 1, 500, "server error"
+		d.NextAttemptAt, d.LastAttemptAt = now.Add(time.Minute), &now
+		if err := repo.UpdateDelivery(ctx, d); err != nil {
+			t.Fatal(err)
+		}
+		done := due[1]
+		done.Status, done.Attempts, done.ResponseStatus, done.LastAttemptAt = domain.DeliverySucceeded, 1, 204, &now
+		if err := repo.UpdateDelivery(ctx, done); err != nil {
+			t.Fatal(err)
+		}
+		if due, _ = repo.DueDeliveries(ctx, now, 0); len(due) != 0 {
+			t.Errorf("DueDeliveries after attempts = %+v, want none", due)
+		}
+		if due, _ = repo.DueDeliveries(ctx, now.Add(time.Minute), 0); len(due) != 1 || due[0].ID != d.ID {
+			t.Errorf("DueDeliveries a minute later = %+v, want the retry", due)
+		}
+		got, err := repo.GetDelivery(ctx, d.ID)
+		if err != nil || got == nil {
+			t.Fatalf("GetDelivery = %v, %v", got, err)
+		}
+		if got.Status != domain.DeliveryPending || got.Attempts != 1 || got.ResponseStatus != 500 || got.LastError != "server error" ||
+			got.LastAttemptAt == nil || !got.LastAttemptAt.Equal(now) {
+			t.Errorf("delivery after attempt = %+v", got)
+		}
+
+		// Replays are new deliveries linked to the original.
+		time.Sleep(10 * time.Millisecond)
+		replay, err := repo.EnqueueDeliveries(ctx, []domain.WebhookDelivery{{
+			WebhookID: hook.ID, EventID: d.EventID, EventType: d.EventType, Payload: d.Payload,
+			Status: domain.DeliveryPending, NextAttemptAt: now, ReplayOf: d.ID,
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		log, err := repo.ListDeliveries(ctx, hook.ID, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(log) != 3 || log[0].ID != replay[0].ID || log[0].ReplayOf != d.ID || log[2].ID != d.ID {
+			t.Fatalf("ListDeliveries = %+v, want the replay first and the original last", log)
+		}
+		if log, _ = repo.ListDeliveries(ctx, hook.ID, 1); len(log) != 1 || log[0].ReplayOf != d.ID {
+			t.Errorf("ListDeliveries(limit 1) = %+v", log)
+		}
+
+		// Deleting a webhook deletes its log.
+		if err := repo.DeleteWebhook(ctx, UserA, hook.ID); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := repo.GetDelivery(ctx, d.ID); err != nil || got != nil {
+			t.Errorf("GetDelivery after deleting the webhook = %+v, %v; want nil", got, err)
+		}
+		if log, _ = repo.ListDeliveries(ctx, other.ID, 0); len(log) != 1 {
+			t.Errorf("other webhook's deliveries = %+v", log)
+		}
+	})
+}
+
+func TestAPIKeyRepository(t *testing.T, newStores Factory) {
+	ctx := context.Background()
+	repo := newStores(t).APIKeys
+	if repo == nil {
+		t.Skip("store has no api key repository")
+	}
+	create := func(k domain.APIKey) domain.APIKey {
+		t.Helper()
+		created, err := repo.CreateAPIKey(ctx, k)
+		if err != nil {
+			t.Fatalf("CreateAPIKey: %v", err)
+		}
+		return created
+	}
+
+	first := create(domain.APIKey{Name: "Civic App", Prefix: "uck_abcd", Hash: "hash-1", RatePerMinute: 600, Burst: 100})
+	time.Sleep(10 * time.Millisecond) // order by creation
+	second := create(domain.APIKey{Name: "Newsroom", Prefix: "uck_efgh", Hash: "hash-2"})
+	if first.ID == "" || first.CreatedAt.IsZero() {
+		t.Errorf("CreateAPIKey = %+v, want ID and CreatedAt set", first)
+	}
+
+	keys, err := repo.ListAPIKeys(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0].ID != first.ID || keys[1].ID != second.ID {
+		t.Fatalf("ListAPIKeys = %+v, want the two created, oldest first", keys)
+	}
+	got, err := repo.GetAPIKeyByHash(ctx, "hash-1")
+	if err != nil || got == nil {
+		t.Fatalf("GetAPIKeyByHash = %v, %v", got, err)
+	}
+	if got.ID != first.ID || got.Name != "Civic App" || got.Prefix != "uck_abcd" || got.RatePerMinute != 600 ||
+		got.Burst != 100 || got.Revoked || got.Requests != 0 || got.LastUsedAt != nil {
+		t.Errorf("stored key = %+v", got)
+	}
+	if missing, err := repo.GetAPIKeyByHash(ctx, "hash-3"); err != nil || missing != nil {
+		t.Errorf("GetAPIKeyByHash(missing) = %v, %v; want nil, nil", missing, err)
+	}
+	if missing, err := repo.GetAPIKey(ctx, "00000000-0000-4000-8000-000000000000"); err != nil || missing != nil {
+		t.Errorf("GetAPIKey(missing) = %v, %v; want nil, nil", missing, err)
+	}
+
+	// Updates change the settings, not the key or its usage.
+	if err := repo.UpdateAPIKey(ctx, domain.APIKey{ID: first.ID, Name: "Civic App v2", Hash: "other", Burst: 10, Revoked: true}); err != nil {
+		t.Fatal(err)
+	}
+	got, _ = repo.GetAPIKey(ctx, first.ID)
+	if got == nil || got.Name != "Civic App v2" || got.RatePerMinute != 0 || got.Burst != 10 || !got.Revoked || got.Hash != "hash-1" {
+		t.Errorf("updated key = %+v", got)
+	}
+
+	// Usage adds up; LastUsedAt only moves forward.
+	at := time.Now().UTC().Truncate(time.Second)
+	for _, u := range []struct {
+		requests, throttled int64
+		at                  time.Time
+	}{{10, 2, at}, {5, 0, at.Add(-time.Minute)}} {
+		if err := repo.AddAPIKeyUsage(ctx, second.ID, u.requests, u.throttled, u.at); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := repo.AddAPIKeyUsage(ctx, "00000000-0000-4000-8000-000000000000", 1, 0, at); err != nil {
+		t.Errorf("AddAPIKeyUsage(missing): %v", err)
+	}
+	got, _ = repo.GetAPIKey(ctx, second.ID)
+	if got == nil || got.Requests != 15 || got.Throttled != 2 || got.LastUsedAt == nil || !got.LastUsedAt.Equal(at) {
+		t.Errorf("key after usage = %+v", got)
+	}
+
+	if err := repo.DeleteAPIKey(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.DeleteAPIKey(ctx, "00000000-0000-4000-8000-000000000000"); err != nil {
+		t.Errorf("DeleteAPIKey(missing): %v", err)
+	}
+	if keys, _ = repo.ListAPIKeys(ctx); len(keys) != 1 || keys[0].ID != second.ID {
+		t.Errorf("keys after delete = %+v", keys)
+	}
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"api/internal/domain"
+)
+
+// StatsRepository stores precomputed legislator voting statistics. Stats are
+// written in bulk per session by the stats job and only read by the API.
+type StatsRepository interface {
+	// GetLegislatorStats returns a legislator's stats, newest session first.
+	// A non-zero sessionYear limits the result to sessions in that year.
+	GetLegislatorStats(ctx context.Context, legislatorID string, sessionYear int) ([]domain.LegislatorStats, error)
+	// SessionDatasetHash returns the DatasetHash of a session's stored stats,
+	// or "" if it has none.
+	SessionDatasetHash(ctx context.Context, sessionID int) (string, error)
+	// ReplaceSessionStats atomically replaces every stored stat for a session.
+	ReplaceSessionStats(ctx context.Context, sessionID int, stats []domain.LegislatorStats) error
+}
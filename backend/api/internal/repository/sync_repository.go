@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"api/internal/domain"
+)
+
+// SyncRepository stores a SyncVersion per entity type. It is shared by
+// every process using the same store, so a job running elsewhere can signal
+// the API that its caches are stale.
+type SyncRepository interface {
+	// GetSyncVersion returns the current marker for entity, or one with a
+	// zero Version if it has never been bumped.
+	GetSyncVersion(ctx context.Context, entity string) (domain.SyncVersion, error)
+	// BumpSyncVersion increments entity's version and sets SyncedAt to now.
+	BumpSyncVersion(ctx context.Context, entity string) (domain.SyncVersion, error)
+}
@@ -0,0 +1,62 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// APIKeyRepository traces the calls to a repository.APIKeyRepository.
+type APIKeyRepository struct {
+	inner  repository.APIKeyRepository
+	system string
+}
+
+// NewAPIKeyRepository wraps inner, whose store is system.
+func NewAPIKeyRepository(inner repository.APIKeyRepository, system string) *APIKeyRepository {
+	return &APIKeyRepository{inner: inner, system: system}
+}
+
+func (r *APIKeyRepository) CreateAPIKey(ctx context.Context, k domain.APIKey) (domain.APIKey, error) {
+	return call(ctx, r.system, "APIKeyRepository.CreateAPIKey", func(ctx context.Context) (domain.APIKey, error) {
+		return r.inner.CreateAPIKey(ctx, k)
+	})
+}
+
+func (r *APIKeyRepository) GetAPIKey(ctx context.Context, id string) (*domain.APIKey, error) {
+	return call(ctx, r.system, "APIKeyRepository.GetAPIKey", func(ctx context.Context) (*domain.APIKey, error) {
+		return r.inner.GetAPIKey(ctx, id)
+	})
+}
+
+func (r *APIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	return call(ctx, r.system, "APIKeyRepository.GetAPIKeyByHash", func(ctx context.Context) (*domain.APIKey, error) {
+		return r.inner.GetAPIKeyByHash(ctx, hash)
+	})
+}
+
+func (r *APIKeyRepository) ListAPIKeys(ctx context.Context) ([]domain.APIKey, error) {
+	return call(ctx, r.system, "APIKeyRepository.ListAPIKeys", func(ctx context.Context) ([]domain.APIKey, error) {
+		return r.inner.ListAPIKeys(ctx)
+	})
+}
+
+func (r *APIKeyRepository) UpdateAPIKey(ctx context.Context, k domain.APIKey) error {
+	return exec(ctx, r.system, "APIKeyRepository.UpdateAPIKey", func(ctx context.Context) error {
+		return r.inner.UpdateAPIKey(ctx, k)
+	})
+}
+
+func (r *APIKeyRepository) DeleteAPIKey(ctx context.Context, id string) error {
+	return exec(ctx, r.system, "APIKeyRepository.DeleteAPIKey", func(ctx context.Context) error {
+		return r.inner.DeleteAPIKey(ctx, id)
+	})
+}
+
+func (r *APIKeyRepository) AddAPIKeyUsage(ctx context.Context, id string, requests, throttled int64, at time.Time) error {
+	return exec(ctx, r.system, "APIKeyRepository.AddAPIKeyUsage", func(ctx context.Context) error {
+		return r.inner.AddAPIKeyUsage(ctx, id, requests, throttled, at)
+	})
+}
@@ -0,0 +1,55 @@
+package tracing
+
+import (
+	"context"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// BillRepository traces the calls to a repository.BillRepository.
+type BillRepository struct {
+	inner  repository.BillRepository
+	system string
+}
+
+// NewBillRepository wraps inner, whose store is system.
+func NewBillRepository(inner repository.BillRepository, system string) *BillRepository {
+	return &BillRepository{inner: inner, system: system}
+}
+
+func (r *BillRepository) ListBills(ctx context.Context, filters repository.BillFilters) ([]domain.Bill, error) {
+	return call(ctx, r.system, "BillRepository.ListBills", func(ctx context.Context) ([]domain.Bill, error) {
+		return r.inner.ListBills(ctx, filters)
+	})
+}
+
+func (r *BillRepository) GetBill(ctx context.Context, id string) (*domain.Bill, error) {
+	return call(ctx, r.system, "BillRepository.GetBill", func(ctx context.Context) (*domain.Bill, error) {
+		return r.inner.GetBill(ctx, id)
+	})
+}
+
+func (r *BillRepository) UpsertBill(ctx context.Context, bill domain.Bill) error {
+	return exec(ctx, r.system, "BillRepository.UpsertBill", func(ctx context.Context) error {
+		return r.inner.UpsertBill(ctx, bill)
+	})
+}
+
+func (r *BillRepository) UpsertBills(ctx context.Context, bills []domain.Bill) error {
+	return exec(ctx, r.system, "BillRepository.UpsertBills", func(ctx context.Context) error {
+		return r.inner.UpsertBills(ctx, bills)
+	})
+}
+
+func (r *BillRepository) LinkSponsors(ctx context.Context) (int, error) {
+	return call(ctx, r.system, "BillRepository.LinkSponsors", func(ctx context.Context) (int, error) {
+		return r.inner.LinkSponsors(ctx)
+	})
+}
+
+func (r *BillRepository) UnresolvedSponsors(ctx context.Context) ([]domain.UnresolvedSponsor, error) {
+	return call(ctx, r.system, "BillRepository.UnresolvedSponsors", func(ctx context.Context) ([]domain.UnresolvedSponsor, error) {
+		return r.inner.UnresolvedSponsors(ctx)
+	})
+}
@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"context"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// DeadLetterRepository traces the calls to a repository.DeadLetterRepository.
+type DeadLetterRepository struct {
+	inner  repository.DeadLetterRepository
+	system string
+}
+
+// NewDeadLetterRepository wraps inner, whose store is system.
+func NewDeadLetterRepository(inner repository.DeadLetterRepository, system string) *DeadLetterRepository {
+	return &DeadLetterRepository{inner: inner, system: system}
+}
+
+func (r *DeadLetterRepository) RecordDeadLetter(ctx context.Context, dl domain.DeadLetter) error {
+	return exec(ctx, r.system, "DeadLetterRepository.RecordDeadLetter", func(ctx context.Context) error {
+		return r.inner.RecordDeadLetter(ctx, dl)
+	})
+}
+
+func (r *DeadLetterRepository) ListDeadLetters(ctx context.Context, kind string) ([]domain.DeadLetter, error) {
+	return call(ctx, r.system, "DeadLetterRepository.ListDeadLetters", func(ctx context.Context) ([]domain.DeadLetter, error) {
+		return r.inner.ListDeadLetters(ctx, kind)
+	})
+}
+
+func (r *DeadLetterRepository) ResolveDeadLetter(ctx context.Context, kind, key string) error {
+	return exec(ctx, r.system, "DeadLetterRepository.ResolveDeadLetter", func(ctx context.Context) error {
+		return r.inner.ResolveDeadLetter(ctx, kind, key)
+	})
+}
@@ -0,0 +1,31 @@
+package tracing
+
+import (
+	"context"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// JobRunRepository traces the calls to a repository.JobRunRepository.
+type JobRunRepository struct {
+	inner  repository.JobRunRepository
+	system string
+}
+
+// NewJobRunRepository wraps inner, whose store is system.
+func NewJobRunRepository(inner repository.JobRunRepository, system string) *JobRunRepository {
+	return &JobRunRepository{inner: inner, system: system}
+}
+
+func (r *JobRunRepository) SaveJobRun(ctx context.Context, run *domain.JobRun) error {
+	return exec(ctx, r.system, "JobRunRepository.SaveJobRun", func(ctx context.Context) error {
+		return r.inner.SaveJobRun(ctx, run)
+	})
+}
+
+func (r *JobRunRepository) ListJobRuns(ctx context.Context, job string, limit int) ([]domain.JobRun, error) {
+	return call(ctx, r.system, "JobRunRepository.ListJobRuns", func(ctx context.Context) ([]domain.JobRun, error) {
+		return r.inner.ListJobRuns(ctx, job, limit)
+	})
+}
@@ -0,0 +1,61 @@
+package tracing
+
+import (
+	"context"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// LegislatorRepository traces the calls to a repository.LegislatorRepository.
+type LegislatorRepository struct {
+	inner  repository.LegislatorRepository
+	system string
+}
+
+// NewLegislatorRepository wraps inner, whose store is system.
+func NewLegislatorRepository(inner repository.LegislatorRepository, system string) *LegislatorRepository {
+	return &LegislatorRepository{inner: inner, system: system}
+}
+
+func (r *LegislatorRepository) ListLegislators(ctx context.Context, filters repository.LegislatorFilters) ([]domain.Legislator, error) {
+	return call(ctx, r.system, "LegislatorRepository.ListLegislators", func(ctx context.Context) ([]domain.Legislator, error) {
+		return r.inner.ListLegislators(ctx, filters)
+	})
+}
+
+func (r *LegislatorRepository) GetLegislator(ctx context.Context, id string) (*domain.Legislator, error) {
+	return call(ctx, r.system, "LegislatorRepository.GetLegislator", func(ctx context.Context) (*domain.Legislator, error) {
+		return r.inner.GetLegislator(ctx, id)
+	})
+}
+
+func (r *LegislatorRepository) GetLegislatorByDistrict(ctx context.Context, chamber string, districtNumber int) (*domain.Legislator, error) {
+	return call(ctx, r.system, "LegislatorRepository.GetLegislatorByDistrict", func(ctx context.Context) (*domain.Legislator, error) {
+		return r.inner.GetLegislatorByDistrict(ctx, chamber, districtNumber)
+	})
+}
+
+func (r *LegislatorRepository) ListTerms(ctx context.Context, legislatorID string) ([]domain.Term, error) {
+	return call(ctx, r.system, "LegislatorRepository.ListTerms", func(ctx context.Context) ([]domain.Term, error) {
+		return r.inner.ListTerms(ctx, legislatorID)
+	})
+}
+
+func (r *LegislatorRepository) UpsertLegislator(ctx context.Context, legislator domain.Legislator) error {
+	return exec(ctx, r.system, "LegislatorRepository.UpsertLegislator", func(ctx context.Context) error {
+		return r.inner.UpsertLegislator(ctx, legislator)
+	})
+}
+
+func (r *LegislatorRepository) UpsertLegislators(ctx context.Context, legislators []domain.Legislator) error {
+	return exec(ctx, r.system, "LegislatorRepository.UpsertLegislators", func(ctx context.Context) error {
+		return r.inner.UpsertLegislators(ctx, legislators)
+	})
+}
+
+func (r *LegislatorRepository) RetireLegislators(ctx context.Context, keep []string) (int, error) {
+	return call(ctx, r.system, "LegislatorRepository.RetireLegislators", func(ctx context.Context) (int, error) {
+		return r.inner.RetireLegislators(ctx, keep)
+	})
+}
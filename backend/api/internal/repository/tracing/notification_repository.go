@@ -0,0 +1,86 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// NotificationRepository traces the calls to a repository.NotificationRepository.
+type NotificationRepository struct {
+	inner  repository.NotificationRepository
+	system string
+}
+
+// NewNotificationRepository wraps inner, whose store is system.
+func NewNotificationRepository(inner repository.NotificationRepository, system string) *NotificationRepository {
+	return &NotificationRepository{inner: inner, system: system}
+}
+
+func (r *NotificationRepository) SaveSubscription(ctx context.Context, s domain.NotificationSubscription) (domain.NotificationSubscription, error) {
+	return call(ctx, r.system, "NotificationRepository.SaveSubscription", func(ctx context.Context) (domain.NotificationSubscription, error) {
+		return r.inner.SaveSubscription(ctx, s)
+	})
+}
+
+func (r *NotificationRepository) DeleteSubscription(ctx context.Context, userID, id string) error {
+	return exec(ctx, r.system, "NotificationRepository.DeleteSubscription", func(ctx context.Context) error {
+		return r.inner.DeleteSubscription(ctx, userID, id)
+	})
+}
+
+func (r *NotificationRepository) ListSubscriptions(ctx context.Context, userID string) ([]domain.NotificationSubscription, error) {
+	return call(ctx, r.system, "NotificationRepository.ListSubscriptions", func(ctx context.Context) ([]domain.NotificationSubscription, error) {
+		return r.inner.ListSubscriptions(ctx, userID)
+	})
+}
+
+func (r *NotificationRepository) GetNotificationSettings(ctx context.Context, userID string) (domain.NotificationSettings, error) {
+	return call(ctx, r.system, "NotificationRepository.GetNotificationSettings", func(ctx context.Context) (domain.NotificationSettings, error) {
+		return r.inner.GetNotificationSettings(ctx, userID)
+	})
+}
+
+func (r *NotificationRepository) SaveNotificationSettings(ctx context.Context, s domain.NotificationSettings) error {
+	return exec(ctx, r.system, "NotificationRepository.SaveNotificationSettings", func(ctx context.Context) error {
+		return r.inner.SaveNotificationSettings(ctx, s)
+	})
+}
+
+func (r *NotificationRepository) SaveDevice(ctx context.Context, d domain.Device) (domain.Device, error) {
+	return call(ctx, r.system, "NotificationRepository.SaveDevice", func(ctx context.Context) (domain.Device, error) {
+		return r.inner.SaveDevice(ctx, d)
+	})
+}
+
+func (r *NotificationRepository) DeleteDevice(ctx context.Context, userID, channel, token string) error {
+	return exec(ctx, r.system, "NotificationRepository.DeleteDevice", func(ctx context.Context) error {
+		return r.inner.DeleteDevice(ctx, userID, channel, token)
+	})
+}
+
+func (r *NotificationRepository) ListDevices(ctx context.Context, userID string) ([]domain.Device, error) {
+	return call(ctx, r.system, "NotificationRepository.ListDevices", func(ctx context.Context) ([]domain.Device, error) {
+		return r.inner.ListDevices(ctx, userID)
+	})
+}
+
+func (r *NotificationRepository) EnqueueNotifications(ctx context.Context, ns []domain.Notification) error {
+	return exec(ctx, r.system, "NotificationRepository.EnqueueNotifications", func(ctx context.Context) error {
+		return r.inner.EnqueueNotifications(ctx, ns)
+	})
+}
+
+func (r *NotificationRepository) PendingNotifications(ctx context.Context) ([]domain.Notification, error) {
+	return call(ctx, r.system, "NotificationRepository.PendingNotifications", func(ctx context.Context) ([]domain.Notification, error) {
+		return r.inner.PendingNotifications(ctx)
+	})
+}
+
+func (r *NotificationRepository) MarkNotificationsSent(ctx context.Context, ids []string, at time.Time) error {
+	return exec(ctx, r.system, "NotificationRepository.MarkNotificationsSent", func(ctx context.Context) error {
+		return r.inner.MarkNotificationsSent(ctx, ids, at)
+	})
+}
@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"context"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// StatsRepository traces the calls to a repository.StatsRepository.
+type StatsRepository struct {
+	inner  repository.StatsRepository
+	system string
+}
+
+// NewStatsRepository wraps inner, whose store is system.
+func NewStatsRepository(inner repository.StatsRepository, system string) *StatsRepository {
+	return &StatsRepository{inner: inner, system: system}
+}
+
+func (r *StatsRepository) GetLegislatorStats(ctx context.Context, legislatorID string, sessionYear int) ([]domain.LegislatorStats, error) {
+	return call(ctx, r.system, "StatsRepository.GetLegislatorStats", func(ctx context.Context) ([]domain.LegislatorStats, error) {
+		return r.inner.GetLegislatorStats(ctx, legislatorID, sessionYear)
+	})
+}
+
+func (r *StatsRepository) SessionDatasetHash(ctx context.Context, sessionID int) (string, error) {
+	return call(ctx, r.system, "StatsRepository.SessionDatasetHash", func(ctx context.Context) (string, error) {
+		return r.inner.SessionDatasetHash(ctx, sessionID)
+	})
+}
+
+func (r *StatsRepository) ReplaceSessionStats(ctx context.Context, sessionID int, stats []domain.LegislatorStats) error {
+	return exec(ctx, r.system, "StatsRepository.ReplaceSessionStats", func(ctx context.Context) error {
+		return r.inner.ReplaceSessionStats(ctx, sessionID, stats)
+	})
+}
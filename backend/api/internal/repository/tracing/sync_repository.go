@@ -0,0 +1,31 @@
+package tracing
+
+import (
+	"context"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// SyncRepository traces the calls to a repository.SyncRepository.
+type SyncRepository struct {
+	inner  repository.SyncRepository
+	system string
+}
+
+// NewSyncRepository wraps inner, whose store is system.
+func NewSyncRepository(inner repository.SyncRepository, system string) *SyncRepository {
+	return &SyncRepository{inner: inner, system: system}
+}
+
+func (r *SyncRepository) GetSyncVersion(ctx context.Context, entity string) (domain.SyncVersion, error) {
+	return call(ctx, r.system, "SyncRepository.GetSyncVersion", func(ctx context.Context) (domain.SyncVersion, error) {
+		return r.inner.GetSyncVersion(ctx, entity)
+	})
+}
+
+func (r *SyncRepository) BumpSyncVersion(ctx context.Context, entity string) (domain.SyncVersion, error) {
+	return call(ctx, r.system, "SyncRepository.BumpSyncVersion", func(ctx context.Context) (domain.SyncVersion, error) {
+		return r.inner.BumpSyncVersion(ctx, entity)
+	})
+}
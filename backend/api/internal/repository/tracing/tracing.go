@@ -0,0 +1,46 @@
+// Package tracing wraps repositories so that each call is a span of the
+// caller's trace, named after the interface and method, e.g.
+// "BillRepository.ListBills", and tagged with the store's db.system.
+//
+// Wrap the stores themselves, under any caching, so the spans show the
+// queries that reach the database.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Values of db.system for the stores.
+const (
+	SystemPocketBase = "pocketbase"
+	SystemPostgres   = "postgresql"
+)
+
+var tracer = otel.Tracer("api/internal/repository")
+
+// call runs f, a call to the store, in a span.
+func call[T any](ctx context.Context, system, name string, f func(context.Context) (T, error)) (T, error) {
+	ctx, span := tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("db.system", system)))
+	defer span.End()
+	v, err := f(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return v, err
+}
+
+// exec is call for methods that only return an error.
+func exec(ctx context.Context, system, name string, f func(context.Context) error) error {
+	_, err := call(ctx, system, name, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, f(ctx)
+	})
+	return err
+}
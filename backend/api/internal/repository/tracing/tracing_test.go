@@ -0,0 +1,127 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/repository/memory"
+	"api/internal/repository/repositorytest"
+)
+
+// newStores wraps the memory stores, so the conformance tests check that
+// every method is passed through.
+func newStores(t *testing.T) repositorytest.Stores {
+	legislators := memory.NewLegislatorRepository()
+	return repositorytest.Stores{
+		Bills:       NewBillRepository(memory.NewBillRepository(legislators), SystemPostgres),
+		Legislators: NewLegislatorRepository(legislators, SystemPostgres),
+		Stats:       NewStatsRepository(memory.NewStatsRepository(), SystemPostgres),
+		Sync:        NewSyncRepository(memory.NewSyncRepository(), SystemPostgres),
+		Runs:        NewJobRunRepository(memory.NewJobRunRepository(), SystemPostgres),
+		DeadLetters: NewDeadLetterRepository(memory.NewDeadLetterRepository(), SystemPostgres),
+		Watchlist:   NewWatchlistRepository(memory.NewWatchlistRepository(), SystemPostgres),
+
+		Notifications: NewNotificationRepository(memory.NewNotificationRepository(), SystemPostgres),
+		Webhooks:      NewWebhookRepository(memory.NewWebhookRepository(), SystemPostgres),
+		APIKeys:       NewAPIKeyRepository(memory.NewAPIKeyRepository(), SystemPostgres),
+	}
+}
+
+func TestLegislatorRepository(t *testing.T) {
+	repositorytest.TestLegislatorRepository(t, newStores)
+}
+
+func TestBillRepository(t *testing.T) {
+	repositorytest.TestBillRepository(t, newStores)
+}
+
+func TestStatsRepository(t *testing.T) {
+	repositorytest.TestStatsRepository(t, newStores)
+}
+
+func TestSyncRepository(t *testing.T) {
+	repositorytest.TestSyncRepository(t, newStores)
+}
+
+func TestJobRunRepository(t *testing.T) {
+	repositorytest.TestJobRunRepository(t, newStores)
+}
+
+func TestDeadLetterRepository(t *testing.T) {
+	repositorytest.TestDeadLetterRepository(t, newStores)
+}
+
+func TestWatchlistRepository(t *testing.T) {
+	repositorytest.TestWatchlistRepository(t, newStores)
+}
+
+func TestNotificationRepository(t *testing.T) {
+	repositorytest.TestNotificationRepository(t, newStores)
+}
+
+func TestWebhookRepository(t *testing.T) {
+	repositorytest.TestWebhookRepository(t, newStores)
+}
+
+func TestAPIKeyRepository(t *testing.T) {
+	repositorytest.TestAPIKeyRepository(t, newStores)
+}
+
+func TestSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "request")
+	bills := NewBillRepository(failingGets{memory.NewBillRepository(memory.NewLegislatorRepository())}, SystemPocketBase)
+	if _, err := bills.ListBills(ctx, repository.BillFilters{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bills.GetBill(ctx, "hb1"); !errors.Is(err, errStore) {
+		t.Fatalf("GetBill: got %v, want %v", err, errStore)
+	}
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("got %d spans, want 3", len(spans))
+	}
+	list, get := spans[0], spans[1]
+	if list.Name() != "BillRepository.ListBills" || get.Name() != "BillRepository.GetBill" {
+		t.Errorf("span names = %q, %q", list.Name(), get.Name())
+	}
+	if list.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Error("repository span is not a child of the caller's span")
+	}
+	want := attribute.String("db.system", SystemPocketBase)
+	if attrs := list.Attributes(); len(attrs) != 1 || attrs[0] != want {
+		t.Errorf("attributes = %v, want [%v]", attrs, want)
+	}
+	if list.Status().Code != codes.Unset {
+		t.Errorf("ListBills status = %v, want unset", list.Status().Code)
+	}
+	if get.Status().Code != codes.Error {
+		t.Errorf("GetBill status = %v, want error", get.Status().Code)
+	}
+}
+
+var errStore = errors.New("store unavailable")
+
+// failingGets is a bill store whose GetBill fails.
+type failingGets struct {
+	repository.BillRepository
+}
+
+func (failingGets) GetBill(context.Context, string) (*domain.Bill, error) {
+	return nil, errStore
+}
@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"context"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// WatchlistRepository traces the calls to a repository.WatchlistRepository.
+type WatchlistRepository struct {
+	inner  repository.WatchlistRepository
+	system string
+}
+
+// NewWatchlistRepository wraps inner, whose store is system.
+func NewWatchlistRepository(inner repository.WatchlistRepository, system string) *WatchlistRepository {
+	return &WatchlistRepository{inner: inner, system: system}
+}
+
+func (r *WatchlistRepository) Follow(ctx context.Context, f domain.Follow) (domain.Follow, error) {
+	return call(ctx, r.system, "WatchlistRepository.Follow", func(ctx context.Context) (domain.Follow, error) {
+		return r.inner.Follow(ctx, f)
+	})
+}
+
+func (r *WatchlistRepository) Unfollow(ctx context.Context, userID, kind, targetID string) error {
+	return exec(ctx, r.system, "WatchlistRepository.Unfollow", func(ctx context.Context) error {
+		return r.inner.Unfollow(ctx, userID, kind, targetID)
+	})
+}
+
+func (r *WatchlistRepository) ListFollows(ctx context.Context, userID, kind string) ([]domain.Follow, error) {
+	return call(ctx, r.system, "WatchlistRepository.ListFollows", func(ctx context.Context) ([]domain.Follow, error) {
+		return r.inner.ListFollows(ctx, userID, kind)
+	})
+}
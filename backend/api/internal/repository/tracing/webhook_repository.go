@@ -0,0 +1,74 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// WebhookRepository traces the calls to a repository.WebhookRepository.
+type WebhookRepository struct {
+	inner  repository.WebhookRepository
+	system string
+}
+
+// NewWebhookRepository wraps inner, whose store is system.
+func NewWebhookRepository(inner repository.WebhookRepository, system string) *WebhookRepository {
+	return &WebhookRepository{inner: inner, system: system}
+}
+
+func (r *WebhookRepository) CreateWebhook(ctx context.Context, w domain.Webhook) (domain.Webhook, error) {
+	return call(ctx, r.system, "WebhookRepository.CreateWebhook", func(ctx context.Context) (domain.Webhook, error) {
+		return r.inner.CreateWebhook(ctx, w)
+	})
+}
+
+func (r *WebhookRepository) GetWebhook(ctx context.Context, id string) (*domain.Webhook, error) {
+	return call(ctx, r.system, "WebhookRepository.GetWebhook", func(ctx context.Context) (*domain.Webhook, error) {
+		return r.inner.GetWebhook(ctx, id)
+	})
+}
+
+func (r *WebhookRepository) ListWebhooks(ctx context.Context, ownerID string) ([]domain.Webhook, error) {
+	return call(ctx, r.system, "WebhookRepository.ListWebhooks", func(ctx context.Context) ([]domain.Webhook, error) {
+		return r.inner.ListWebhooks(ctx, ownerID)
+	})
+}
+
+func (r *WebhookRepository) DeleteWebhook(ctx context.Context, ownerID, id string) error {
+	return exec(ctx, r.system, "WebhookRepository.DeleteWebhook", func(ctx context.Context) error {
+		return r.inner.DeleteWebhook(ctx, ownerID, id)
+	})
+}
+
+func (r *WebhookRepository) EnqueueDeliveries(ctx context.Context, ds []domain.WebhookDelivery) ([]domain.WebhookDelivery, error) {
+	return call(ctx, r.system, "WebhookRepository.EnqueueDeliveries", func(ctx context.Context) ([]domain.WebhookDelivery, error) {
+		return r.inner.EnqueueDeliveries(ctx, ds)
+	})
+}
+
+func (r *WebhookRepository) DueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	return call(ctx, r.system, "WebhookRepository.DueDeliveries", func(ctx context.Context) ([]domain.WebhookDelivery, error) {
+		return r.inner.DueDeliveries(ctx, now, limit)
+	})
+}
+
+func (r *WebhookRepository) UpdateDelivery(ctx context.Context, d domain.WebhookDelivery) error {
+	return exec(ctx, r.system, "WebhookRepository.UpdateDelivery", func(ctx context.Context) error {
+		return r.inner.UpdateDelivery(ctx, d)
+	})
+}
+
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	return call(ctx, r.system, "WebhookRepository.GetDelivery", func(ctx context.Context) (*domain.WebhookDelivery, error) {
+		return r.inner.GetDelivery(ctx, id)
+	})
+}
+
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, webhookID string, limit int) ([]domain.WebhookDelivery, error) {
+	return call(ctx, r.system, "WebhookRepository.ListDeliveries", func(ctx context.Context) ([]domain.WebhookDelivery, error) {
+		return r.inner.ListDeliveries(ctx, webhookID, limit)
+	})
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"api/internal/domain"
+)
+
+// WatchlistRepository stores the items each user follows.
+type WatchlistRepository interface {
+	// Follow adds f to its user's watchlist, keyed on (UserID, Kind,
+	// TargetID), and returns the stored follow. Following an item twice
+	// keeps the first follow. ID and CreatedAt of f are ignored.
+	Follow(ctx context.Context, f domain.Follow) (domain.Follow, error)
+	// Unfollow removes an item from a user's watchlist, if it is there.
+	Unfollow(ctx context.Context, userID, kind, targetID string) error
+	// ListFollows returns a user's follows, newest first, limited to one
+	// kind if kind is non-empty.
+	ListFollows(ctx context.Context, userID, kind string) ([]domain.Follow, error)
+}
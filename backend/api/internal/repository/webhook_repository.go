@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"api/internal/domain"
+)
+
+// WebhookRepository stores webhooks and their delivery log, which doubles as
+// the queue of deliveries waiting to be sent.
+type WebhookRepository interface {
+	// CreateWebhook stores a new webhook and returns it with its ID and
+	// CreatedAt set.
+	CreateWebhook(ctx context.Context, w domain.Webhook) (domain.Webhook, error)
+	// GetWebhook returns a webhook, or nil if it doesn't exist.
+	GetWebhook(ctx context.Context, id string) (*domain.Webhook, error)
+	// ListWebhooks returns an owner's webhooks, oldest first, or every
+	// owner's if ownerID is empty.
+	ListWebhooks(ctx context.Context, ownerID string) ([]domain.Webhook, error)
+	// DeleteWebhook removes one of an owner's webhooks and its deliveries,
+	// if it exists.
+	DeleteWebhook(ctx context.Context, ownerID, id string) error
+
+	// EnqueueDeliveries adds deliveries to the log and returns them with
+	// their IDs and CreatedAt set.
+	EnqueueDeliveries(ctx context.Context, ds []domain.WebhookDelivery) ([]domain.WebhookDelivery, error)
+	// DueDeliveries returns up to limit pending deliveries whose
+	// NextAttemptAt is not after now, oldest first. A limit <= 0 returns
+	// all of them.
+	DueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error)
+	// UpdateDelivery stores the outcome of an attempt: Status, Attempts,
+	// NextAttemptAt, LastAttemptAt, ResponseStatus and LastError.
+	UpdateDelivery(ctx context.Context, d domain.WebhookDelivery) error
+	// GetDelivery returns a delivery, or nil if it doesn't exist.
+	GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error)
+	// ListDeliveries returns a webhook's most recent deliveries, newest
+	// first. A limit <= 0 defaults to 50.
+	ListDeliveries(ctx context.Context, webhookID string, limit int) ([]domain.WebhookDelivery, error)
+}
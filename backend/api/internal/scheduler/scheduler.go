@@ -0,0 +1,234 @@
+// Package scheduler runs the ingestion jobs inside the API server, so a
+// single-container deployment needs no external cron.
+//
+// Each job runs on a cron schedule with random jitter, never overlaps with
+// itself, and records every run in a repository.JobRunRepository, as well
+// as in the job metrics and a trace of its own. Jobs can also be triggered
+// on demand, e.g. from the admin API.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pocketbase/pocketbase/tools/cron"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"api/internal/domain"
+	"api/internal/ingest"
+	"api/internal/repository"
+)
+
+var (
+	// ErrUnknownJob is returned when triggering a job that isn't registered.
+	ErrUnknownJob = errors.New("unknown job")
+	// ErrAlreadyRunning is returned when triggering a job that is running.
+	ErrAlreadyRunning = errors.New("job already running")
+)
+
+var tracer = otel.Tracer("api/internal/scheduler")
+
+// Job is an ingestion job and when to run it.
+type Job struct {
+	Name     string
+	Schedule string // cron expression, evaluated in UTC
+
+	// Active reports whether scheduled runs should happen at t, e.g. only
+	// during session. Nil means always. Manual triggers ignore it.
+	Active func(t time.Time) bool
+
+	Run func(ctx context.Context) (ingest.Result, error)
+}
+
+type entry struct {
+	Job
+	running atomic.Bool
+}
+
+// Scheduler runs registered jobs on schedule and on demand.
+type Scheduler struct {
+	runs   repository.JobRunRepository
+	logger *slog.Logger
+	jitter time.Duration
+
+	ctx    context.Context // cancelled by Stop; parent of every run
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu   sync.RWMutex
+	jobs map[string]*entry
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// New creates a Scheduler that records runs in runs. Scheduled runs start
+// after a random delay of up to jitter, so jobs sharing a schedule don't hit
+// upstream APIs at the same instant.
+func New(runs repository.JobRunRepository, logger *slog.Logger, jitter time.Duration) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		runs:   runs,
+		logger: logger,
+		jitter: jitter,
+		ctx:    ctx,
+		cancel: cancel,
+		jobs:   map[string]*entry{},
+		now:    time.Now,
+		sleep:  sleepCtx,
+	}
+}
+
+// Add registers a job, replacing any job with the same name.
+func (s *Scheduler) Add(job Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[job.Name] = &entry{Job: job}
+}
+
+// Jobs returns the names of the registered jobs, sorted.
+func (s *Scheduler) Jobs() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	names := make([]string, 0, len(s.jobs))
+	for name := range s.jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Register adds every job with a schedule to c, under the job's name.
+func (s *Scheduler) Register(c *cron.Cron) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for name, e := range s.jobs {
+		if e.Schedule == "" {
+			continue
+		}
+		if err := c.Add("ingest_"+name, e.Schedule, func() { s.runScheduled(e) }); err != nil {
+			return fmt.Errorf("schedule job %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Trigger starts a run of the named job in the background and returns its
+// run record. Returns ErrUnknownJob or ErrAlreadyRunning if it can't start.
+func (s *Scheduler) Trigger(ctx context.Context, name string) (domain.JobRun, error) {
+	s.mu.RLock()
+	e, ok := s.jobs[name]
+	s.mu.RUnlock()
+	if !ok {
+		return domain.JobRun{}, fmt.Errorf("%w: %q", ErrUnknownJob, name)
+	}
+	if !e.running.CompareAndSwap(false, true) {
+		return domain.JobRun{}, fmt.Errorf("%w: %q", ErrAlreadyRunning, name)
+	}
+
+	run := s.start(ctx, e, domain.TriggerManual)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer e.running.Store(false)
+		s.execute(e, run)
+	}()
+	return run, nil
+}
+
+// Stop cancels running jobs and waits for them to record their result.
+func (s *Scheduler) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// runScheduled is called by cron. It skips the run if the job is inactive
+// or still running from last time, then waits out the jitter.
+func (s *Scheduler) runScheduled(e *entry) {
+	if e.Active != nil && !e.Active(s.now()) {
+		return
+	}
+	if !e.running.CompareAndSwap(false, true) {
+		s.logger.Warn("skipping scheduled job: previous run still in progress", "job", e.Name)
+		return
+	}
+	s.wg.Add(1)
+	defer s.wg.Done()
+	defer e.running.Store(false)
+
+	if s.jitter > 0 {
+		if err := s.sleep(s.ctx, rand.N(s.jitter)); err != nil {
+			return
+		}
+	}
+	s.execute(e, s.start(s.ctx, e, domain.TriggerSchedule))
+}
+
+// start records the beginning of a run. A failure to record it is logged
+// but doesn't stop the job.
+func (s *Scheduler) start(ctx context.Context, e *entry, trigger string) domain.JobRun {
+	run := domain.JobRun{
+		Job:       e.Name,
+		Trigger:   trigger,
+		Status:    domain.JobRunning,
+		StartedAt: s.now().UTC(),
+	}
+	if err := s.runs.SaveJobRun(ctx, &run); err != nil {
+		s.logger.Error("failed to record job start", "job", e.Name, "error", err)
+	}
+	return run
+}
+
+// execute runs the job and records its outcome.
+func (s *Scheduler) execute(e *entry, run domain.JobRun) {
+	logger := s.logger.With("job", e.Name, "trigger", run.Trigger)
+	logger.Info("job started")
+
+	ctx, span := tracer.Start(s.ctx, "job "+e.Name, trace.WithAttributes(attribute.String("job.trigger", run.Trigger)))
+	res, err := e.Run(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "job failed")
+	}
+	span.End()
+
+	finished := s.now().UTC()
+	ingest.ObserveRun(e.Name, run.StartedAt, finished, res, err)
+	run.FinishedAt = &finished
+	run.Upserted, run.Failed = res.Upserted, res.Failed
+	run.Status = domain.JobSucceeded
+	if err != nil {
+		run.Status = domain.JobFailed
+		run.Error = err.Error()
+		logger.Error("job failed", "error", err, "duration", finished.Sub(run.StartedAt))
+	} else {
+		logger.Info("job finished", "upserted", res.Upserted, "duration", finished.Sub(run.StartedAt))
+	}
+
+	// Record the outcome even if the scheduler is stopping. If the start
+	// wasn't recorded, this inserts the run instead.
+	if err := s.runs.SaveJobRun(context.WithoutCancel(s.ctx), &run); err != nil {
+		logger.Error("failed to record job result", "error", err)
+	}
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
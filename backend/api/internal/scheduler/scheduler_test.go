@@ -0,0 +1,125 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/ingest"
+	"api/internal/repository/memory"
+)
+
+func newScheduler(t *testing.T) (*Scheduler, *memory.JobRunRepository) {
+	t.Helper()
+	runs := memory.NewJobRunRepository()
+	s := New(runs, slog.New(slog.NewTextHandler(io.Discard, nil)), 0)
+	t.Cleanup(s.Stop)
+	return s, runs
+}
+
+func TestTrigger(t *testing.T) {
+	ctx := context.Background()
+	s, runs := newScheduler(t)
+
+	release := make(chan struct{})
+	s.Add(Job{Name: "bills", Run: func(ctx context.Context) (ingest.Result, error) {
+		<-release
+		return ingest.Result{Upserted: 3, Failed: 1}, errors.New("partial failure")
+	}})
+
+	run, err := s.Trigger(ctx, "bills")
+	if err != nil {
+		t.Fatalf("Trigger: %v", err)
+	}
+	if run.ID == "" || run.Status != domain.JobRunning || run.Trigger != domain.TriggerManual {
+		t.Errorf("started run = %+v", run)
+	}
+	if _, err := s.Trigger(ctx, "bills"); !errors.Is(err, ErrAlreadyRunning) {
+		t.Errorf("overlapping Trigger error = %v, want ErrAlreadyRunning", err)
+	}
+	if _, err := s.Trigger(ctx, "nope"); !errors.Is(err, ErrUnknownJob) {
+		t.Errorf("Trigger(nope) error = %v, want ErrUnknownJob", err)
+	}
+
+	close(release)
+	s.Stop()
+
+	history, err := runs.ListJobRuns(ctx, "bills", 0)
+	if err != nil || len(history) != 1 {
+		t.Fatalf("ListJobRuns = %+v, %v; want one run", history, err)
+	}
+	got := history[0]
+	if got.ID != run.ID || got.Status != domain.JobFailed || got.Error != "partial failure" ||
+		got.Upserted != 3 || got.Failed != 1 || got.FinishedAt == nil {
+		t.Errorf("recorded run = %+v", got)
+	}
+}
+
+func TestRunScheduled(t *testing.T) {
+	ctx := context.Background()
+	s, runs := newScheduler(t)
+
+	active := false
+	calls := 0
+	s.Add(Job{
+		Name:     "legislators",
+		Schedule: "0 10 * * *",
+		Active:   func(time.Time) bool { return active },
+		Run: func(ctx context.Context) (ingest.Result, error) {
+			calls++
+			return ingest.Result{Upserted: 104}, nil
+		},
+	})
+	e := s.jobs["legislators"]
+
+	s.runScheduled(e)
+	if calls != 0 {
+		t.Fatalf("inactive job ran %d times", calls)
+	}
+
+	active = true
+	e.running.Store(true) // a manual run is in progress
+	s.runScheduled(e)
+	if calls != 0 {
+		t.Fatalf("overlapping scheduled run executed")
+	}
+
+	e.running.Store(false)
+	s.runScheduled(e)
+	if calls != 1 || e.running.Load() {
+		t.Fatalf("calls = %d, running = %v; want one finished run", calls, e.running.Load())
+	}
+	history, err := runs.ListJobRuns(ctx, "", 0)
+	if err != nil || len(history) != 1 || history[0].Status != domain.JobSucceeded ||
+		history[0].Trigger != domain.TriggerSchedule || history[0].Upserted != 104 {
+		t.Errorf("ListJobRuns = %+v, %v", history, err)
+	}
+}
+
+func TestJitterStopsWithScheduler(t *testing.T) {
+	s, runs := newScheduler(t)
+	s.jitter = time.Hour
+	s.Add(Job{Name: "bills", Run: func(ctx context.Context) (ingest.Result, error) {
+		t.Error("job ran after Stop")
+		return ingest.Result{}, nil
+	}})
+
+	done := make(chan struct{})
+	go func() {
+		s.runScheduled(s.jobs["bills"])
+		close(done)
+	}()
+	s.cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("scheduled run still waiting out jitter after Stop")
+	}
+	if history, _ := runs.ListJobRuns(context.Background(), "", 0); len(history) != 0 {
+		t.Errorf("recorded %d runs for a cancelled start", len(history))
+	}
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/domain"
+	"api/internal/ingest"
+	"api/internal/repository"
+	"api/internal/scheduler"
+)
+
+// JobScheduler starts ingestion jobs on demand. *scheduler.Scheduler
+// implements it.
+type JobScheduler interface {
+	Jobs() []string
+	Trigger(ctx context.Context, name string) (domain.JobRun, error)
+}
+
+// Resyncer re-fetches and writes individual records. *ingest.Resyncer
+// implements it.
+type Resyncer interface {
+	Resync(ctx context.Context, bills, legislators []string) ([]ingest.Outcome, error)
+}
+
+// AdminService implements pb.AdminServiceServer.
+//
+// It performs no authorization itself; main.go guards every AdminService
+// method with a superuser check before the call reaches this handler.
+type AdminService struct {
+	pb.UnimplementedAdminServiceServer
+	bills       repository.BillRepository
+	legislators repository.LegislatorRepository
+	jobs        JobScheduler
+	runs        repository.JobRunRepository
+	deadLetters repository.DeadLetterRepository
+	resync      Resyncer
+}
+
+// NewAdminService creates a new AdminService. jobs may be nil when the
+// embedded scheduler is disabled.
+func NewAdminService(bills repository.BillRepository, legislators repository.LegislatorRepository, jobs JobScheduler, runs repository.JobRunRepository, deadLetters repository.DeadLetterRepository, resync Resyncer) *AdminService {
+	return &AdminService{bills: bills, legislators: legislators, jobs: jobs, runs: runs, deadLetters: deadLetters, resync: resync}
+}
+
+// GetLegislatorProvenance reports which source supplied each stored field of a legislator.
+func (s *AdminService) GetLegislatorProvenance(ctx context.Context, req *pb.GetProvenanceRequest) (*pb.GetProvenanceResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	l, err := s.legislators.GetLegislator(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get legislator: %v", err)
+	}
+	if l == nil {
+		return nil, status.Errorf(codes.NotFound, "legislator %q not found", req.Id)
+	}
+
+	return &pb.GetProvenanceResponse{Fields: toProvenancePb(l.Provenance)}, nil
+}
+
+// GetBillProvenance reports which source supplied each stored field of a bill.
+func (s *AdminService) GetBillProvenance(ctx context.Context, req *pb.GetProvenanceRequest) (*pb.GetProvenanceResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	b, err := s.bills.GetBill(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get bill: %v", err)
+	}
+	if b == nil {
+		return nil, status.Errorf(codes.NotFound, "bill %q not found", req.Id)
+	}
+
+	return &pb.GetProvenanceResponse{Fields: toProvenancePb(b.Provenance)}, nil
+}
+
+// TriggerJob starts an ingestion job in the background.
+func (s *AdminService) TriggerJob(ctx context.Context, req *pb.TriggerJobRequest) (*pb.TriggerJobResponse, error) {
+	if req.Job == "" {
+		return nil, status.Error(codes.InvalidArgument, "job is required")
+	}
+	if s.jobs == nil {
+		return nil, status.Error(codes.Unavailable, "scheduler is disabled")
+	}
+
+	run, err := s.jobs.Trigger(ctx, req.Job)
+	switch {
+	case errors.Is(err, scheduler.ErrUnknownJob):
+		return nil, status.Errorf(codes.NotFound, "job %q not found", req.Job)
+	case errors.Is(err, scheduler.ErrAlreadyRunning):
+		return nil, status.Errorf(codes.FailedPrecondition, "job %q is already running", req.Job)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "trigger job: %v", err)
+	}
+	return &pb.TriggerJobResponse{Run: toJobRunPb(run)}, nil
+}
+
+// ListJobRuns returns the run history of the ingestion jobs, newest first.
+func (s *AdminService) ListJobRuns(ctx context.Context, req *pb.ListJobRunsRequest) (*pb.ListJobRunsResponse, error) {
+	runs, err := s.runs.ListJobRuns(ctx, req.Job, int(req.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list job runs: %v", err)
+	}
+
+	resp := &pb.ListJobRunsResponse{Runs: make([]*pb.JobRun, 0, len(runs))}
+	for _, r := range runs {
+		resp.Runs = append(resp.Runs, toJobRunPb(r))
+	}
+	if s.jobs != nil {
+		resp.Jobs = s.jobs.Jobs()
+	}
+	return resp, nil
+}
+
+// ListDeadLetters returns the records the ingestion jobs failed to write,
+// oldest first.
+func (s *AdminService) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	letters, err := s.deadLetters.ListDeadLetters(ctx, req.Kind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list dead letters: %v", err)
+	}
+
+	resp := &pb.ListDeadLettersResponse{DeadLetters: make([]*pb.DeadLetter, 0, len(letters))}
+	for _, dl := range letters {
+		resp.DeadLetters = append(resp.DeadLetters, &pb.DeadLetter{
+			Id:            dl.ID,
+			Kind:          dl.Kind,
+			Source:        dl.Source,
+			Key:           dl.Key,
+			Payload:       string(dl.Payload),
+			Error:         dl.Error,
+			Attempts:      int32(dl.Attempts),
+			FirstFailedAt: dl.FirstFailedAt.Format(time.RFC3339),
+			LastFailedAt:  dl.LastFailedAt.Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+// Resync re-fetches the named records, or every dead letter if none are
+// named, and reports the outcome of each.
+func (s *AdminService) Resync(ctx context.Context, req *pb.ResyncRequest) (*pb.ResyncResponse, error) {
+	outcomes, err := s.resync.Resync(ctx, req.Bills, req.Legislators)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "resync: %v", err)
+	}
+
+	resp := &pb.ResyncResponse{Results: make([]*pb.ResyncResult, 0, len(outcomes))}
+	for _, o := range outcomes {
+		r := &pb.ResyncResult{Kind: o.Kind, Key: o.Key}
+		if o.Err != nil {
+			r.Error = o.Err.Error()
+			resp.Failed++
+		} else {
+			resp.Upserted++
+		}
+		resp.Results = append(resp.Results, r)
+	}
+	return resp, nil
+}
+
+// GetDataQuality reports bills whose upstream sponsor ID matches no stored
+// legislator.
+func (s *AdminService) GetDataQuality(ctx context.Context, req *pb.GetDataQualityRequest) (*pb.GetDataQualityResponse, error) {
+	unresolved, err := s.bills.UnresolvedSponsors(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unresolved sponsors: %v", err)
+	}
+
+	resp := &pb.GetDataQualityResponse{UnresolvedSponsors: make([]*pb.UnresolvedSponsor, 0, len(unresolved))}
+	for _, u := range unresolved {
+		resp.UnresolvedSponsors = append(resp.UnresolvedSponsors, &pb.UnresolvedSponsor{
+			SponsorId: u.SponsorUtahID,
+			Bills:     int32(u.Bills),
+		})
+		resp.UnresolvedSponsorBills += int32(u.Bills)
+	}
+	return resp, nil
+}
+
+// toJobRunPb converts a domain.JobRun to its proto representation.
+func toJobRunPb(r domain.JobRun) *pb.JobRun {
+	out := &pb.JobRun{
+		Id:        r.ID,
+		Job:       r.Job,
+		Trigger:   r.Trigger,
+		Status:    r.Status,
+		StartedAt: r.StartedAt.Format(time.RFC3339),
+		Upserted:  int32(r.Upserted),
+		Failed:    int32(r.Failed),
+		Error:     r.Error,
+	}
+	if r.FinishedAt != nil {
+		out.FinishedAt = r.FinishedAt.Format(time.RFC3339)
+	}
+	return out
+}
+
+// toProvenancePb converts domain provenance to its proto form, sorted by field name.
+func toProvenancePb(p domain.Provenance) []*pb.FieldProvenance {
+	out := make([]*pb.FieldProvenance, 0, len(p))
+	for field, src := range p {
+		out = append(out, &pb.FieldProvenance{
+			Field:     field,
+			Source:    src.Source,
+			UpdatedAt: src.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Field < out[j].Field })
+	return out
+}
+
+// ensure interface is satisfied at compile time.
+var _ pb.AdminServiceServer = (*AdminService)(nil)
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/domain"
+	"api/internal/ingest"
+	"api/internal/repository/memory"
+)
+
+type fakeResyncer struct {
+	bills, legislators []string
+}
+
+func (f *fakeResyncer) Resync(ctx context.Context, bills, legislators []string) ([]ingest.Outcome, error) {
+	f.bills, f.legislators = bills, legislators
+	return []ingest.Outcome{
+		{Kind: domain.DeadLetterLegislator, Key: "SMITHJ"},
+		{Kind: domain.DeadLetterBill, Key: "2026GS/HB0001", Err: errors.New("upstream 500")},
+	}, nil
+}
+
+func TestResync(t *testing.T) {
+	resync := &fakeResyncer{}
+	s := NewAdminService(nil, nil, nil, nil, memory.NewDeadLetterRepository(), resync)
+
+	resp, err := s.Resync(context.Background(), &pb.ResyncRequest{Bills: []string{"2026GS/HB0001"}, Legislators: []string{"SMITHJ"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resync.bills) != 1 || len(resync.legislators) != 1 {
+		t.Errorf("resyncer got bills %v, legislators %v", resync.bills, resync.legislators)
+	}
+	if resp.Upserted != 1 || resp.Failed != 1 || len(resp.Results) != 2 {
+		t.Fatalf("Resync = %+v, want 1 upserted and 1 failed", resp)
+	}
+	if r := resp.Results[1]; r.Key != "2026GS/HB0001" || r.Error != "upstream 500" {
+		t.Errorf("result = %+v, want the bill's error", r)
+	}
+}
+
+func TestListDeadLetters(t *testing.T) {
+	ctx := context.Background()
+	letters := memory.NewDeadLetterRepository()
+	s := NewAdminService(nil, nil, nil, nil, letters, &fakeResyncer{})
+
+	err := letters.RecordDeadLetter(ctx, domain.DeadLetter{
+		Kind: domain.DeadLetterBill, Source: domain.SourceUtahLegislature, Key: "2026GS/HB0001",
+		Payload: []byte(`{"BillNumber":"HB0001"}`), Error: "constraint failed",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.ListDeadLetters(ctx, &pb.ListDeadLettersRequest{Kind: domain.DeadLetterBill})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.DeadLetters) != 1 {
+		t.Fatalf("got %d dead letters, want 1", len(resp.DeadLetters))
+	}
+	dl := resp.DeadLetters[0]
+	if dl.Key != "2026GS/HB0001" || dl.Attempts != 1 || dl.Payload != `{"BillNumber":"HB0001"}` || dl.FirstFailedAt == "" {
+		t.Errorf("dead letter = %+v", dl)
+	}
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/domain"
+	"api/internal/ratelimit"
+	"api/internal/repository"
+)
+
+// Limits on API key settings.
+const (
+	maxAPIKeyName  = 200
+	maxAPIKeyLimit = 1_000_000
+)
+
+// APIKeyService implements pb.ApiKeyServiceServer. Its methods are for
+// superusers, which the superuser interceptor enforces.
+type APIKeyService struct {
+	pb.UnimplementedApiKeyServiceServer
+	keys repository.APIKeyRepository
+}
+
+// NewAPIKeyService creates a new APIKeyService.
+func NewAPIKeyService(keys repository.APIKeyRepository) *APIKeyService {
+	return &APIKeyService{keys: keys}
+}
+
+// CreateApiKey issues a new key and returns it.
+func (s *APIKeyService) CreateApiKey(ctx context.Context, req *pb.CreateApiKeyRequest) (*pb.CreateApiKeyResponse, error) {
+	in, err := validAPIKey(req.GetApiKey())
+	if err != nil {
+		return nil, err
+	}
+	key, err := ratelimit.NewKey()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	in.Prefix, in.Hash = ratelimit.KeyPrefix(key), ratelimit.HashKey(key)
+	k, err := s.keys.CreateAPIKey(ctx, in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "create api key: %v", err)
+	}
+	return &pb.CreateApiKeyResponse{ApiKey: toAPIKeyPb(k), Key: key}, nil
+}
+
+// ListApiKeys returns every key, oldest first.
+func (s *APIKeyService) ListApiKeys(ctx context.Context, req *pb.ListApiKeysRequest) (*pb.ListApiKeysResponse, error) {
+	keys, err := s.keys.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list api keys: %v", err)
+	}
+	out := make([]*pb.ApiKey, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, toAPIKeyPb(k))
+	}
+	return &pb.ListApiKeysResponse{ApiKeys: out}, nil
+}
+
+// UpdateApiKey changes a key's name, limits and revocation.
+func (s *APIKeyService) UpdateApiKey(ctx context.Context, req *pb.UpdateApiKeyRequest) (*pb.UpdateApiKeyResponse, error) {
+	in, err := validAPIKey(req.GetApiKey())
+	if err != nil {
+		return nil, err
+	}
+	if in.ID == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if err := s.keys.UpdateAPIKey(ctx, in); err != nil {
+		return nil, status.Errorf(codes.Internal, "update api key: %v", err)
+	}
+	k, err := s.keys.GetAPIKey(ctx, in.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get api key: %v", err)
+	}
+	if k == nil {
+		return nil, status.Errorf(codes.NotFound, "api key %q not found", in.ID)
+	}
+	return &pb.UpdateApiKeyResponse{ApiKey: toAPIKeyPb(*k)}, nil
+}
+
+// DeleteApiKey removes a key, if it exists.
+func (s *APIKeyService) DeleteApiKey(ctx context.Context, req *pb.DeleteApiKeyRequest) (*pb.DeleteApiKeyResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if err := s.keys.DeleteAPIKey(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete api key: %v", err)
+	}
+	return &pb.DeleteApiKeyResponse{}, nil
+}
+
+// validAPIKey checks the settings of a key to create or update.
+func validAPIKey(in *pb.ApiKey) (domain.APIKey, error) {
+	if in == nil {
+		return domain.APIKey{}, status.Error(codes.InvalidArgument, "api_key is required")
+	}
+	name := strings.TrimSpace(in.Name)
+	if name == "" || len(name) > maxAPIKeyName {
+		return domain.APIKey{}, status.Errorf(codes.InvalidArgument, "name is required, at most %d bytes", maxAPIKeyName)
+	}
+	for _, n := range []int32{in.RatePerMinute, in.Burst} {
+		if n < 0 || n > maxAPIKeyLimit {
+			return domain.APIKey{}, status.Errorf(codes.InvalidArgument, "rate_per_minute and burst must be 0 to %d", maxAPIKeyLimit)
+		}
+	}
+	return domain.APIKey{
+		ID:            in.Id,
+		Name:          name,
+		RatePerMinute: int(in.RatePerMinute),
+		Burst:         int(in.Burst),
+		Revoked:       in.Revoked,
+	}, nil
+}
+
+// toAPIKeyPb converts a domain.APIKey to its proto representation.
+func toAPIKeyPb(k domain.APIKey) *pb.ApiKey {
+	out := &pb.ApiKey{
+		Id:            k.ID,
+		Name:          k.Name,
+		Prefix:        k.Prefix,
+		RatePerMinute: int32(k.RatePerMinute),
+		Burst:         int32(k.Burst),
+		Revoked:       k.Revoked,
+		Requests:      k.Requests,
+		Throttled:     k.Throttled,
+		CreatedAt:     k.CreatedAt.Format(time.RFC3339),
+	}
+	if k.LastUsedAt != nil {
+		out.LastUsedAt = k.LastUsedAt.Format(time.RFC3339)
+	}
+	return out
+}
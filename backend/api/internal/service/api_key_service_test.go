@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/ratelimit"
+	"api/internal/repository/memory"
+)
+
+func TestAPIKeys(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewAPIKeyRepository()
+	svc := NewAPIKeyService(repo)
+
+	created, err := svc.CreateApiKey(ctx, &pb.CreateApiKeyRequest{ApiKey: &pb.ApiKey{Name: " Civic App ", RatePerMinute: 600}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ApiKey.Name != "Civic App" || created.ApiKey.Prefix != ratelimit.KeyPrefix(created.Key) {
+		t.Errorf("created = %v", created)
+	}
+	stored, _ := repo.GetAPIKeyByHash(ctx, ratelimit.HashKey(created.Key))
+	if stored == nil || stored.RatePerMinute != 600 {
+		t.Fatalf("stored key = %+v, want it findable by the key's hash", stored)
+	}
+	for _, bad := range []*pb.ApiKey{{}, {Name: "x", Burst: -1}, {Name: "x", RatePerMinute: maxAPIKeyLimit + 1}} {
+		if _, err := svc.CreateApiKey(ctx, &pb.CreateApiKeyRequest{ApiKey: bad}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("CreateApiKey(%v) error = %v, want InvalidArgument", bad, err)
+		}
+	}
+
+	updated, err := svc.UpdateApiKey(ctx, &pb.UpdateApiKeyRequest{ApiKey: &pb.ApiKey{Id: created.ApiKey.Id, Name: "Civic App", Revoked: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !updated.ApiKey.Revoked || updated.ApiKey.RatePerMinute != 0 || updated.ApiKey.Prefix != created.ApiKey.Prefix {
+		t.Errorf("updated = %v", updated.ApiKey)
+	}
+	if _, err := svc.UpdateApiKey(ctx, &pb.UpdateApiKeyRequest{ApiKey: &pb.ApiKey{Id: "missing", Name: "x"}}); status.Code(err) != codes.NotFound {
+		t.Errorf("UpdateApiKey(missing) error = %v, want NotFound", err)
+	}
+
+	if _, err := svc.DeleteApiKey(ctx, &pb.DeleteApiKeyRequest{Id: created.ApiKey.Id}); err != nil {
+		t.Fatal(err)
+	}
+	if list, _ := svc.ListApiKeys(ctx, &pb.ListApiKeysRequest{}); len(list.ApiKeys) != 0 {
+		t.Errorf("keys after delete = %v", list.ApiKeys)
+	}
+}
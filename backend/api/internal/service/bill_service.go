@@ -2,25 +2,44 @@ package service
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	pb "api/gen/go/proto/v1"
+	"api/internal/auth"
 	"api/internal/domain"
 	"api/internal/repository"
+	"api/internal/repository/events"
+)
+
+// Limits and keep-alives of WatchBills.
+const (
+	maxWatchedBills        = 100
+	watchHeartbeatInterval = 25 * time.Second
+
+	watchSnapshot  = "snapshot"
+	watchHeartbeat = "heartbeat"
 )
 
 // BillService implements pb.BillServiceServer.
 type BillService struct {
 	pb.UnimplementedBillServiceServer
-	repo repository.BillRepository
+	repo      repository.BillRepository
+	follows   repository.WatchlistRepository
+	broker    *events.Broker
+	heartbeat time.Duration
 }
 
-// NewBillService creates a new BillService.
-func NewBillService(repo repository.BillRepository) *BillService {
-	return &BillService{repo: repo}
+// NewBillService creates a new BillService. follows backs the followed-bills
+// feed and may be nil to disable it. broker carries the bill events
+// WatchBills streams; it may be nil to disable streaming.
+func NewBillService(repo repository.BillRepository, follows repository.WatchlistRepository, broker *events.Broker) *BillService {
+	return &BillService{repo: repo, follows: follows, broker: broker, heartbeat: watchHeartbeatInterval}
 }
 
 // ListBills returns Utah bills with optional filtering and pagination.
@@ -33,8 +52,24 @@ func (s *BillService) ListBills(ctx context.Context, req *pb.ListBillsRequest) (
 		PageSize:    int(req.PageSize),
 	}
 
-	// Default to current year when not specified.
-	if filters.SessionYear == 0 {
+	var paths []string
+	if mask := req.GetReadMask(); len(mask.GetPaths()) > 0 {
+		if !mask.IsValid(&pb.Bill{}) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid read_mask %v", mask.GetPaths())
+		}
+		mask.Normalize()
+		paths = mask.GetPaths()
+		filters.OmitSponsor = !maskIncludes(paths, "sponsor")
+	}
+
+	if req.Followed {
+		ids, err := s.followedBills(ctx)
+		if err != nil {
+			return nil, err
+		}
+		filters.IDs, filters.ByActivity = ids, true
+	} else if filters.SessionYear == 0 {
+		// Default to current year when not specified.
 		filters.SessionYear = time.Now().Year()
 	}
 
@@ -45,12 +80,36 @@ func (s *BillService) ListBills(ctx context.Context, req *pb.ListBillsRequest) (
 
 	pbBills := make([]*pb.Bill, 0, len(bills))
 	for _, b := range bills {
-		pbBills = append(pbBills, toBillPb(b))
+		out := toBillPb(b)
+		if paths != nil {
+			applyReadMask(out.ProtoReflect(), paths)
+		}
+		pbBills = append(pbBills, out)
 	}
 
 	return &pb.ListBillsResponse{Bills: pbBills, Total: int32(len(pbBills))}, nil
 }
 
+// followedBills returns the IDs of the bills the caller follows.
+func (s *BillService) followedBills(ctx context.Context) ([]string, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.follows == nil {
+		return nil, status.Error(codes.Unimplemented, "followed bills are not available")
+	}
+	follows, err := s.follows.ListFollows(ctx, user.ID, domain.FollowBill)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list follows: %v", err)
+	}
+	ids := make([]string, 0, len(follows))
+	for _, f := range follows {
+		ids = append(ids, f.TargetID)
+	}
+	return ids, nil
+}
+
 // GetBill returns a single bill by UUID with the sponsor embedded.
 func (s *BillService) GetBill(ctx context.Context, req *pb.GetBillRequest) (*pb.GetBillResponse, error) {
 	if req.Id == "" {
@@ -68,6 +127,112 @@ func (s *BillService) GetBill(ctx context.Context, req *pb.GetBillRequest) (*pb.
 	return &pb.GetBillResponse{Bill: toBillPb(*b)}, nil
 }
 
+// WatchBills streams updates to the selected bills until the client goes
+// away.
+func (s *BillService) WatchBills(req *pb.WatchBillsRequest, stream pb.BillService_WatchBillsServer) error {
+	ctx := stream.Context()
+	if s.broker == nil {
+		return status.Error(codes.Unimplemented, "watching bills is not available")
+	}
+	if len(req.BillIds) > maxWatchedBills {
+		return status.Errorf(codes.InvalidArgument, "at most %d bill_ids", maxWatchedBills)
+	}
+	eventTypes := req.EventTypes
+	if len(eventTypes) == 0 {
+		eventTypes = []string{domain.EventBillStatusChanged, domain.EventBillAction}
+	}
+	for _, t := range eventTypes {
+		if t != domain.EventBillStatusChanged && t != domain.EventBillAction && t != domain.EventBillNewVersion {
+			return status.Errorf(codes.InvalidArgument, "unknown event type %q", t)
+		}
+	}
+	var follows []domain.Follow
+	if req.Followed {
+		user, err := auth.RequireUser(ctx)
+		if err != nil {
+			return err
+		}
+		if s.follows == nil {
+			return status.Error(codes.Unimplemented, "followed bills are not available")
+		}
+		if follows, err = s.follows.ListFollows(ctx, user.ID, ""); err != nil {
+			return status.Errorf(codes.Internal, "list follows: %v", err)
+		}
+	}
+
+	// Subscribe before the snapshot so no change falls between the two.
+	updates, unsubscribe := s.broker.Subscribe()
+	defer unsubscribe()
+
+	for _, id := range req.BillIds {
+		b, err := s.repo.GetBill(ctx, id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "get bill: %v", err)
+		}
+		if b == nil {
+			return status.Errorf(codes.NotFound, "bill %q not found", id)
+		}
+		err = stream.Send(&pb.WatchBillsResponse{
+			EventType: watchSnapshot, Bill: toBillPb(*b), OccurredAt: time.Now().UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	heartbeat := time.NewTicker(s.heartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			err := stream.Send(&pb.WatchBillsResponse{EventType: watchHeartbeat, OccurredAt: time.Now().UTC().Format(time.RFC3339)})
+			if err != nil {
+				return err
+			}
+		case e, ok := <-updates:
+			if !ok {
+				return status.Error(codes.Unavailable, "stream fell behind; reconnect")
+			}
+			if e.Bill == nil || !slices.Contains(eventTypes, e.Type) || !watching(req, follows, e.Bill) {
+				continue
+			}
+			err := stream.Send(&pb.WatchBillsResponse{
+				EventType:  e.Type,
+				Bill:       toBillPb(*e.Bill),
+				Previous:   e.Previous,
+				OccurredAt: e.At.UTC().Format(time.RFC3339),
+			})
+			if err != nil {
+				return err
+			}
+			heartbeat.Reset(s.heartbeat)
+		}
+	}
+}
+
+// watching reports whether a WatchBills request selects b. follows are the
+// caller's, for a followed request.
+func watching(req *pb.WatchBillsRequest, follows []domain.Follow, b *domain.Bill) bool {
+	if len(req.BillIds) > 0 {
+		return slices.Contains(req.BillIds, b.ID)
+	}
+	if req.SessionYear != 0 && int(req.SessionYear) != b.SessionYear {
+		return false
+	}
+	if req.SponsorId != "" && req.SponsorId != b.SponsorID {
+		return false
+	}
+	if req.Followed {
+		return slices.ContainsFunc(follows, func(f domain.Follow) bool {
+			return f.Kind == domain.FollowBill && f.TargetID == b.ID ||
+				f.Kind == domain.FollowLegislator && b.SponsorID != "" && f.TargetID == b.SponsorID
+		})
+	}
+	return true
+}
+
 // toBillPb converts a domain.Bill to its proto representation.
 func toBillPb(b domain.Bill) *pb.Bill {
 	out := &pb.Bill{
@@ -88,3 +253,45 @@ func toBillPb(b domain.Bill) *pb.Bill {
 	}
 	return out
 }
+
+// maskIncludes reports whether a normalized mask selects field or any part of it.
+func maskIncludes(paths []string, field string) bool {
+	for _, p := range paths {
+		if p == field || strings.HasPrefix(p, field+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// applyReadMask clears every field of m not selected by paths, which must be
+// valid for m. Dotted paths such as "sponsor.last_name" select within a
+// message field.
+func applyReadMask(m protoreflect.Message, paths []string) {
+	whole := map[string]bool{}
+	sub := map[string][]string{}
+	for _, p := range paths {
+		head, rest, nested := strings.Cut(p, ".")
+		if nested {
+			sub[head] = append(sub[head], rest)
+		} else {
+			whole[head] = true
+		}
+	}
+
+	var clear []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := string(fd.Name())
+		switch {
+		case whole[name]:
+		case sub[name] != nil && fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			applyReadMask(v.Message(), sub[name])
+		default:
+			clear = append(clear, fd)
+		}
+		return true
+	})
+	for _, fd := range clear {
+		m.Clear(fd)
+	}
+}
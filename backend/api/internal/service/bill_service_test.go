@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/repository/events"
+	"api/internal/repository/memory"
+)
+
+func newBillService(t *testing.T) *BillService {
+	t.Helper()
+	ctx := context.Background()
+	legislators := memory.NewLegislatorRepository()
+	err := legislators.UpsertLegislator(ctx, domain.Legislator{
+		UtahLegislatureID: "SMITHJ", Chamber: "house", DistrictNumber: 1, FirstName: "Jane", LastName: "Smith",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ls, err := legislators.ListLegislators(ctx, repository.LegislatorFilters{})
+	if err != nil || len(ls) != 1 {
+		t.Fatalf("ListLegislators = %v, %v", ls, err)
+	}
+
+	bills := memory.NewBillRepository(legislators)
+	err = bills.UpsertBill(ctx, domain.Bill{
+		BillNumber: "HB0001", BillType: "HB", SessionYear: 2026, Title: "Title", Status: "introduced", SponsorID: ls[0].ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewBillService(bills, memory.NewWatchlistRepository(), events.NewBroker(16))
+}
+
+func TestListBillsReadMask(t *testing.T) {
+	ctx := context.Background()
+	svc := newBillService(t)
+
+	cases := []struct {
+		paths []string
+		want  func(*pb.Bill) bool
+	}{
+		{nil, func(b *pb.Bill) bool {
+			return b.Title == "Title" && b.Sponsor.GetLastName() == "Smith"
+		}},
+		{[]string{"bill_number", "title"}, func(b *pb.Bill) bool {
+			return b.BillNumber == "HB0001" && b.Title == "Title" && b.Id == "" && b.Sponsor == nil
+		}},
+		{[]string{"id", "sponsor.last_name"}, func(b *pb.Bill) bool {
+			return b.Id != "" && b.Title == "" && b.Sponsor.GetLastName() == "Smith" && b.Sponsor.GetFirstName() == ""
+		}},
+	}
+	for _, c := range cases {
+		req := &pb.ListBillsRequest{SessionYear: 2026}
+		if c.paths != nil {
+			req.ReadMask = &fieldmaskpb.FieldMask{Paths: c.paths}
+		}
+		resp, err := svc.ListBills(ctx, req)
+		if err != nil || len(resp.Bills) != 1 {
+			t.Fatalf("ListBills(%v) = %v, %v", c.paths, resp, err)
+		}
+		if !c.want(resp.Bills[0]) {
+			t.Errorf("ListBills(%v) bill = %v", c.paths, resp.Bills[0])
+		}
+	}
+
+	_, err := svc.ListBills(ctx, &pb.ListBillsRequest{ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"nope"}}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("invalid mask error = %v, want InvalidArgument", err)
+	}
+}
+
+// watchStream is the server side of a WatchBills stream.
+type watchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.WatchBillsResponse
+}
+
+func (s *watchStream) Context() context.Context { return s.ctx }
+
+func (s *watchStream) Send(m *pb.WatchBillsResponse) error {
+	s.sent <- m
+	return nil
+}
+
+func TestWatchBills(t *testing.T) {
+	svc := newBillService(t)
+	svc.heartbeat = 10 * time.Millisecond
+	list, err := svc.ListBills(context.Background(), &pb.ListBillsRequest{SessionYear: 2026})
+	if err != nil {
+		t.Fatal(err)
+	}
+	watched := list.Bills[0]
+
+	watch := func(req *pb.WatchBillsRequest) (*watchStream, func() error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := &watchStream{ctx: ctx, sent: make(chan *pb.WatchBillsResponse, 10)}
+		done := make(chan error, 1)
+		go func() { done <- svc.WatchBills(req, stream) }()
+		return stream, func() error {
+			cancel()
+			return <-done
+		}
+	}
+	publish := func(events ...domain.Event) {
+		if err := svc.broker.Publish(context.Background(), events); err != nil {
+			t.Fatal(err)
+		}
+	}
+	update := func(typ, id string, year int) domain.Event {
+		return domain.Event{Type: typ, Bill: &domain.Bill{ID: id, SessionYear: year, Status: "passed"}, Previous: "introduced"}
+	}
+
+	// A stream of bill_ids opens with a snapshot of each, then sends their
+	// status changes and actions.
+	stream, stop := watch(&pb.WatchBillsRequest{BillIds: []string{watched.Id}})
+	if first := <-stream.sent; first.EventType != "snapshot" || first.Bill.GetTitle() != "Title" {
+		t.Errorf("first message = %v, want a snapshot", first)
+	}
+	publish(
+		update(domain.EventBillStatusChanged, "other", 2026),
+		update(domain.EventBillNewVersion, watched.Id, 2026),
+		update(domain.EventBillStatusChanged, watched.Id, 2026),
+	)
+	for m := range stream.sent {
+		if m.EventType == "heartbeat" {
+			continue
+		}
+		if m.EventType != domain.EventBillStatusChanged || m.Bill.Id != watched.Id || m.Previous != "introduced" {
+			t.Errorf("update = %v, want the watched bill's status change", m)
+		}
+		break
+	}
+	if err := stop(); err != nil {
+		t.Errorf("WatchBills after the client left = %v", err)
+	}
+
+	// Filters select bills by session.
+	stream, stop = watch(&pb.WatchBillsRequest{SessionYear: 2025, EventTypes: []string{domain.EventBillNewVersion}})
+	if m := <-stream.sent; m.EventType != "heartbeat" || m.Bill != nil {
+		t.Errorf("idle stream sent %v, want a heartbeat", m)
+	}
+	publish(update(domain.EventBillNewVersion, "b2026", 2026), update(domain.EventBillNewVersion, "b2025", 2025))
+	for m := range stream.sent {
+		if m.EventType == "heartbeat" {
+			continue
+		}
+		if m.Bill.Id != "b2025" {
+			t.Errorf("update = %v, want the 2025 bill", m)
+		}
+		break
+	}
+	stop()
+
+	for _, req := range []*pb.WatchBillsRequest{
+		{EventTypes: []string{domain.EventLegislatorUpdated}},
+		{BillIds: make([]string, maxWatchedBills+1)},
+	} {
+		if err := svc.WatchBills(req, &watchStream{ctx: context.Background()}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("WatchBills(%v) error = %v, want InvalidArgument", req, err)
+		}
+	}
+	if err := svc.WatchBills(&pb.WatchBillsRequest{Followed: true}, &watchStream{ctx: context.Background()}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("anonymous followed WatchBills error = %v, want Unauthenticated", err)
+	}
+}
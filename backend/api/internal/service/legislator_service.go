@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,17 +15,31 @@ import (
 // LegislatorService implements pb.LegislatorServiceServer.
 type LegislatorService struct {
 	pb.UnimplementedLegislatorServiceServer
-	repo repository.LegislatorRepository
+	repo  repository.LegislatorRepository
+	stats repository.StatsRepository
 }
 
 // NewLegislatorService creates a new LegislatorService.
-func NewLegislatorService(repo repository.LegislatorRepository) *LegislatorService {
-	return &LegislatorService{repo: repo}
+func NewLegislatorService(repo repository.LegislatorRepository, stats repository.StatsRepository) *LegislatorService {
+	return &LegislatorService{repo: repo, stats: stats}
 }
 
-// ListLegislators returns all Utah legislators, optionally filtered by chamber.
+// ListLegislators returns Utah legislators, optionally filtered by chamber.
+// Only current members are returned unless include_former or as_of is set.
 func (s *LegislatorService) ListLegislators(ctx context.Context, req *pb.ListLegislatorsRequest) (*pb.ListLegislatorsResponse, error) {
-	legislators, err := s.repo.ListLegislators(ctx, req.Chamber)
+	filters := repository.LegislatorFilters{
+		Chamber:       req.Chamber,
+		IncludeFormer: req.IncludeFormer,
+	}
+	if req.AsOf != "" {
+		asOf, err := parseAsOf(req.AsOf)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "as_of: %v", err)
+		}
+		filters.AsOf = &asOf
+	}
+
+	legislators, err := s.repo.ListLegislators(ctx, filters)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "list legislators: %v", err)
 	}
@@ -54,9 +69,85 @@ func (s *LegislatorService) GetLegislator(ctx context.Context, req *pb.GetLegisl
 	return &pb.GetLegislatorResponse{Legislator: toLegislatorPb(*l)}, nil
 }
 
+// GetLegislatorStats returns a legislator's precomputed voting statistics,
+// one entry per session, newest first.
+func (s *LegislatorService) GetLegislatorStats(ctx context.Context, req *pb.GetLegislatorStatsRequest) (*pb.GetLegislatorStatsResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	l, err := s.repo.GetLegislator(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get legislator: %v", err)
+	}
+	if l == nil {
+		return nil, status.Errorf(codes.NotFound, "legislator %q not found", req.Id)
+	}
+
+	sessions, err := s.stats.GetLegislatorStats(ctx, req.Id, int(req.SessionYear))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get legislator stats: %v", err)
+	}
+
+	// Agreement entries only carry IDs; resolve names for display. Former
+	// members are included since older sessions reference them.
+	names := map[string]domain.Legislator{}
+	if len(sessions) > 0 {
+		all, err := s.repo.ListLegislators(ctx, repository.LegislatorFilters{IncludeFormer: true})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "list legislators: %v", err)
+		}
+		for _, other := range all {
+			names[other.ID] = other
+		}
+	}
+
+	out := make([]*pb.LegislatorSessionStats, 0, len(sessions))
+	for _, st := range sessions {
+		out = append(out, toStatsPb(st, names))
+	}
+	return &pb.GetLegislatorStatsResponse{Sessions: out}, nil
+}
+
+// toStatsPb converts domain.LegislatorStats to its proto representation.
+func toStatsPb(st domain.LegislatorStats, names map[string]domain.Legislator) *pb.LegislatorSessionStats {
+	out := &pb.LegislatorSessionStats{
+		SessionId:         int32(st.SessionID),
+		SessionName:       st.SessionName,
+		SessionYear:       int32(st.SessionYear),
+		EligibleVotes:     int32(st.EligibleVotes),
+		VotesCast:         int32(st.VotesCast),
+		MissedVotes:       int32(st.MissedVotes),
+		ParticipationRate: st.ParticipationRate,
+		VotesAgainstParty: int32(st.VotesAgainstParty),
+		BillsSponsored:    int32(st.BillsSponsored),
+		BillsPassed:       int32(st.BillsPassed),
+		ComputedAt:        st.ComputedAt.Format(time.RFC3339),
+	}
+	for _, a := range st.Agreement {
+		other := names[a.LegislatorID]
+		out.Agreement = append(out.Agreement, &pb.AgreementScore{
+			LegislatorId: a.LegislatorID,
+			FirstName:    other.FirstName,
+			LastName:     other.LastName,
+			SharedVotes:  int32(a.SharedVotes),
+			Rate:         a.Rate,
+		})
+	}
+	return out
+}
+
+// parseAsOf accepts a plain date or an RFC3339 timestamp.
+func parseAsOf(s string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
 // toLegislatorPb converts a domain.Legislator to its proto representation.
 func toLegislatorPb(l domain.Legislator) *pb.Legislator {
-	return &pb.Legislator{
+	out := &pb.Legislator{
 		Id:             l.ID,
 		Chamber:        l.Chamber,
 		DistrictNumber: int32(l.DistrictNumber),
@@ -67,7 +158,31 @@ func toLegislatorPb(l domain.Legislator) *pb.Legislator {
 		Phone:          l.Phone,
 		Website:        l.Website,
 		ImageUrl:       l.ImageURL,
+		TermStart:      formatTime(l.TermStart),
+		TermEnd:        formatTime(l.TermEnd),
+		Current:        l.Current,
+	}
+	for _, t := range l.Terms {
+		start := ""
+		if !t.Start.IsZero() {
+			start = t.Start.Format(time.RFC3339)
+		}
+		out.Terms = append(out.Terms, &pb.Term{
+			Chamber:        t.Chamber,
+			DistrictNumber: int32(t.DistrictNumber),
+			StartDate:      start,
+			EndDate:        formatTime(t.End),
+		})
+	}
+	return out
+}
+
+// formatTime formats an optional time as RFC3339, or "" if nil.
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
 	}
+	return t.Format(time.RFC3339)
 }
 
 // toLegislatorPbPtr is a nil-safe variant for optional sponsor fields.
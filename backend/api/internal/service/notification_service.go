@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/auth"
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// maxDigestInterval bounds how long notifications can be batched for.
+const maxDigestInterval = 7 * 24 * time.Hour
+
+// NotificationService implements pb.NotificationServiceServer.
+type NotificationService struct {
+	pb.UnimplementedNotificationServiceServer
+	notifications  repository.NotificationRepository
+	bills          repository.BillRepository
+	legislators    repository.LegislatorRepository
+	channels       []string
+	vapidPublicKey string
+}
+
+// NewNotificationService creates a new NotificationService. channels are
+// the channels the server can deliver on, and vapidPublicKey is the web
+// push key browsers subscribe with (empty without web push). Subscribed
+// bills and sponsors must exist in bills and legislators.
+func NewNotificationService(notifications repository.NotificationRepository, bills repository.BillRepository, legislators repository.LegislatorRepository, channels []string, vapidPublicKey string) *NotificationService {
+	return &NotificationService{
+		notifications:  notifications,
+		bills:          bills,
+		legislators:    legislators,
+		channels:       channels,
+		vapidPublicKey: vapidPublicKey,
+	}
+}
+
+// GetNotificationSettings returns the caller's settings and what the server
+// can deliver.
+func (s *NotificationService) GetNotificationSettings(ctx context.Context, req *pb.GetNotificationSettingsRequest) (*pb.GetNotificationSettingsResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := s.notifications.GetNotificationSettings(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get notification settings: %v", err)
+	}
+	return &pb.GetNotificationSettingsResponse{
+		Settings:       toNotificationSettingsPb(settings),
+		VapidPublicKey: s.vapidPublicKey,
+		Channels:       s.channels,
+	}, nil
+}
+
+// UpdateNotificationSettings replaces the caller's settings.
+func (s *NotificationService) UpdateNotificationSettings(ctx context.Context, req *pb.UpdateNotificationSettingsRequest) (*pb.UpdateNotificationSettingsResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in := req.GetSettings()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "settings are required")
+	}
+	if in.Email != "" {
+		if _, err := mail.ParseAddress(in.Email); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid email %q", in.Email)
+		}
+	}
+	if in.TimeZone != "" {
+		if _, err := time.LoadLocation(in.TimeZone); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown time zone %q", in.TimeZone)
+		}
+	}
+	for _, m := range []int32{in.QuietStart, in.QuietEnd} {
+		if m < 0 || m >= 24*60 {
+			return nil, status.Error(codes.InvalidArgument, "quiet hours must be minutes after midnight, 0 to 1439")
+		}
+	}
+	digest := time.Duration(in.DigestIntervalMinutes) * time.Minute
+	if digest < 0 || digest > maxDigestInterval {
+		return nil, status.Errorf(codes.InvalidArgument, "digest_interval_minutes must be 0 to %d", int(maxDigestInterval/time.Minute))
+	}
+
+	// Keep when the last digest went out, so changing settings doesn't
+	// send the next one early.
+	stored, err := s.notifications.GetNotificationSettings(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get notification settings: %v", err)
+	}
+	settings := domain.NotificationSettings{
+		UserID:         user.ID,
+		Enabled:        in.Enabled,
+		Email:          in.Email,
+		TimeZone:       in.TimeZone,
+		QuietStart:     int(in.QuietStart),
+		QuietEnd:       int(in.QuietEnd),
+		DigestInterval: digest,
+		LastDigestAt:   stored.LastDigestAt,
+	}
+	if err := s.notifications.SaveNotificationSettings(ctx, settings); err != nil {
+		return nil, status.Errorf(codes.Internal, "save notification settings: %v", err)
+	}
+	return &pb.UpdateNotificationSettingsResponse{Settings: toNotificationSettingsPb(settings)}, nil
+}
+
+// CreateSubscription adds a subscription for the caller.
+func (s *NotificationService) CreateSubscription(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.CreateSubscriptionResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in := req.GetSubscription()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "subscription is required")
+	}
+	for _, t := range in.EventTypes {
+		if t != domain.EventBillStatusChanged && t != domain.EventBillAction {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown event type %q", t)
+		}
+	}
+	if err := validChannels(in.Channels); err != nil {
+		return nil, err
+	}
+	if in.BillId != "" {
+		b, err := s.bills.GetBill(ctx, in.BillId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "get bill: %v", err)
+		}
+		if b == nil {
+			return nil, status.Errorf(codes.NotFound, "bill %q not found", in.BillId)
+		}
+	}
+	if in.SponsorId != "" {
+		l, err := s.legislators.GetLegislator(ctx, in.SponsorId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "get legislator: %v", err)
+		}
+		if l == nil {
+			return nil, status.Errorf(codes.NotFound, "legislator %q not found", in.SponsorId)
+		}
+	}
+
+	sub, err := s.notifications.SaveSubscription(ctx, domain.NotificationSubscription{
+		UserID:     user.ID,
+		EventTypes: in.EventTypes,
+		BillID:     in.BillId,
+		SponsorID:  in.SponsorId,
+		Followed:   in.Followed,
+		Channels:   in.Channels,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "save subscription: %v", err)
+	}
+	return &pb.CreateSubscriptionResponse{Subscription: toSubscriptionPb(sub)}, nil
+}
+
+// ListSubscriptions returns the caller's subscriptions, oldest first.
+func (s *NotificationService) ListSubscriptions(ctx context.Context, req *pb.ListSubscriptionsRequest) (*pb.ListSubscriptionsResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	subs, err := s.notifications.ListSubscriptions(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list subscriptions: %v", err)
+	}
+	out := make([]*pb.NotificationSubscription, 0, len(subs))
+	for _, sub := range subs {
+		out = append(out, toSubscriptionPb(sub))
+	}
+	return &pb.ListSubscriptionsResponse{Subscriptions: out}, nil
+}
+
+// DeleteSubscription removes one of the caller's subscriptions, if it
+// exists.
+func (s *NotificationService) DeleteSubscription(ctx context.Context, req *pb.DeleteSubscriptionRequest) (*pb.DeleteSubscriptionResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if err := s.notifications.DeleteSubscription(ctx, user.ID, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete subscription: %v", err)
+	}
+	return &pb.DeleteSubscriptionResponse{}, nil
+}
+
+// RegisterDevice adds a push destination for the caller.
+func (s *NotificationService) RegisterDevice(ctx context.Context, req *pb.RegisterDeviceRequest) (*pb.RegisterDeviceResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token := strings.TrimSpace(req.Token)
+	switch req.Channel {
+	case domain.ChannelWebPush:
+		u, err := url.Parse(token)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return nil, status.Error(codes.InvalidArgument, "token must be the push subscription's https endpoint")
+		}
+		if req.P256Dh == "" || req.Auth == "" {
+			return nil, status.Error(codes.InvalidArgument, "p256dh and auth are required for web push")
+		}
+	case domain.ChannelFCM:
+		if token == "" {
+			return nil, status.Error(codes.InvalidArgument, "token is required")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "channel must be %q or %q", domain.ChannelWebPush, domain.ChannelFCM)
+	}
+
+	_, err = s.notifications.SaveDevice(ctx, domain.Device{
+		UserID:  user.ID,
+		Channel: req.Channel,
+		Token:   token,
+		P256dh:  req.P256Dh,
+		Auth:    req.Auth,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "register device: %v", err)
+	}
+	return &pb.RegisterDeviceResponse{}, nil
+}
+
+// UnregisterDevice removes one of the caller's push destinations.
+func (s *NotificationService) UnregisterDevice(ctx context.Context, req *pb.UnregisterDeviceRequest) (*pb.UnregisterDeviceResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.notifications.DeleteDevice(ctx, user.ID, req.Channel, strings.TrimSpace(req.Token)); err != nil {
+		return nil, status.Errorf(codes.Internal, "unregister device: %v", err)
+	}
+	return &pb.UnregisterDeviceResponse{}, nil
+}
+
+// validChannels rejects unknown notification channels.
+func validChannels(channels []string) error {
+	known := []string{domain.ChannelEmail, domain.ChannelWebPush, domain.ChannelFCM}
+	for _, c := range channels {
+		if !slices.Contains(known, c) {
+			return status.Errorf(codes.InvalidArgument, "unknown channel %q", c)
+		}
+	}
+	return nil
+}
+
+// toNotificationSettingsPb converts domain.NotificationSettings to their
+// proto representation.
+func toNotificationSettingsPb(s domain.NotificationSettings) *pb.NotificationSettings {
+	return &pb.NotificationSettings{
+		Enabled:               s.Enabled,
+		Email:                 s.Email,
+		TimeZone:              s.TimeZone,
+		QuietStart:            int32(s.QuietStart),
+		QuietEnd:              int32(s.QuietEnd),
+		DigestIntervalMinutes: int32(s.DigestInterval / time.Minute),
+	}
+}
+
+// toSubscriptionPb converts a domain.NotificationSubscription to its proto
+// representation.
+func toSubscriptionPb(s domain.NotificationSubscription) *pb.NotificationSubscription {
+	return &pb.NotificationSubscription{
+		Id:         s.ID,
+		EventTypes: s.EventTypes,
+		BillId:     s.BillID,
+		SponsorId:  s.SponsorID,
+		Followed:   s.Followed,
+		Channels:   s.Channels,
+		CreatedAt:  s.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/auth"
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/repository/memory"
+)
+
+func TestNotifications(t *testing.T) {
+	ctx := context.Background()
+	legislators := memory.NewLegislatorRepository()
+	bills := memory.NewBillRepository(legislators)
+	repo := memory.NewNotificationRepository()
+	if err := bills.UpsertBill(ctx, domain.Bill{BillNumber: "HB0001", SessionYear: 2026, Title: "Bill"}); err != nil {
+		t.Fatal(err)
+	}
+	stored, err := bills.ListBills(ctx, repository.BillFilters{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	billID := stored[0].ID
+
+	svc := NewNotificationService(repo, bills, legislators, []string{domain.ChannelEmail}, "vapid-key")
+	if _, err := svc.GetNotificationSettings(ctx, &pb.GetNotificationSettingsRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("anonymous GetNotificationSettings error = %v, want Unauthenticated", err)
+	}
+	user := auth.NewContext(ctx, auth.User{ID: "user-1", Role: "authenticated"})
+
+	got, err := svc.GetNotificationSettings(user, &pb.GetNotificationSettingsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Settings.Enabled || got.VapidPublicKey != "vapid-key" || len(got.Channels) != 1 {
+		t.Errorf("default settings = %v", got)
+	}
+
+	// Updating settings keeps when the last digest was sent.
+	last := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	if err := repo.SaveNotificationSettings(ctx, domain.NotificationSettings{UserID: "user-1", LastDigestAt: &last}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = svc.UpdateNotificationSettings(user, &pb.UpdateNotificationSettingsRequest{Settings: &pb.NotificationSettings{
+		Enabled: true, Email: "me@example.com", TimeZone: "America/Denver", QuietStart: 1320, QuietEnd: 420, DigestIntervalMinutes: 60,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved, _ := repo.GetNotificationSettings(ctx, "user-1")
+	if saved.Email != "me@example.com" || saved.DigestInterval != time.Hour || saved.LastDigestAt == nil {
+		t.Errorf("saved settings = %+v", saved)
+	}
+	for _, bad := range []*pb.NotificationSettings{
+		{Email: "not an address"},
+		{TimeZone: "Mars/Olympus"},
+		{QuietStart: 1440},
+		{DigestIntervalMinutes: -1},
+	} {
+		_, err := svc.UpdateNotificationSettings(user, &pb.UpdateNotificationSettingsRequest{Settings: bad})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("UpdateNotificationSettings(%v) error = %v, want InvalidArgument", bad, err)
+		}
+	}
+
+	for _, tc := range []struct {
+		sub  *pb.NotificationSubscription
+		code codes.Code
+	}{
+		{&pb.NotificationSubscription{BillId: billID, EventTypes: []string{domain.EventBillStatusChanged}}, codes.OK},
+		{&pb.NotificationSubscription{Followed: true, Channels: []string{domain.ChannelFCM}}, codes.OK},
+		{&pb.NotificationSubscription{BillId: "missing"}, codes.NotFound},
+		{&pb.NotificationSubscription{SponsorId: "missing"}, codes.NotFound},
+		{&pb.NotificationSubscription{EventTypes: []string{"bill.vetoed"}}, codes.InvalidArgument},
+		{&pb.NotificationSubscription{Channels: []string{"sms"}}, codes.InvalidArgument},
+	} {
+		_, err := svc.CreateSubscription(user, &pb.CreateSubscriptionRequest{Subscription: tc.sub})
+		if status.Code(err) != tc.code {
+			t.Errorf("CreateSubscription(%v) error = %v, want %s", tc.sub, err, tc.code)
+		}
+	}
+	subs, err := svc.ListSubscriptions(user, &pb.ListSubscriptionsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subs.Subscriptions) != 2 || subs.Subscriptions[0].BillId != billID {
+		t.Fatalf("subscriptions = %v", subs.Subscriptions)
+	}
+	if _, err := svc.DeleteSubscription(user, &pb.DeleteSubscriptionRequest{Id: subs.Subscriptions[0].Id}); err != nil {
+		t.Fatal(err)
+	}
+	if subs, _ = svc.ListSubscriptions(user, &pb.ListSubscriptionsRequest{}); len(subs.Subscriptions) != 1 {
+		t.Errorf("subscriptions after delete = %v", subs.Subscriptions)
+	}
+
+	for _, tc := range []struct {
+		req  *pb.RegisterDeviceRequest
+		code codes.Code
+	}{
+		{&pb.RegisterDeviceRequest{Channel: domain.ChannelWebPush, Token: "https://push.example/1", P256Dh: "k", Auth: "a"}, codes.OK},
+		{&pb.RegisterDeviceRequest{Channel: domain.ChannelFCM, Token: "fcm-token"}, codes.OK},
+		{&pb.RegisterDeviceRequest{Channel: domain.ChannelWebPush, Token: "http://push.example/1", P256Dh: "k", Auth: "a"}, codes.InvalidArgument},
+		{&pb.RegisterDeviceRequest{Channel: domain.ChannelWebPush, Token: "https://push.example/2"}, codes.InvalidArgument},
+		{&pb.RegisterDeviceRequest{Channel: domain.ChannelEmail, Token: "me@example.com"}, codes.InvalidArgument},
+	} {
+		if _, err := svc.RegisterDevice(user, tc.req); status.Code(err) != tc.code {
+			t.Errorf("RegisterDevice(%v) error = %v, want %s", tc.req, err, tc.code)
+		}
+	}
+	if _, err := svc.UnregisterDevice(user, &pb.UnregisterDeviceRequest{Channel: domain.ChannelFCM, Token: "fcm-token"}); err != nil {
+		t.Fatal(err)
+	}
+	if devices, _ := repo.ListDevices(ctx, "user-1"); len(devices) != 1 || devices[0].Channel != domain.ChannelWebPush {
+		t.Errorf("devices = %+v, want the web push subscription", devices)
+	}
+}
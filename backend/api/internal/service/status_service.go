@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/calendar"
+)
+
+// StatusService implements pb.StatusServiceServer.
+type StatusService struct {
+	pb.UnimplementedStatusServiceServer
+	calendar *calendar.Calendar
+	now      func() time.Time
+}
+
+// NewStatusService creates a new StatusService reporting on cal.
+func NewStatusService(cal *calendar.Calendar) *StatusService {
+	return &StatusService{calendar: cal, now: time.Now}
+}
+
+// GetStatus returns the ingestion mode and the current and next sessions.
+func (s *StatusService) GetStatus(ctx context.Context, req *pb.GetStatusRequest) (*pb.GetStatusResponse, error) {
+	now := s.now()
+	mode := s.calendar.Mode(now)
+	resp := &pb.GetStatusResponse{
+		Mode:                    string(mode),
+		NextSession:             sessionToProto(s.calendar.Next(now)),
+		BillPollIntervalSeconds: int64(calendar.PollInterval(mode) / time.Second),
+	}
+	if cur, ok := s.calendar.Current(now); ok {
+		resp.CurrentSession = sessionToProto(cur)
+	}
+	return resp, nil
+}
+
+func sessionToProto(s calendar.Session) *pb.Session {
+	return &pb.Session{
+		Name:      s.Name,
+		Kind:      s.Kind,
+		StartDate: s.Start.Format(time.DateOnly),
+		EndDate:   s.End.Format(time.DateOnly),
+	}
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/calendar"
+)
+
+func TestGetStatus(t *testing.T) {
+	s := NewStatusService(calendar.New(nil))
+
+	s.now = func() time.Time { return time.Date(2026, time.February, 10, 12, 0, 0, 0, calendar.Mountain) }
+	resp, err := s.GetStatus(context.Background(), &pb.GetStatusRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Mode != "in_session" || resp.BillPollIntervalSeconds != 3600 {
+		t.Errorf("mode = %s every %ds, want in_session every 3600s", resp.Mode, resp.BillPollIntervalSeconds)
+	}
+	if cur := resp.CurrentSession; cur == nil || cur.Name != "2026GS" || cur.StartDate != "2026-01-20" || cur.EndDate != "2026-03-06" {
+		t.Errorf("current session = %v, want 2026GS from 2026-01-20 to 2026-03-06", cur)
+	}
+	if resp.NextSession.GetName() != "2027GS" {
+		t.Errorf("next session = %s, want 2027GS", resp.NextSession.GetName())
+	}
+
+	s.now = func() time.Time { return time.Date(2026, time.October, 18, 12, 0, 0, 0, calendar.Mountain) }
+	resp, err = s.GetStatus(context.Background(), &pb.GetStatusRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Mode != "dormant" || resp.CurrentSession != nil {
+		t.Errorf("mode = %s, current session = %v; want dormant with no session", resp.Mode, resp.CurrentSession)
+	}
+}
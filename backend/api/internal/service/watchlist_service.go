@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/auth"
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// Committees and the Utah Code aren't ingested, so their follows are only
+// checked for form: committee codes such as "HSTHHS", and code titles such
+// as "10" or "63G".
+var (
+	committeeCode = regexp.MustCompile(`^[A-Z0-9]{2,16}$`)
+	codeTitle     = regexp.MustCompile(`^[0-9]{1,2}[A-Z]?$`)
+)
+
+// WatchlistService implements pb.WatchlistServiceServer.
+type WatchlistService struct {
+	pb.UnimplementedWatchlistServiceServer
+	follows     repository.WatchlistRepository
+	bills       repository.BillRepository
+	legislators repository.LegislatorRepository
+}
+
+// NewWatchlistService creates a new WatchlistService. Followed bills and
+// legislators must exist in bills and legislators.
+func NewWatchlistService(follows repository.WatchlistRepository, bills repository.BillRepository, legislators repository.LegislatorRepository) *WatchlistService {
+	return &WatchlistService{follows: follows, bills: bills, legislators: legislators}
+}
+
+// Follow adds an item to the caller's watchlist.
+func (s *WatchlistService) Follow(ctx context.Context, req *pb.FollowRequest) (*pb.FollowResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target, err := followTarget(req.Kind, req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkExists(ctx, req.Kind, target); err != nil {
+		return nil, err
+	}
+
+	f, err := s.follows.Follow(ctx, domain.Follow{UserID: user.ID, Kind: req.Kind, TargetID: target})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "follow: %v", err)
+	}
+	return &pb.FollowResponse{Follow: toFollowPb(f)}, nil
+}
+
+// Unfollow removes an item from the caller's watchlist.
+func (s *WatchlistService) Unfollow(ctx context.Context, req *pb.UnfollowRequest) (*pb.UnfollowResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target, err := followTarget(req.Kind, req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.follows.Unfollow(ctx, user.ID, req.Kind, target); err != nil {
+		return nil, status.Errorf(codes.Internal, "unfollow: %v", err)
+	}
+	return &pb.UnfollowResponse{}, nil
+}
+
+// ListFollows returns the caller's watchlist, newest first.
+func (s *WatchlistService) ListFollows(ctx context.Context, req *pb.ListFollowsRequest) (*pb.ListFollowsResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Kind != "" {
+		if err := validFollowKind(req.Kind); err != nil {
+			return nil, err
+		}
+	}
+
+	follows, err := s.follows.ListFollows(ctx, user.ID, req.Kind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list follows: %v", err)
+	}
+	out := make([]*pb.Follow, 0, len(follows))
+	for _, f := range follows {
+		out = append(out, toFollowPb(f))
+	}
+	return &pb.ListFollowsResponse{Follows: out}, nil
+}
+
+// validFollowKind rejects unknown kinds of follow.
+func validFollowKind(kind string) error {
+	switch kind {
+	case domain.FollowBill, domain.FollowLegislator, domain.FollowCommittee, domain.FollowCodeTitle:
+		return nil
+	}
+	return status.Errorf(codes.InvalidArgument, "kind must be %q, %q, %q or %q",
+		domain.FollowBill, domain.FollowLegislator, domain.FollowCommittee, domain.FollowCodeTitle)
+}
+
+// followTarget validates a follow's kind and target and returns the target
+// ID in canonical form.
+func followTarget(kind, target string) (string, error) {
+	if err := validFollowKind(kind); err != nil {
+		return "", err
+	}
+	target = strings.TrimSpace(target)
+	if target == "" {
+		return "", status.Error(codes.InvalidArgument, "target_id is required")
+	}
+	switch kind {
+	case domain.FollowCommittee:
+		target = strings.ToUpper(target)
+		if !committeeCode.MatchString(target) {
+			return "", status.Errorf(codes.InvalidArgument, "invalid committee code %q", target)
+		}
+	case domain.FollowCodeTitle:
+		target = strings.ToUpper(target)
+		if !codeTitle.MatchString(target) {
+			return "", status.Errorf(codes.InvalidArgument, "invalid Utah Code title %q", target)
+		}
+	}
+	return target, nil
+}
+
+// checkExists rejects follows of bills and legislators that aren't stored.
+func (s *WatchlistService) checkExists(ctx context.Context, kind, id string) error {
+	switch kind {
+	case domain.FollowBill:
+		b, err := s.bills.GetBill(ctx, id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "get bill: %v", err)
+		}
+		if b == nil {
+			return status.Errorf(codes.NotFound, "bill %q not found", id)
+		}
+	case domain.FollowLegislator:
+		l, err := s.legislators.GetLegislator(ctx, id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "get legislator: %v", err)
+		}
+		if l == nil {
+			return status.Errorf(codes.NotFound, "legislator %q not found", id)
+		}
+	}
+	return nil
+}
+
+// toFollowPb converts a domain.Follow to its proto representation.
+func toFollowPb(f domain.Follow) *pb.Follow {
+	return &pb.Follow{
+		Kind:      f.Kind,
+		TargetId:  f.TargetID,
+		CreatedAt: f.CreatedAt.Format(time.RFC3339),
+	}
+}
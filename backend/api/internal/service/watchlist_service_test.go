@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/auth"
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/repository/memory"
+)
+
+func TestWatchlist(t *testing.T) {
+	ctx := context.Background()
+	legislators := memory.NewLegislatorRepository()
+	bills := memory.NewBillRepository(legislators)
+	follows := memory.NewWatchlistRepository()
+	for i, number := range []string{"HB0001", "HB0002", "HB0003"} {
+		d := time.Date(2025+i%2, time.February, i+1, 0, 0, 0, 0, time.UTC)
+		err := bills.UpsertBill(ctx, domain.Bill{BillNumber: number, SessionYear: d.Year(), Title: number, LastActionDate: &d})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	ids := map[string]string{}
+	all, err := bills.ListBills(ctx, repository.BillFilters{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range all {
+		ids[b.BillNumber] = b.ID
+	}
+
+	watchlist := NewWatchlistService(follows, bills, legislators)
+	billService := NewBillService(bills, follows, nil)
+
+	if _, err := watchlist.ListFollows(ctx, &pb.ListFollowsRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("anonymous ListFollows error = %v, want Unauthenticated", err)
+	}
+	if _, err := billService.ListBills(ctx, &pb.ListBillsRequest{Followed: true}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("anonymous feed error = %v, want Unauthenticated", err)
+	}
+
+	user := auth.NewContext(ctx, auth.User{ID: "user-1", Role: "authenticated"})
+	for _, req := range []*pb.FollowRequest{
+		{Kind: domain.FollowBill, TargetId: ids["HB0001"]},
+		{Kind: domain.FollowBill, TargetId: ids["HB0002"]},
+		{Kind: domain.FollowCodeTitle, TargetId: "63g"},
+		{Kind: domain.FollowCommittee, TargetId: "HSTHHS"},
+	} {
+		if _, err := watchlist.Follow(user, req); err != nil {
+			t.Fatalf("Follow(%v): %v", req, err)
+		}
+	}
+	for _, tc := range []struct {
+		req  *pb.FollowRequest
+		code codes.Code
+	}{
+		{&pb.FollowRequest{Kind: domain.FollowBill, TargetId: "missing"}, codes.NotFound},
+		{&pb.FollowRequest{Kind: domain.FollowLegislator, TargetId: "missing"}, codes.NotFound},
+		{&pb.FollowRequest{Kind: domain.FollowCodeTitle, TargetId: "title 63"}, codes.InvalidArgument},
+		{&pb.FollowRequest{Kind: "party", TargetId: "x"}, codes.InvalidArgument},
+		{&pb.FollowRequest{Kind: domain.FollowBill}, codes.InvalidArgument},
+	} {
+		if _, err := watchlist.Follow(user, tc.req); status.Code(err) != tc.code {
+			t.Errorf("Follow(%v) error = %v, want %s", tc.req, err, tc.code)
+		}
+	}
+
+	resp, err := watchlist.ListFollows(user, &pb.ListFollowsRequest{Kind: domain.FollowCodeTitle})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Follows) != 1 || resp.Follows[0].TargetId != "63G" {
+		t.Errorf("code title follows = %v, want 63G", resp.Follows)
+	}
+
+	// The feed spans sessions, most recent action first.
+	feed, err := billService.ListBills(user, &pb.ListBillsRequest{Followed: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(feed.Bills) != 2 || feed.Bills[0].BillNumber != "HB0002" || feed.Bills[1].BillNumber != "HB0001" {
+		t.Errorf("feed = %v, want HB0002 then HB0001", feed.Bills)
+	}
+
+	if _, err := watchlist.Unfollow(user, &pb.UnfollowRequest{Kind: domain.FollowBill, TargetId: ids["HB0002"]}); err != nil {
+		t.Fatal(err)
+	}
+	feed, err = billService.ListBills(user, &pb.ListBillsRequest{Followed: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(feed.Bills) != 1 || feed.Bills[0].BillNumber != "HB0001" {
+		t.Errorf("feed after Unfollow = %v, want HB0001", feed.Bills)
+	}
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/auth"
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/webhook"
+)
+
+// Limits on what users can set up.
+const (
+	maxWebhooksPerUser = 10
+	maxWebhookKeywords = 20
+	maxKeywordLength   = 100
+	maxWebhookURL      = 2000
+	maxDeliveryLimit   = 500
+)
+
+// WebhookService implements pb.WebhookServiceServer.
+type WebhookService struct {
+	pb.UnimplementedWebhookServiceServer
+	webhooks    repository.WebhookRepository
+	bills       repository.BillRepository
+	legislators repository.LegislatorRepository
+}
+
+// NewWebhookService creates a new WebhookService. Filtered bills and
+// sponsors must exist in bills and legislators.
+func NewWebhookService(webhooks repository.WebhookRepository, bills repository.BillRepository, legislators repository.LegislatorRepository) *WebhookService {
+	return &WebhookService{webhooks: webhooks, bills: bills, legislators: legislators}
+}
+
+// CreateWebhook adds a webhook for the caller and returns its signing
+// secret.
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in := req.GetWebhook()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "webhook is required")
+	}
+	target := strings.TrimSpace(in.Url)
+	u, err := url.Parse(target)
+	if err != nil || u.Scheme != "https" || u.Host == "" || len(target) > maxWebhookURL {
+		return nil, status.Error(codes.InvalidArgument, "url must be an https URL")
+	}
+	for _, t := range in.EventTypes {
+		if !slices.Contains(webhook.EventTypes, t) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown event type %q", t)
+		}
+	}
+	var keywords []string
+	for _, k := range in.Keywords {
+		if k = strings.TrimSpace(k); k == "" {
+			continue
+		}
+		if len(k) > maxKeywordLength {
+			return nil, status.Errorf(codes.InvalidArgument, "keywords must be at most %d bytes", maxKeywordLength)
+		}
+		keywords = append(keywords, k)
+	}
+	if len(keywords) > maxWebhookKeywords {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d keywords", maxWebhookKeywords)
+	}
+	if in.BillId != "" {
+		b, err := s.bills.GetBill(ctx, in.BillId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "get bill: %v", err)
+		}
+		if b == nil {
+			return nil, status.Errorf(codes.NotFound, "bill %q not found", in.BillId)
+		}
+	}
+	if in.SponsorId != "" {
+		l, err := s.legislators.GetLegislator(ctx, in.SponsorId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "get legislator: %v", err)
+		}
+		if l == nil {
+			return nil, status.Errorf(codes.NotFound, "legislator %q not found", in.SponsorId)
+		}
+	}
+
+	existing, err := s.webhooks.ListWebhooks(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list webhooks: %v", err)
+	}
+	if len(existing) >= maxWebhooksPerUser {
+		return nil, status.Errorf(codes.FailedPrecondition, "at most %d webhooks per account", maxWebhooksPerUser)
+	}
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	w, err := s.webhooks.CreateWebhook(ctx, domain.Webhook{
+		OwnerID:    user.ID,
+		URL:        target,
+		Secret:     secret,
+		EventTypes: in.EventTypes,
+		BillID:     in.BillId,
+		SponsorID:  in.SponsorId,
+		Keywords:   keywords,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "create webhook: %v", err)
+	}
+	return &pb.CreateWebhookResponse{Webhook: toWebhookPb(w), Secret: secret}, nil
+}
+
+// ListWebhooks returns the caller's webhooks, oldest first.
+func (s *WebhookService) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hooks, err := s.webhooks.ListWebhooks(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list webhooks: %v", err)
+	}
+	out := make([]*pb.Webhook, 0, len(hooks))
+	for _, w := range hooks {
+		out = append(out, toWebhookPb(w))
+	}
+	return &pb.ListWebhooksResponse{Webhooks: out}, nil
+}
+
+// DeleteWebhook removes one of the caller's webhooks, if it exists.
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if err := s.webhooks.DeleteWebhook(ctx, user.ID, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete webhook: %v", err)
+	}
+	return &pb.DeleteWebhookResponse{}, nil
+}
+
+// ListWebhookDeliveries returns the delivery log of one of the caller's
+// webhooks, newest first.
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 || req.Limit > maxDeliveryLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be 0 to %d", maxDeliveryLimit)
+	}
+	if _, err := s.ownWebhook(ctx, user.ID, req.WebhookId); err != nil {
+		return nil, err
+	}
+	deliveries, err := s.webhooks.ListDeliveries(ctx, req.WebhookId, int(req.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list webhook deliveries: %v", err)
+	}
+	out := make([]*pb.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		out = append(out, toWebhookDeliveryPb(d))
+	}
+	return &pb.ListWebhookDeliveriesResponse{Deliveries: out}, nil
+}
+
+// ReplayWebhookDelivery queues a delivery's payload to be sent again.
+func (s *WebhookService) ReplayWebhookDelivery(ctx context.Context, req *pb.ReplayWebhookDeliveryRequest) (*pb.ReplayWebhookDeliveryResponse, error) {
+	user, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.ownWebhook(ctx, user.ID, req.WebhookId); err != nil {
+		return nil, err
+	}
+	d, err := s.webhooks.GetDelivery(ctx, req.DeliveryId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get webhook delivery: %v", err)
+	}
+	if d == nil || d.WebhookID != req.WebhookId {
+		return nil, status.Errorf(codes.NotFound, "delivery %q not found", req.DeliveryId)
+	}
+	queued, err := s.webhooks.EnqueueDeliveries(ctx, []domain.WebhookDelivery{{
+		WebhookID:     d.WebhookID,
+		EventID:       d.EventID,
+		EventType:     d.EventType,
+		Payload:       d.Payload,
+		Status:        domain.DeliveryPending,
+		NextAttemptAt: time.Now().UTC(),
+		ReplayOf:      d.ID,
+	}})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "replay webhook delivery: %v", err)
+	}
+	return &pb.ReplayWebhookDeliveryResponse{Delivery: toWebhookDeliveryPb(queued[0])}, nil
+}
+
+// ownWebhook returns the webhook with id if it belongs to userID, and
+// NotFound otherwise, so other users' webhooks can't be probed.
+func (s *WebhookService) ownWebhook(ctx context.Context, userID, id string) (*domain.Webhook, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "webhook_id is required")
+	}
+	w, err := s.webhooks.GetWebhook(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get webhook: %v", err)
+	}
+	if w == nil || w.OwnerID != userID {
+		return nil, status.Errorf(codes.NotFound, "webhook %q not found", id)
+	}
+	return w, nil
+}
+
+// toWebhookPb converts a domain.Webhook to its proto representation,
+// without the secret.
+func toWebhookPb(w domain.Webhook) *pb.Webhook {
+	return &pb.Webhook{
+		Id:         w.ID,
+		Url:        w.URL,
+		EventTypes: w.EventTypes,
+		BillId:     w.BillID,
+		SponsorId:  w.SponsorID,
+		Keywords:   w.Keywords,
+		CreatedAt:  w.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// toWebhookDeliveryPb converts a domain.WebhookDelivery to its proto
+// representation.
+func toWebhookDeliveryPb(d domain.WebhookDelivery) *pb.WebhookDelivery {
+	out := &pb.WebhookDelivery{
+		Id:             d.ID,
+		WebhookId:      d.WebhookID,
+		EventId:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        string(d.Payload),
+		Status:         d.Status,
+		Attempts:       int32(d.Attempts),
+		ResponseStatus: int32(d.ResponseStatus),
+		LastError:      d.LastError,
+		ReplayOf:       d.ReplayOf,
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+	}
+	if d.Status == domain.DeliveryPending {
+		out.NextAttemptAt = d.NextAttemptAt.Format(time.RFC3339)
+	}
+	if d.LastAttemptAt != nil {
+		out.LastAttemptAt = d.LastAttemptAt.Format(time.RFC3339)
+	}
+	return out
+}
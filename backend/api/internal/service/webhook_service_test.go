@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/auth"
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/repository/memory"
+)
+
+func TestWebhooks(t *testing.T) {
+	ctx := context.Background()
+	legislators := memory.NewLegislatorRepository()
+	bills := memory.NewBillRepository(legislators)
+	repo := memory.NewWebhookRepository()
+	if err := bills.UpsertBill(ctx, domain.Bill{BillNumber: "HB0001", SessionYear: 2026, Title: "Bill"}); err != nil {
+		t.Fatal(err)
+	}
+	stored, err := bills.ListBills(ctx, repository.BillFilters{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	billID := stored[0].ID
+
+	svc := NewWebhookService(repo, bills, legislators)
+	if _, err := svc.ListWebhooks(ctx, &pb.ListWebhooksRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("anonymous ListWebhooks error = %v, want Unauthenticated", err)
+	}
+	user := auth.NewContext(ctx, auth.User{ID: "user-1", Role: "authenticated"})
+	other := auth.NewContext(ctx, auth.User{ID: "user-2", Role: "authenticated"})
+
+	for _, tc := range []struct {
+		hook *pb.Webhook
+		code codes.Code
+	}{
+		{&pb.Webhook{Url: "http://hooks.example.org"}, codes.InvalidArgument},
+		{&pb.Webhook{Url: "https://hooks.example.org", EventTypes: []string{"bill.vetoed"}}, codes.InvalidArgument},
+		{&pb.Webhook{Url: "https://hooks.example.org", Keywords: []string{strings.Repeat("x", 101)}}, codes.InvalidArgument},
+		{&pb.Webhook{Url: "https://hooks.example.org", BillId: "missing"}, codes.NotFound},
+		{&pb.Webhook{Url: "https://hooks.example.org", SponsorId: "missing"}, codes.NotFound},
+	} {
+		if _, err := svc.CreateWebhook(user, &pb.CreateWebhookRequest{Webhook: tc.hook}); status.Code(err) != tc.code {
+			t.Errorf("CreateWebhook(%v) error = %v, want %s", tc.hook, err, tc.code)
+		}
+	}
+
+	created, err := svc.CreateWebhook(user, &pb.CreateWebhookRequest{Webhook: &pb.Webhook{
+		Url: "https://hooks.example.org/utah", BillId: billID, Keywords: []string{" water ", ""},
+		EventTypes: []string{domain.EventBillNewVersion, domain.EventLegislatorUpdated},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(created.Secret, "whsec_") || created.Webhook.Id == "" || len(created.Webhook.Keywords) != 1 || created.Webhook.Keywords[0] != "water" {
+		t.Errorf("CreateWebhook = %v", created)
+	}
+	hookID := created.Webhook.Id
+	if list, _ := svc.ListWebhooks(other, &pb.ListWebhooksRequest{}); len(list.Webhooks) != 0 {
+		t.Errorf("other user's webhooks = %v", list.Webhooks)
+	}
+
+	// Deliveries are listed and replayed only by the owner.
+	queued, err := repo.EnqueueDeliveries(ctx, []domain.WebhookDelivery{{
+		WebhookID: hookID, EventID: "evt_1", EventType: domain.EventBillNewVersion, Payload: []byte(`{"id":"evt_1"}`),
+		Status: domain.DeliveryFailed, Attempts: 10, NextAttemptAt: time.Now(),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.ListWebhookDeliveries(other, &pb.ListWebhookDeliveriesRequest{WebhookId: hookID}); status.Code(err) != codes.NotFound {
+		t.Errorf("other user's ListWebhookDeliveries error = %v, want NotFound", err)
+	}
+	_, err = svc.ReplayWebhookDelivery(user, &pb.ReplayWebhookDeliveryRequest{WebhookId: hookID, DeliveryId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("ReplayWebhookDelivery(missing) error = %v, want NotFound", err)
+	}
+	replay, err := svc.ReplayWebhookDelivery(user, &pb.ReplayWebhookDeliveryRequest{WebhookId: hookID, DeliveryId: queued[0].ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := replay.Delivery; d.ReplayOf != queued[0].ID || d.Status != domain.DeliveryPending || d.Attempts != 0 ||
+		d.EventId != "evt_1" || d.Payload != `{"id":"evt_1"}` {
+		t.Errorf("replay = %v", d)
+	}
+	log, err := svc.ListWebhookDeliveries(user, &pb.ListWebhookDeliveriesRequest{WebhookId: hookID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log.Deliveries) != 2 || log.Deliveries[0].Id != replay.Delivery.Id || log.Deliveries[1].NextAttemptAt != "" {
+		t.Errorf("deliveries = %v, want the replay, then the failed original", log.Deliveries)
+	}
+
+	// Only the owner can delete.
+	if _, err := svc.DeleteWebhook(other, &pb.DeleteWebhookRequest{Id: hookID}); err != nil {
+		t.Fatal(err)
+	}
+	if list, _ := svc.ListWebhooks(user, &pb.ListWebhooksRequest{}); len(list.Webhooks) != 1 {
+		t.Errorf("webhooks after another user's delete = %v", list.Webhooks)
+	}
+	if _, err := svc.DeleteWebhook(user, &pb.DeleteWebhookRequest{Id: hookID}); err != nil {
+		t.Fatal(err)
+	}
+	if list, _ := svc.ListWebhooks(user, &pb.ListWebhooksRequest{}); len(list.Webhooks) != 0 {
+		t.Errorf("webhooks after delete = %v", list.Webhooks)
+	}
+}
@@ -0,0 +1,352 @@
+// Package legiscan provides a client for the LegiScan API, used for
+// per-member roll-call votes that the official Utah API does not publish.
+//
+// Set the API key via the LEGISCAN_API_KEY environment variable. The free
+// tier allows 30,000 queries per month, so this client reads whole-session
+// datasets (one query for the list, one per changed session) instead of
+// walking bills and roll calls one request at a time. LegiScan regenerates
+// datasets roughly weekly; callers compare Dataset.Hash with the hash of
+// the last download to skip unchanged sessions.
+//
+// NOTE: Like the Utah adapter, this file is the only place that knows the
+// LegiScan JSON shapes.
+package legiscan
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/telemetry"
+)
+
+const baseURL = "https://api.legiscan.com/"
+
+// Client is a thin HTTP adapter for the LegiScan API.
+type Client struct {
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewClient creates a new LegiScan API client.
+func NewClient(apiKey string) *Client {
+	return &Client{
+		apiKey: apiKey,
+		httpClient: &http.Client{
+			Transport: telemetry.Transport(nil),
+			Timeout:   60 * time.Second,
+		},
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Datasets
+// ---------------------------------------------------------------------------
+
+// Dataset describes a downloadable whole-session archive.
+type Dataset struct {
+	SessionID   int    `json:"session_id"`
+	SessionName string `json:"session_name"`
+	YearStart   int    `json:"year_start"`
+	YearEnd     int    `json:"year_end"`
+	Special     int    `json:"special"`
+	Hash        string `json:"dataset_hash"`
+	AccessKey   string `json:"access_key"`
+}
+
+// FetchDatasets lists the available session datasets for Utah.
+func (c *Client) FetchDatasets(ctx context.Context) ([]Dataset, error) {
+	var resp struct {
+		DatasetList []Dataset `json:"datasetlist"`
+	}
+	if err := c.call(ctx, "getDatasetList", url.Values{"state": {"UT"}}, &resp); err != nil {
+		return nil, fmt.Errorf("fetch datasets: %w", err)
+	}
+	return resp.DatasetList, nil
+}
+
+// Session is the parsed content of one session dataset.
+type Session struct {
+	ID          int
+	Name        string
+	Year        int
+	People      []Person
+	Bills       []Bill
+	RollCalls   []domain.RollCall // Votes keyed by "legiscan:<people_id>"; see PeopleKey
+	FetchedHash string
+}
+
+// Person is a member listed in a session.
+type Person struct {
+	PeopleID       int
+	FirstName      string
+	LastName       string
+	Party          string // "R", "D", ...
+	Chamber        string // "house" or "senate"
+	DistrictNumber int
+}
+
+// Bill is the sponsorship and outcome of one bill.
+type Bill struct {
+	Number           string
+	PrimarySponsorID int // people_id; 0 if none
+	Passed           bool
+}
+
+// PeopleKey is the placeholder key for a LegiScan member in RollCall.Votes,
+// until the caller maps it to a legislator ID.
+func PeopleKey(peopleID int) string {
+	return "legiscan:" + strconv.Itoa(peopleID)
+}
+
+// FetchSession downloads and parses the dataset for ds.
+func (c *Client) FetchSession(ctx context.Context, ds Dataset) (*Session, error) {
+	var resp struct {
+		Dataset struct {
+			Zip string `json:"zip"`
+		} `json:"dataset"`
+	}
+	params := url.Values{"id": {strconv.Itoa(ds.SessionID)}, "access_key": {ds.AccessKey}}
+	if err := c.call(ctx, "getDataset", params, &resp); err != nil {
+		return nil, fmt.Errorf("fetch dataset %d: %w", ds.SessionID, err)
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(resp.Dataset.Zip)
+	if err != nil {
+		return nil, fmt.Errorf("decode dataset %d: %w", ds.SessionID, err)
+	}
+	s, err := ParseDataset(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parse dataset %d: %w", ds.SessionID, err)
+	}
+	s.ID = ds.SessionID
+	s.Name = ds.SessionName
+	s.Year = ds.YearStart
+	s.FetchedHash = ds.Hash
+	return s, nil
+}
+
+// ---------------------------------------------------------------------------
+// Dataset parsing
+// ---------------------------------------------------------------------------
+
+// Dataset archives contain one JSON file per object under bill/, vote/ and
+// people/ directories.
+
+type apiPerson struct {
+	PeopleID  int    `json:"people_id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Party     string `json:"party"`
+	Role      string `json:"role"`     // "Rep" or "Sen"
+	District  string `json:"district"` // e.g. "HD-012"
+}
+
+type apiBill struct {
+	BillNumber string `json:"bill_number"`
+	Status     int    `json:"status"`
+	Sponsors   []struct {
+		PeopleID      int `json:"people_id"`
+		SponsorTypeID int `json:"sponsor_type_id"` // 1 = primary
+	} `json:"sponsors"`
+}
+
+type apiRollCall struct {
+	RollCallID int    `json:"roll_call_id"`
+	BillNumber string `json:"bill_number"`
+	Date       string `json:"date"`
+	Chamber    string `json:"chamber"` // "H" or "S"
+	Votes      []struct {
+		PeopleID int `json:"people_id"`
+		VoteID   int `json:"vote_id"` // 1 yea, 2 nay, 3 NV, 4 absent
+	} `json:"votes"`
+}
+
+// statusPassed is LegiScan's bill status code for "Passed".
+const statusPassed = 4
+
+// ParseDataset parses a LegiScan dataset zip archive.
+func ParseDataset(raw []byte) (*Session, error) {
+	zr, err := zip.NewReader(bytes.NewReader(raw), int64(len(raw)))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Session{}
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || path.Ext(f.Name) != ".json" {
+			continue
+		}
+		kind := path.Base(path.Dir(f.Name))
+
+		switch kind {
+		case "people":
+			var doc struct {
+				Person apiPerson `json:"person"`
+			}
+			if err := readJSON(f, &doc); err != nil {
+				return nil, err
+			}
+			chamber, district := parseDistrict(doc.Person.District)
+			s.People = append(s.People, Person{
+				PeopleID:       doc.Person.PeopleID,
+				FirstName:      doc.Person.FirstName,
+				LastName:       doc.Person.LastName,
+				Party:          doc.Person.Party,
+				Chamber:        chamber,
+				DistrictNumber: district,
+			})
+
+		case "bill":
+			var doc struct {
+				Bill apiBill `json:"bill"`
+			}
+			if err := readJSON(f, &doc); err != nil {
+				return nil, err
+			}
+			b := Bill{Number: doc.Bill.BillNumber, Passed: doc.Bill.Status == statusPassed}
+			for _, sp := range doc.Bill.Sponsors {
+				if sp.SponsorTypeID == 1 {
+					b.PrimarySponsorID = sp.PeopleID
+					break
+				}
+			}
+			s.Bills = append(s.Bills, b)
+
+		case "vote":
+			var doc struct {
+				RollCall apiRollCall `json:"roll_call"`
+			}
+			if err := readJSON(f, &doc); err != nil {
+				return nil, err
+			}
+			rc := domain.RollCall{
+				ID:         doc.RollCall.RollCallID,
+				BillNumber: doc.RollCall.BillNumber,
+				Chamber:    normalizeChamber(doc.RollCall.Chamber),
+				Votes:      make(map[string]domain.Vote, len(doc.RollCall.Votes)),
+			}
+			if t, err := time.Parse("2006-01-02", doc.RollCall.Date); err == nil {
+				rc.Date = t
+			}
+			for _, v := range doc.RollCall.Votes {
+				rc.Votes[PeopleKey(v.PeopleID)] = voteFromID(v.VoteID)
+			}
+			s.RollCalls = append(s.RollCalls, rc)
+		}
+	}
+	return s, nil
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// call performs an API operation and decodes the response into dest.
+func (c *Client) call(ctx context.Context, op string, params url.Values, dest any) error {
+	q := url.Values{"key": {c.apiKey}, "op": {op}}
+	for k, v := range params {
+		q[k] = v
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"?"+q.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// Don't echo the URL: it carries the API key.
+		return fmt.Errorf("unexpected status %d from LegiScan %s", resp.StatusCode, op)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var envelope struct {
+		Status string `json:"status"`
+		Alert  struct {
+			Message string `json:"message"`
+		} `json:"alert"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return err
+	}
+	if envelope.Status != "OK" {
+		return fmt.Errorf("LegiScan %s: %s", op, envelope.Alert.Message)
+	}
+	return json.Unmarshal(body, dest)
+}
+
+func readJSON(f *zip.File, dest any) error {
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+	if err := json.NewDecoder(rc).Decode(dest); err != nil {
+		return fmt.Errorf("decode %s: %w", f.Name, err)
+	}
+	return nil
+}
+
+// voteFromID maps LegiScan vote_id codes to domain votes.
+func voteFromID(id int) domain.Vote {
+	switch id {
+	case 1:
+		return domain.VoteYea
+	case 2:
+		return domain.VoteNay
+	case 3:
+		return domain.VotePresent
+	default:
+		return domain.VoteAbsent
+	}
+}
+
+// parseDistrict maps "HD-012" → ("house", 12) and "SD-007" → ("senate", 7).
+func parseDistrict(d string) (string, int) {
+	prefix, num, ok := strings.Cut(d, "-")
+	if !ok {
+		return "", 0
+	}
+	n, _ := strconv.Atoi(num)
+	switch strings.ToUpper(prefix) {
+	case "HD":
+		return "house", n
+	case "SD":
+		return "senate", n
+	default:
+		return "", n
+	}
+}
+
+// normalizeChamber maps "H" → "house" and "S" → "senate".
+func normalizeChamber(c string) string {
+	switch strings.ToUpper(c) {
+	case "H":
+		return "house"
+	case "S":
+		return "senate"
+	default:
+		return strings.ToLower(c)
+	}
+}
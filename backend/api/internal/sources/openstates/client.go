@@ -0,0 +1,206 @@
+// Package openstates provides a reader for the OpenStates bulk people CSV
+// published nightly at data.openstates.org.
+//
+// No API key is required, which makes it a convenient way to seed legislator
+// data before a Utah Legislature developer token is available:
+//
+//	https://data.openstates.org/people/current/ut.csv
+//
+// The CSV carries contact offices, social handles and photo URLs that the
+// official Utah API does not expose, so it is also useful as a secondary
+// source for filling gaps in Utah API records. Records are tagged with
+// domain.SourceOpenStates so the merge rules rank them below the Utah API.
+package openstates
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/telemetry"
+)
+
+const baseURL = "https://data.openstates.org/people/current"
+
+// Client is a thin HTTP adapter for the OpenStates bulk CSV exports.
+type Client struct {
+	state      string
+	httpClient *http.Client
+}
+
+// NewClient creates a new OpenStates client for Utah.
+func NewClient() *Client {
+	return &Client{
+		state: "ut",
+		httpClient: &http.Client{
+			Transport: telemetry.Transport(nil),
+			Timeout:   30 * time.Second,
+		},
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Legislators
+// ---------------------------------------------------------------------------
+
+// FetchLegislators downloads and parses the current people CSV.
+func (c *Client) FetchLegislators(ctx context.Context) ([]domain.Legislator, error) {
+	url := fmt.Sprintf("%s/%s.csv", baseURL, c.state)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetch legislators: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch legislators: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch legislators: unexpected status %d from %s", resp.StatusCode, url)
+	}
+
+	return ParseLegislators(resp.Body)
+}
+
+// ParseLegislators parses an OpenStates people CSV. Columns are looked up by
+// header name, so extra or reordered columns are tolerated.
+func ParseLegislators(r io.Reader) ([]domain.Legislator, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+	cols := make(map[string]int, len(header))
+	for i, h := range header {
+		cols[strings.TrimSpace(h)] = i
+	}
+	for _, required := range []string{"id", "current_chamber", "current_district"} {
+		if _, ok := cols[required]; !ok {
+			return nil, fmt.Errorf("csv is missing required column %q", required)
+		}
+	}
+
+	var legislators []domain.Legislator
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv row: %w", err)
+		}
+
+		get := func(col string) string {
+			i, ok := cols[col]
+			if !ok || i >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[i])
+		}
+
+		district, err := strconv.Atoi(get("current_district"))
+		if err != nil {
+			// At-large or non-numeric districts don't exist in Utah; skip them.
+			continue
+		}
+
+		firstName, lastName := get("given_name"), get("family_name")
+		if firstName == "" && lastName == "" {
+			firstName, lastName = splitName(get("name"))
+		}
+
+		legislators = append(legislators, domain.Legislator{
+			OpenStatesID:   get("id"),
+			Chamber:        normalizeChamber(get("current_chamber")),
+			DistrictNumber: district,
+			FirstName:      firstName,
+			LastName:       lastName,
+			Party:          get("current_party"),
+			Email:          get("email"),
+			Phone:          firstNonEmpty(get("capitol_voice"), get("district_voice")),
+			Website:        firstLink(get("links")),
+			ImageURL:       get("image"),
+			Offices:        offices(get),
+			Twitter:        get("twitter"),
+			Facebook:       get("facebook"),
+			Instagram:      get("instagram"),
+			YouTube:        get("youtube"),
+			Source:         domain.SourceOpenStates,
+		})
+	}
+	return legislators, nil
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// offices collects the capitol and district contact offices from a row,
+// skipping any office with no contact details at all.
+func offices(get func(string) string) []domain.Office {
+	var out []domain.Office
+	for _, class := range []string{"capitol", "district"} {
+		o := domain.Office{
+			Classification: class,
+			Address:        get(class + "_address"),
+			Voice:          get(class + "_voice"),
+			Fax:            get(class + "_fax"),
+		}
+		if o.Address == "" && o.Voice == "" && o.Fax == "" {
+			continue
+		}
+		out = append(out, o)
+	}
+	return out
+}
+
+// normalizeChamber maps OpenStates "lower" → "house" and "upper" → "senate".
+func normalizeChamber(c string) string {
+	switch strings.ToLower(c) {
+	case "lower":
+		return "house"
+	case "upper":
+		return "senate"
+	default:
+		return strings.ToLower(c)
+	}
+}
+
+// firstLink returns the first URL from the semicolon-separated links column.
+func firstLink(links string) string {
+	for _, l := range strings.Split(links, ";") {
+		if l = strings.TrimSpace(l); l != "" {
+			return l
+		}
+	}
+	return ""
+}
+
+// splitName splits a full name on its last space: "Jane Q Doe" → ("Jane Q", "Doe").
+func splitName(name string) (string, string) {
+	i := strings.LastIndex(name, " ")
+	if i < 0 {
+		return "", name
+	}
+	return name[:i], name[i+1:]
+}
+
+func firstNonEmpty(vals ...string) string {
+	for _, v := range vals {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
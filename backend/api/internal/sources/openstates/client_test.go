@@ -0,0 +1,96 @@
+package openstates
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"api/internal/domain"
+)
+
+func TestParseLegislators(t *testing.T) {
+	f, err := os.Open("testdata/ut.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	got, err := ParseLegislators(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []domain.Legislator{
+		{
+			OpenStatesID: "ocd-person/0001", Chamber: "house", DistrictNumber: 12,
+			FirstName: "Jane", LastName: "Smith", Party: "Republican",
+			Email: "jsmith@le.utah.gov", Phone: "801-538-1029",
+			Website:  "https://house.utah.gov/rep/SMITHJ",
+			ImageURL: "https://le.utah.gov/images/jsmith.jpg",
+			Offices: []domain.Office{
+				{Classification: "capitol", Address: "350 N State St, Salt Lake City, UT 84114", Voice: "801-538-1029"},
+			},
+			Twitter: "janesmithut", Facebook: "JaneSmithUtah",
+			Source: domain.SourceOpenStates,
+		},
+		{
+			// No given or family name: split from the full name. The phone
+			// falls back to the district office.
+			OpenStatesID: "ocd-person/0002", Chamber: "senate", DistrictNumber: 3,
+			FirstName: "Luis", LastName: "Ortega", Party: "Democratic",
+			Email: "lortega@le.utah.gov", Phone: "801-555-0102",
+			Offices: []domain.Office{
+				{Classification: "district", Address: "12 Main St, Ogden, UT", Voice: "801-555-0102", Fax: "801-555-0103"},
+			},
+			Source: domain.SourceOpenStates,
+		},
+		{
+			OpenStatesID: "ocd-person/0003", Chamber: "house", DistrictNumber: 29,
+			LastName: "Cher", Party: "Republican",
+			Source: domain.SourceOpenStates,
+		},
+		// The at-large row is skipped.
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseLegislators =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestParseLegislatorsColumns(t *testing.T) {
+	// Columns are found by name, in any order.
+	csv := "current_district,id,family_name,current_chamber,extra\n7,ocd-person/9,Lee,upper,x\n"
+	got, err := ParseLegislators(strings.NewReader(csv))
+	if err != nil || len(got) != 1 || got[0].OpenStatesID != "ocd-person/9" || got[0].Chamber != "senate" ||
+		got[0].DistrictNumber != 7 || got[0].LastName != "Lee" {
+		t.Errorf("ParseLegislators = %+v, %v", got, err)
+	}
+
+	for _, csv := range []string{"", "id,current_chamber\nocd-person/1,lower\n"} {
+		if _, err := ParseLegislators(strings.NewReader(csv)); err == nil {
+			t.Errorf("ParseLegislators(%q) succeeded, want an error", csv)
+		}
+	}
+}
+
+func TestNormalizeChamber(t *testing.T) {
+	for in, want := range map[string]string{
+		"lower": "house", "upper": "senate", "LOWER": "house", "Upper": "senate", "legislature": "legislature", "": "",
+	} {
+		if got := normalizeChamber(in); got != want {
+			t.Errorf("normalizeChamber(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestSplitName(t *testing.T) {
+	for _, tc := range []struct{ in, first, last string }{
+		{"Jane Q Doe", "Jane Q", "Doe"},
+		{"Jane Doe", "Jane", "Doe"},
+		{"Cher", "", "Cher"},
+		{"", "", ""},
+	} {
+		if first, last := splitName(tc.in); first != tc.first || last != tc.last {
+			t.Errorf("splitName(%q) = %q, %q; want %q, %q", tc.in, first, last, tc.first, tc.last)
+		}
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"api/internal/domain"
+	"api/internal/telemetry"
 )
 
 const baseURL = "https://glen.le.utah.gov"
@@ -40,7 +41,8 @@ func NewClient(token string) *Client {
 	return &Client{
 		token: token,
 		httpClient: &http.Client{
-			Timeout: 15 * time.Second,
+			Transport: telemetry.Transport(nil),
+			Timeout:   15 * time.Second,
 		},
 	}
 }
@@ -69,6 +71,7 @@ type apiLegislator struct {
 	Phone          string `json:"phone"`
 	Website        string `json:"website"`
 	ImageURL       string `json:"imageUrl"`
+	ServiceStart   string `json:"serviceStart"` // e.g. "January 1, 2009"
 }
 
 // FetchLegislators retrieves all current Utah legislators.
@@ -82,6 +85,11 @@ func (c *Client) FetchLegislators(ctx context.Context) ([]domain.Legislator, err
 
 	legislators := make([]domain.Legislator, 0, len(raw))
 	for _, r := range raw {
+		var termStart *time.Time
+		if t, err := parseDate(r.ServiceStart); err == nil {
+			termStart = &t
+		}
+
 		legislators = append(legislators, domain.Legislator{
 			UtahLegislatureID: r.ID,
 			Chamber:           normalizeChamber(r.Chamber),
@@ -93,6 +101,8 @@ func (c *Client) FetchLegislators(ctx context.Context) ([]domain.Legislator, err
 			Phone:             r.Phone,
 			Website:           r.Website,
 			ImageURL:          r.ImageURL,
+			TermStart:         termStart,
+			Source:            domain.SourceUtahLegislature,
 		})
 	}
 	return legislators, nil
@@ -146,9 +156,10 @@ func (c *Client) FetchBills(ctx context.Context, session string) ([]domain.Bill,
 			SessionYear:       sessionYear,
 			Title:             firstNonEmpty(r.LongTitle, r.ShortTitle),
 			Status:            r.Status,
-			// SponsorID is the UtahLegislatureID of the sponsor legislator.
-			// The ingestion job resolves it to a UUID after upserting legislators.
-			SponsorID: r.Sponsor,
+			// The ingestion job resolves the sponsor's UtahLegislatureID to
+			// a stored legislator, now or once they are synced.
+			SponsorUtahID: r.Sponsor,
+			Source:        domain.SourceUtahLegislature,
 		})
 	}
 	return bills, nil
@@ -171,10 +182,11 @@ func (c *Client) FetchBill(ctx context.Context, session, billID string) (*domain
 		Title:             firstNonEmpty(r.LongTitle, r.ShortTitle),
 		Description:       r.Description,
 		Status:            r.Status,
-		SponsorID:         r.Sponsor,
+		SponsorUtahID:     r.Sponsor,
 		FullTextURL:       r.FullTextURL,
 		LastAction:        r.LastAction,
 		FiscalNoteURL:     r.FiscalNoteURL,
+		Source:            domain.SourceUtahLegislature,
 	}
 
 	if r.LastActionDate != "" {
@@ -244,6 +256,7 @@ func parseDate(s string) (time.Time, error) {
 	layouts := []string{
 		"2006-01-02",
 		time.RFC3339,
+		"January 2, 2006",
 	}
 	for _, layout := range layouts {
 		if t, err := time.Parse(layout, s); err == nil {
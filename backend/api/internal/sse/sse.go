@@ -0,0 +1,69 @@
+// Package sse serves the gRPC gateway's server-streaming RPCs to browsers
+// as Server-Sent Events.
+//
+// The gateway writes each message of a stream as {"result": ...} (or
+// {"error": ...} if the stream fails) followed by the marshaler's delimiter.
+// Marshaler frames those as SSE "data:" events, so an EventSource receives
+// one JSON message per event.
+package sse
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ContentType is the media type of Server-Sent Events. Register Marshaler
+// for it with runtime.WithMarshalerOption; the gateway picks it when a
+// request's Accept header asks for it.
+const ContentType = "text/event-stream"
+
+// Marshaler marshals messages as JSON, like the gateway's default, framed
+// as SSE events.
+type Marshaler struct {
+	runtime.JSONPb
+}
+
+// NewMarshaler returns a Marshaler with the gateway's default JSON options.
+func NewMarshaler() *Marshaler {
+	return &Marshaler{runtime.JSONPb{
+		MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+		UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+	}}
+}
+
+// ContentType returns ContentType.
+func (m *Marshaler) ContentType(any) string {
+	return ContentType
+}
+
+// Marshal returns v as the data line of an event. The JSON is compact, so
+// it fits on one line.
+func (m *Marshaler) Marshal(v any) ([]byte, error) {
+	b, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("data: "), b...), nil
+}
+
+// Delimiter ends each event.
+func (m *Marshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
+
+// Stream wraps a handler serving long-lived streams: it lifts the server's
+// write timeout for the request, which would otherwise cut streams off, and
+// asks proxies not to buffer or cache the response.
+func Stream(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Not every ResponseWriter supports deadlines; the stream then
+		// lasts as long as the server's timeout allows.
+		_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("X-Accel-Buffering", "no")
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,92 @@
+package sse_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "api/gen/go/proto/v1"
+	"api/internal/sse"
+)
+
+// bills streams two updates, then fails.
+type bills struct {
+	pb.UnimplementedBillServiceServer
+}
+
+func (bills) WatchBills(req *pb.WatchBillsRequest, stream pb.BillService_WatchBillsServer) error {
+	for _, id := range req.BillIds {
+		if err := stream.Send(&pb.WatchBillsResponse{EventType: "snapshot", Bill: &pb.Bill{Id: id}}); err != nil {
+			return err
+		}
+	}
+	return status.Error(codes.Unavailable, "fell behind")
+}
+
+func TestGatewayStream(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterBillServiceServer(srv, bills{})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	mux := runtime.NewServeMux(runtime.WithMarshalerOption(sse.ContentType, sse.NewMarshaler()))
+	if err := pb.RegisterBillServiceHandler(context.Background(), mux, conn); err != nil {
+		t.Fatal(err)
+	}
+	gateway := httptest.NewServer(sse.Stream(mux))
+	defer gateway.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, gateway.URL+"/v1/bills:watch?bill_ids=a&bill_ids=b", nil)
+	req.Header.Set("Accept", sse.ContentType)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	if ct := resp.Header.Get("Content-Type"); ct != sse.ContentType {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if cc := resp.Header.Get("Cache-Control"); cc != "no-cache" {
+		t.Errorf("Cache-Control = %q", cc)
+	}
+	m := sse.NewMarshaler()
+	var want []byte
+	for _, v := range []any{
+		map[string]any{"result": &pb.WatchBillsResponse{EventType: "snapshot", Bill: &pb.Bill{Id: "a"}}},
+		map[string]any{"result": &pb.WatchBillsResponse{EventType: "snapshot", Bill: &pb.Bill{Id: "b"}}},
+	} {
+		b, err := m.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want = append(append(want, b...), m.Delimiter()...)
+	}
+	if string(body[:min(len(want), len(body))]) != string(want) {
+		t.Fatalf("body = %q, want it to start with %q", body, want)
+	}
+	if rest := string(body[len(want):]); rest[:6] != "data: " || rest[len(rest)-2:] != "\n\n" || !strings.Contains(rest, `"error"`) {
+		t.Errorf("stream error event = %q", rest)
+	}
+}
@@ -0,0 +1,54 @@
+// Package telemetry instruments the API server and the ingestion jobs with
+// Prometheus metrics and OpenTelemetry traces.
+//
+// Metrics go to the default Prometheus registry: the server serves them at
+// /metrics, and the ingest command pushes them to a Pushgateway after a
+// run. They cover RPCs (see UnaryInterceptor), job runs (see
+// ingest.ObserveRun) and upstream requests (see Transport).
+//
+// Traces are exported over OTLP/HTTP when OTEL_EXPORTER_OTLP_ENDPOINT or
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT is set, configured by the standard
+// OTEL_* variables; without either, trace context is still propagated but
+// nothing is recorded. A request's trace starts at the gateway and follows
+// it through the gRPC call, the repositories (see repository/tracing) and
+// requests to upstream APIs.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// SetupTracing installs the global tracer provider and W3C trace context
+// propagation. service names the process in traces unless OTEL_SERVICE_NAME
+// does. The returned function flushes buffered spans; call it on shutdown.
+func SetupTracing(ctx context.Context, service string) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create trace exporter: %w", err)
+	}
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", service)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create trace resource: %w", err)
+	}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
@@ -0,0 +1,97 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/proto.v1.BillService/GetBill"}
+	handled := rpcHandled.WithLabelValues("unary", "proto.v1.BillService", "GetBill", "NotFound")
+	before := testutil.ToFloat64(handled)
+
+	_, err := UnaryInterceptor()(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.NotFound, "no such bill")
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("got %v, want the handler's error", err)
+	}
+	if got := testutil.ToFloat64(handled) - before; got != 1 {
+		t.Errorf("counted %v NotFound calls, want 1", got)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	h := Handler("secret")
+	for _, tc := range []struct {
+		auth string
+		want int
+	}{
+		{"", http.StatusUnauthorized},
+		{"Bearer wrong", http.StatusUnauthorized},
+		{"Bearer secret", http.StatusOK},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if tc.auth != "" {
+			req.Header.Set("Authorization", tc.auth)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != tc.want {
+			t.Errorf("Authorization %q: got %d, want %d", tc.auth, rec.Code, tc.want)
+		}
+	}
+}
+
+func TestTransport(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+	observed := func() int { return testutil.CollectAndCount(upstreamDuration) }
+	before := observed()
+
+	client := &http.Client{Transport: Transport(nil)}
+	resp, err := client.Get(srv.URL + "/bills/SECRET-TOKEN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if observed() != before+1 {
+		t.Errorf("no duration recorded for %s 502", host)
+	}
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	if want := "HTTP GET " + host; spans[0].Name() != want {
+		t.Errorf("span name = %q, want %q", spans[0].Name(), want)
+	}
+	for _, a := range spans[0].Attributes() {
+		if strings.Contains(a.Value.Emit(), "SECRET-TOKEN") {
+			t.Errorf("attribute %s leaks the URL path", a.Key)
+		}
+	}
+	if spans[0].Status().Code != otelcodes.Error {
+		t.Errorf("span status = %v, want Error for a 502", spans[0].Status().Code)
+	}
+}
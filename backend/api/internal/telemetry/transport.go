@@ -0,0 +1,69 @@
+package telemetry
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var upstreamDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "upstream_request_duration_seconds",
+	Help:    "How long requests to upstream APIs take, by host and HTTP status (0 if there was no response).",
+	Buckets: []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+}, []string{"host", "code"})
+
+var tracer = otel.Tracer("api/internal/telemetry")
+
+// Transport returns base, or http.DefaultTransport if nil, instrumented to
+// time each request and record it as a span of the caller's trace.
+//
+// Spans name only the method and host: upstream URLs can carry credentials,
+// such as the Utah Legislature's token in its paths. Trace context isn't
+// sent upstream.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := tracer.Start(req.Context(), "HTTP "+req.Method+" "+req.URL.Host,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Host),
+		))
+	defer span.End()
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	code := 0
+	if resp != nil {
+		code = resp.StatusCode
+	}
+	upstreamDuration.WithLabelValues(req.URL.Host, strconv.Itoa(code)).Observe(time.Since(start).Seconds())
+
+	switch {
+	case err != nil:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "request failed")
+	case code >= 500:
+		span.SetStatus(codes.Error, http.StatusText(code))
+	}
+	if code != 0 {
+		span.SetAttributes(attribute.Int("http.response.status_code", code))
+	}
+	return resp, err
+}
@@ -0,0 +1,144 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository"
+	"api/internal/safehttp"
+)
+
+const (
+	// maxAttempts is how many times a delivery is tried before it is
+	// marked failed. With backoff that is about eight and a half hours.
+	maxAttempts = 10
+	// firstRetry is the wait after the first failure; each later failure
+	// doubles it, up to maxRetry.
+	firstRetry = time.Minute
+	maxRetry   = 6 * time.Hour
+	// batchSize bounds the deliveries sent per run.
+	batchSize = 100
+	// maxErrorLength bounds the response body kept in the log.
+	maxErrorLength = 500
+)
+
+// Deliverer sends due deliveries and records the outcome of each attempt.
+type Deliverer struct {
+	repo   repository.WebhookRepository
+	client *http.Client
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewDeliverer creates a Deliverer sending with client; use NewClient
+// outside tests.
+func NewDeliverer(repo repository.WebhookRepository, client *http.Client, logger *slog.Logger) *Deliverer {
+	return &Deliverer{repo: repo, client: client, logger: logger, now: time.Now}
+}
+
+// NewClient returns an HTTP client for webhook requests. Webhook URLs are
+// chosen by users, so it refuses to connect to loopback, private and
+// link-local addresses, and doesn't follow redirects.
+func NewClient() *http.Client {
+	return safehttp.NewClient(15 * time.Second)
+}
+
+// Deliver sends the deliveries that are due and returns how many
+// succeeded. An attempt failing is recorded on the delivery, not returned.
+func (d *Deliverer) Deliver(ctx context.Context) (int, error) {
+	due, err := d.repo.DueDeliveries(ctx, d.now(), batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("due webhook deliveries: %w", err)
+	}
+
+	hooks := map[string]*domain.Webhook{}
+	sent := 0
+	for _, dl := range due {
+		w, ok := hooks[dl.WebhookID]
+		if !ok {
+			if w, err = d.repo.GetWebhook(ctx, dl.WebhookID); err != nil {
+				return sent, fmt.Errorf("get webhook: %w", err)
+			}
+			hooks[dl.WebhookID] = w
+		}
+
+		now := d.now().UTC()
+		dl.Attempts++
+		dl.LastAttemptAt = &now
+		if w == nil {
+			dl.Status, dl.ResponseStatus, dl.LastError = domain.DeliveryFailed, 0, "webhook deleted"
+		} else {
+			dl.ResponseStatus, err = d.send(ctx, *w, dl, now)
+			switch {
+			case err == nil:
+				dl.Status, dl.LastError = domain.DeliverySucceeded, ""
+				sent++
+			case dl.Attempts >= maxAttempts:
+				dl.Status, dl.LastError = domain.DeliveryFailed, err.Error()
+			default:
+				dl.LastError = err.Error()
+				dl.NextAttemptAt = now.Add(Backoff(dl.Attempts))
+			}
+		}
+		if dl.Status == domain.DeliveryFailed {
+			d.logger.Warn("webhook delivery failed", "webhook", dl.WebhookID, "delivery", dl.ID, "attempts", dl.Attempts, "error", dl.LastError)
+		}
+		if err := d.repo.UpdateDelivery(ctx, dl); err != nil {
+			return sent, fmt.Errorf("update webhook delivery: %w", err)
+		}
+	}
+	return sent, nil
+}
+
+// Backoff returns the wait before retrying after the given number of
+// failed attempts.
+func Backoff(attempts int) time.Duration {
+	wait := firstRetry
+	for i := 1; i < attempts && wait < maxRetry; i++ {
+		wait *= 2
+	}
+	return min(wait, maxRetry)
+}
+
+// send POSTs a delivery, returning the response status and, unless it is
+// 2xx, an error.
+func (d *Deliverer) send(ctx context.Context, w domain.Webhook, dl domain.WebhookDelivery, now time.Time) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(dl.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Id", w.ID)
+	req.Header.Set("X-Webhook-Event", dl.EventType)
+	req.Header.Set("X-Webhook-Delivery", dl.ID)
+	req.Header.Set("X-Webhook-Timestamp", strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set("X-Webhook-Signature", Sign(w.Secret, now, dl.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		// Drop the URL, which the error repeats and the log already has.
+		var uerr *url.Error
+		if errors.As(err, &uerr) {
+			err = uerr.Err
+		}
+		return 0, err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorLength))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if len(body) > 0 {
+			return resp.StatusCode, fmt.Errorf("%s: %s", resp.Status, body)
+		}
+		return resp.StatusCode, errors.New(resp.Status)
+	}
+	return resp.StatusCode, nil
+}
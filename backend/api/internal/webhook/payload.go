@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"time"
+
+	"api/internal/domain"
+)
+
+// payload is the JSON body of a delivery.
+type payload struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Bill       *billJSON       `json:"bill,omitempty"`
+	Legislator *legislatorJSON `json:"legislator,omitempty"`
+	// Previous is the old status, last action or full text URL.
+	Previous string `json:"previous,omitempty"`
+	// Changed lists the fields of a legislator that changed.
+	Changed []string `json:"changed,omitempty"`
+}
+
+type billJSON struct {
+	ID             string     `json:"id"`
+	BillNumber     string     `json:"bill_number"`
+	SessionYear    int        `json:"session_year"`
+	Title          string     `json:"title"`
+	Description    string     `json:"description,omitempty"`
+	Status         string     `json:"status"`
+	SponsorID      string     `json:"sponsor_id,omitempty"`
+	FullTextURL    string     `json:"full_text_url,omitempty"`
+	LastAction     string     `json:"last_action,omitempty"`
+	LastActionDate *time.Time `json:"last_action_date,omitempty"`
+	FiscalNoteURL  string     `json:"fiscal_note_url,omitempty"`
+}
+
+type legislatorJSON struct {
+	ID             string `json:"id"`
+	Chamber        string `json:"chamber"`
+	DistrictNumber int    `json:"district_number"`
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+	Party          string `json:"party,omitempty"`
+	Email          string `json:"email,omitempty"`
+	Phone          string `json:"phone,omitempty"`
+	Website        string `json:"website,omitempty"`
+	ImageURL       string `json:"image_url,omitempty"`
+	Current        bool   `json:"current"`
+}
+
+// newPayload returns the body delivered for e.
+func newPayload(id string, e domain.Event) payload {
+	p := payload{ID: id, Type: e.Type, OccurredAt: e.At.UTC(), Previous: e.Previous, Changed: e.Changed}
+	if b := e.Bill; b != nil {
+		p.Bill = &billJSON{
+			ID:             b.ID,
+			BillNumber:     b.BillNumber,
+			SessionYear:    b.SessionYear,
+			Title:          b.Title,
+			Description:    b.Description,
+			Status:         b.Status,
+			SponsorID:      b.SponsorID,
+			FullTextURL:    b.FullTextURL,
+			LastAction:     b.LastAction,
+			LastActionDate: b.LastActionDate,
+			FiscalNoteURL:  b.FiscalNoteURL,
+		}
+	}
+	if l := e.Legislator; l != nil {
+		p.Legislator = &legislatorJSON{
+			ID:             l.ID,
+			Chamber:        l.Chamber,
+			DistrictNumber: l.DistrictNumber,
+			FirstName:      l.FirstName,
+			LastName:       l.LastName,
+			Party:          l.Party,
+			Email:          l.Email,
+			Phone:          l.Phone,
+			Website:        l.Website,
+			ImageURL:       l.ImageURL,
+			Current:        l.Current,
+		}
+	}
+	return p
+}
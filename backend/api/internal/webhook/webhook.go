@@ -0,0 +1,169 @@
+// Package webhook delivers legislative events to subscribers' URLs as
+// signed HTTP POSTs.
+//
+// As with notifications, delivery is in two steps: the Publisher, a sink for
+// the events repositories, matches events to webhooks and queues a delivery
+// for each match, and the Deliverer, run every minute, sends what is due and
+// retries failures with exponential backoff. The deliveries double as each
+// webhook's delivery log, and any of them can be replayed.
+//
+// Each request carries these headers:
+//
+//	X-Webhook-Id         the webhook's ID
+//	X-Webhook-Event      the event type, e.g. "bill.status_changed"
+//	X-Webhook-Delivery   the delivery's ID; a replay has a new one
+//	X-Webhook-Timestamp  Unix seconds when the request was signed
+//	X-Webhook-Signature  "sha256=" and the hex HMAC-SHA256, keyed with the
+//	                     webhook's secret, of the timestamp, ".", and the body
+//
+// Receivers should recompute the signature, compare it in constant time,
+// and reject stale timestamps. The body's "id" is the event's, which is the
+// same across retries and replays, so receivers can drop duplicates.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository"
+)
+
+// EventTypes are the events webhooks can subscribe to.
+var EventTypes = []string{
+	domain.EventBillStatusChanged,
+	domain.EventBillAction,
+	domain.EventBillNewVersion,
+	domain.EventLegislatorUpdated,
+}
+
+// Sign returns the X-Webhook-Signature value for body sent at timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10) + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSecret returns a random signing secret for a new webhook.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// Publisher matches events against the stored webhooks and queues a
+// delivery for each match.
+type Publisher struct {
+	repo   repository.WebhookRepository
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewPublisher creates a Publisher.
+func NewPublisher(repo repository.WebhookRepository, logger *slog.Logger) *Publisher {
+	return &Publisher{repo: repo, logger: logger, now: time.Now}
+}
+
+// Publish queues deliveries of events to the webhooks they match.
+func (p *Publisher) Publish(ctx context.Context, events []domain.Event) error {
+	hooks, err := p.repo.ListWebhooks(ctx, "")
+	if err != nil {
+		return fmt.Errorf("list webhooks: %w", err)
+	}
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	now := p.now().UTC()
+	var out []domain.WebhookDelivery
+	for _, e := range events {
+		var body []byte
+		var id string
+		for _, w := range hooks {
+			if !Matches(w, e) {
+				continue
+			}
+			if body == nil {
+				if id, err = newEventID(); err != nil {
+					return err
+				}
+				if body, err = json.Marshal(newPayload(id, e)); err != nil {
+					return fmt.Errorf("encode %s event: %w", e.Type, err)
+				}
+			}
+			out = append(out, domain.WebhookDelivery{
+				WebhookID:     w.ID,
+				EventID:       id,
+				EventType:     e.Type,
+				Payload:       body,
+				Status:        domain.DeliveryPending,
+				NextAttemptAt: now,
+			})
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	if _, err := p.repo.EnqueueDeliveries(ctx, out); err != nil {
+		return fmt.Errorf("enqueue webhook deliveries: %w", err)
+	}
+	p.logger.Info("queued webhook deliveries", "events", len(events), "deliveries", len(out))
+	return nil
+}
+
+// Matches reports whether w subscribes to e: every filter w sets must
+// match.
+func Matches(w domain.Webhook, e domain.Event) bool {
+	if len(w.EventTypes) > 0 && !slices.Contains(w.EventTypes, e.Type) {
+		return false
+	}
+	b, l := e.Bill, e.Legislator
+	if w.BillID != "" && (b == nil || b.ID != w.BillID) {
+		return false
+	}
+	if w.SponsorID != "" {
+		switch {
+		case b != nil && b.SponsorID == w.SponsorID:
+		case l != nil && l.ID == w.SponsorID:
+		default:
+			return false
+		}
+	}
+	if len(w.Keywords) > 0 {
+		var text string
+		switch {
+		case b != nil:
+			text = b.BillNumber + " " + b.Title + " " + b.Description
+		case l != nil:
+			text = l.FirstName + " " + l.LastName
+		}
+		text = strings.ToLower(text)
+		if !slices.ContainsFunc(w.Keywords, func(k string) bool {
+			return strings.Contains(text, strings.ToLower(k))
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+func newEventID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate event id: %w", err)
+	}
+	return "evt_" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,184 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"api/internal/domain"
+	"api/internal/repository/memory"
+)
+
+var discard = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func TestMatches(t *testing.T) {
+	bill := domain.Event{
+		Type: domain.EventBillStatusChanged,
+		Bill: &domain.Bill{ID: "b1", BillNumber: "HB0001", Title: "Water Conservation Amendments", SponsorID: "l1"},
+	}
+	legislator := domain.Event{
+		Type:       domain.EventLegislatorUpdated,
+		Legislator: &domain.Legislator{ID: "l1", FirstName: "Pat", LastName: "Waters"},
+	}
+	for _, tc := range []struct {
+		name       string
+		hook       domain.Webhook
+		bill, legi bool
+	}{
+		{"everything", domain.Webhook{}, true, true},
+		{"event type", domain.Webhook{EventTypes: []string{domain.EventBillStatusChanged}}, true, false},
+		{"bill", domain.Webhook{BillID: "b1"}, true, false},
+		{"other bill", domain.Webhook{BillID: "b2"}, false, false},
+		{"sponsor", domain.Webhook{SponsorID: "l1"}, true, true},
+		{"keyword", domain.Webhook{Keywords: []string{"tax", "WATER"}}, true, true},
+		{"keyword miss", domain.Webhook{Keywords: []string{"tax"}}, false, false},
+		{"all filters", domain.Webhook{SponsorID: "l1", Keywords: []string{"conservation"}}, true, false},
+	} {
+		if got := Matches(tc.hook, bill); got != tc.bill {
+			t.Errorf("%s: Matches(bill) = %v, want %v", tc.name, got, tc.bill)
+		}
+		if got := Matches(tc.hook, legislator); got != tc.legi {
+			t.Errorf("%s: Matches(legislator) = %v, want %v", tc.name, got, tc.legi)
+		}
+	}
+}
+
+func TestPublishAndDeliver(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewWebhookRepository()
+
+	type request struct {
+		header http.Header
+		body   []byte
+	}
+	var got []request
+	fail := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got = append(got, request{r.Header, body})
+		if fail {
+			http.Error(w, "down for maintenance", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	hook, err := repo.CreateWebhook(ctx, domain.Webhook{OwnerID: "user", URL: srv.URL, Secret: "whsec_test", BillID: "b1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateWebhook(ctx, domain.Webhook{OwnerID: "user", URL: srv.URL, Secret: "s", BillID: "b2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	at := time.Date(2026, time.March, 2, 15, 0, 0, 0, time.UTC)
+	pub := NewPublisher(repo, discard)
+	pub.now = func() time.Time { return at }
+	err = pub.Publish(ctx, []domain.Event{{
+		Type: domain.EventBillStatusChanged, Previous: "introduced", At: at,
+		Bill: &domain.Bill{ID: "b1", BillNumber: "HB0001", SessionYear: 2026, Title: "Water", Status: "passed"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := at
+	d := NewDeliverer(repo, srv.Client(), discard)
+	d.now = func() time.Time { return now }
+
+	// The first attempt fails and is retried after the backoff.
+	if sent, err := d.Deliver(ctx); err != nil || sent != 0 {
+		t.Fatalf("Deliver = %d, %v; want 0 sent", sent, err)
+	}
+	log, _ := repo.ListDeliveries(ctx, hook.ID, 0)
+	if len(log) != 1 {
+		t.Fatalf("deliveries = %+v, want one", log)
+	}
+	dl := log[0]
+	if dl.Status != domain.DeliveryPending || dl.Attempts != 1 || dl.ResponseStatus != http.StatusServiceUnavailable ||
+		!strings.Contains(dl.LastError, "down for maintenance") || !dl.NextAttemptAt.Equal(now.Add(time.Minute)) {
+		t.Errorf("delivery after a failure = %+v", dl)
+	}
+	if sent, _ := d.Deliver(ctx); sent != 0 || len(got) != 1 {
+		t.Errorf("Deliver before the retry is due sent %d, %d requests", sent, len(got))
+	}
+
+	fail, now = false, now.Add(time.Minute)
+	if sent, err := d.Deliver(ctx); err != nil || sent != 1 {
+		t.Fatalf("retry = %d, %v; want 1 sent", sent, err)
+	}
+	r := got[len(got)-1]
+	ts, _ := strconv.ParseInt(r.header.Get("X-Webhook-Timestamp"), 10, 64)
+	if want := Sign("whsec_test", time.Unix(ts, 0), r.body); !hmac.Equal([]byte(r.header.Get("X-Webhook-Signature")), []byte(want)) {
+		t.Errorf("signature = %q, want %q", r.header.Get("X-Webhook-Signature"), want)
+	}
+	if ts != now.Unix() || r.header.Get("X-Webhook-Event") != domain.EventBillStatusChanged ||
+		r.header.Get("X-Webhook-Id") != hook.ID || r.header.Get("X-Webhook-Delivery") != dl.ID {
+		t.Errorf("headers = %v", r.header)
+	}
+	var body map[string]any
+	if err := json.Unmarshal(r.body, &body); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := body["bill"].(map[string]any)
+	if body["type"] != domain.EventBillStatusChanged || body["previous"] != "introduced" || b["status"] != "passed" ||
+		!strings.HasPrefix(body["id"].(string), "evt_") || body["occurred_at"] != "2026-03-02T15:00:00Z" {
+		t.Errorf("body = %s", r.body)
+	}
+	if dl, _ := repo.GetDelivery(ctx, dl.ID); dl.Status != domain.DeliverySucceeded || dl.Attempts != 2 || dl.LastError != "" {
+		t.Errorf("delivery after success = %+v", dl)
+	}
+}
+
+func TestDeliverGivesUp(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewWebhookRepository()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+	hook, _ := repo.CreateWebhook(ctx, domain.Webhook{OwnerID: "user", URL: srv.URL, Secret: "s"})
+	now := time.Now()
+	repo.EnqueueDeliveries(ctx, []domain.WebhookDelivery{{
+		WebhookID: hook.ID, EventID: "evt_1", EventType: domain.EventBillAction, Payload: []byte(`{}`),
+		Status: domain.DeliveryPending, NextAttemptAt: now,
+	}})
+
+	d := NewDeliverer(repo, srv.Client(), discard)
+	d.now = func() time.Time { return now }
+	for range maxAttempts {
+		if _, err := d.Deliver(ctx); err != nil {
+			t.Fatal(err)
+		}
+		now = now.Add(maxRetry)
+	}
+	log, _ := repo.ListDeliveries(ctx, hook.ID, 0)
+	if log[0].Status != domain.DeliveryFailed || log[0].Attempts != maxAttempts || log[0].LastError != "500 Internal Server Error" {
+		t.Errorf("delivery = %+v, want failed after %d attempts", log[0], maxAttempts)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempts, want := range map[int]time.Duration{1: time.Minute, 2: 2 * time.Minute, 5: 16 * time.Minute, 9: 256 * time.Minute, 10: maxRetry, 50: maxRetry} {
+		if got := Backoff(attempts); got != want {
+			t.Errorf("Backoff(%d) = %s, want %s", attempts, got, want)
+		}
+	}
+}
+
+func TestNewClientRefusesPrivateAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	if _, err := NewClient().Get(srv.URL); err == nil || !strings.Contains(err.Error(), "refusing") {
+		t.Errorf("Get(loopback) error = %v, want refused", err)
+	}
+}
@@ -2,20 +2,84 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
+	"maps"
 	"net"
+	"net/http"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5/pgxpool"
 	pocketbaseSDK "github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/cmd"
 	"github.com/pocketbase/pocketbase/core"
-	"github.com/pocketbase/pocketbase/tools/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 
 	pb "api/gen/go/proto/v1"
+	"api/internal/auth"
+	"api/internal/calendar"
+	"api/internal/config"
+	"api/internal/domain"
+	"api/internal/health"
+	"api/internal/httpcache"
+	"api/internal/ingest"
+	"api/internal/inproc"
+	"api/internal/notify"
+	"api/internal/ratelimit"
+	"api/internal/repository"
+	"api/internal/repository/cache"
+	"api/internal/repository/events"
 	"api/internal/repository/pocketbase"
+	"api/internal/repository/postgres"
+	"api/internal/repository/tracing"
+	"api/internal/scheduler"
 	"api/internal/service"
+	"api/internal/sse"
+	"api/internal/telemetry"
+	"api/internal/webhook"
+)
+
+// Rate limits of the public API: per client IP address for requests
+// without an API key, and per key unless the key sets its own. The Flutter
+// app is anonymous, so one user stays well under the IP limit.
+var (
+	ipRateLimit     = ratelimit.Limit{PerMinute: 300, Burst: 60}
+	apiKeyRateLimit = ratelimit.Limit{PerMinute: 1200, Burst: 200}
+)
+
+// apiKeyUsageSchedule is how often API key usage counted in memory is
+// stored, on every instance.
+const apiKeyUsageSchedule = "* * * * *"
+
+// watchBuffer is how many events a WatchBills stream can fall behind by
+// before it is closed.
+const watchBuffer = 256
+
+// Readiness fails once the last successful sync of bills or legislators is
+// older than two of the job's intervals, plus freshnessGrace for a run to
+// start and finish. Bills' interval follows the session calendar.
+const (
+	healthCheckTimeout = 5 * time.Second
+	freshnessGrace     = 30 * time.Minute
+	legislatorsMaxAge  = 2*24*time.Hour + freshnessGrace
 )
 
 func main() {
@@ -23,61 +87,298 @@ func main() {
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 
 	// ---------------------------------------------------------------------------
-	// Initialize PocketBase
+	// Load settings from the defaults, --config, the environment and flags
 	// ---------------------------------------------------------------------------
-	dataDir := os.Getenv("POCKETBASE_DATA_DIR")
-	if dataDir == "" {
-		dataDir = "./pb_data"
+	cfg, flags, err := loadConfig(os.Args[1:])
+	if err != nil {
+		logger.Error("invalid config", "error", err)
+		os.Exit(1)
 	}
 
+	// ---------------------------------------------------------------------------
+	// Initialize PocketBase; its --dir flag overrides the configured data dir
+	// ---------------------------------------------------------------------------
 	app := pocketbaseSDK.NewWithConfig(pocketbaseSDK.Config{
-		DefaultDataDir: dataDir,
+		DefaultDataDir: cfg.Store.PocketBaseDataDir,
+	})
+	app.RootCmd.PersistentFlags().AddFlagSet(flags)
+
+	// ---------------------------------------------------------------------------
+	// Export traces if OTEL_EXPORTER_OTLP_ENDPOINT is set
+	// ---------------------------------------------------------------------------
+	shutdownTracing, err := telemetry.SetupTracing(ctx, "api")
+	if err != nil {
+		logger.Error("failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
+	app.OnTerminate().BindFunc(func(te *core.TerminateEvent) error {
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Error("failed to flush traces", "error", err)
+		}
+		return te.Next()
 	})
 
 	// ---------------------------------------------------------------------------
 	// Setup collections and start gRPC server on serve
 	// ---------------------------------------------------------------------------
 	app.OnServe().BindFunc(func(e *core.ServeEvent) error {
-		if err := setupCollections(app); err != nil {
+		if err := pocketbase.SetupCollections(app); err != nil {
 			logger.Error("failed to setup collections", "error", err)
 			return err
 		}
 
-		// Create repositories using PocketBase
-		billRepo := pocketbase.NewBillRepository(app)
-		legislatorRepo := pocketbase.NewLegislatorRepository(app)
+		// Optionally use the shared Supabase Postgres database for domain
+		// data. PocketBase still serves HTTP and admin auth either way.
+		var pool *pgxpool.Pool
+		if cfg.Store.DatabaseURL != "" {
+			var err error
+			pool, err = postgres.Connect(ctx, cfg.Store.DatabaseURL)
+			if err != nil {
+				logger.Error("failed to connect to postgres", "error", err)
+				return err
+			}
+			app.OnTerminate().BindFunc(func(te *core.TerminateEvent) error {
+				pool.Close()
+				return te.Next()
+			})
+		}
 
-		// Start gRPC server
-		lis, err := net.Listen("tcp", ":50051")
+		// Create repositories: Postgres when a database URL is set, else PocketBase
+		var (
+			billRepo       repository.BillRepository
+			legislatorRepo repository.LegislatorRepository
+			statsRepo      repository.StatsRepository
+			syncRepo       repository.SyncRepository
+			jobRunRepo     repository.JobRunRepository
+			deadLetterRepo repository.DeadLetterRepository
+			watchlistRepo  repository.WatchlistRepository
+			notifyRepo     repository.NotificationRepository
+			webhookRepo    repository.WebhookRepository
+			db             string
+		)
+		if pool != nil {
+			billRepo = postgres.NewBillRepository(pool)
+			legislatorRepo = postgres.NewLegislatorRepository(pool)
+			statsRepo = postgres.NewStatsRepository(pool)
+			syncRepo = postgres.NewSyncRepository(pool)
+			jobRunRepo = postgres.NewJobRunRepository(pool)
+			deadLetterRepo = postgres.NewDeadLetterRepository(pool)
+			watchlistRepo = postgres.NewWatchlistRepository(pool)
+			notifyRepo = postgres.NewNotificationRepository(pool)
+			webhookRepo = postgres.NewWebhookRepository(pool)
+			db = tracing.SystemPostgres
+			logger.Info("using postgres repositories")
+		} else {
+			billRepo = pocketbase.NewBillRepository(app)
+			legislatorRepo = pocketbase.NewLegislatorRepository(app)
+			statsRepo = pocketbase.NewStatsRepository(app)
+			syncRepo = pocketbase.NewSyncRepository(app)
+			jobRunRepo = pocketbase.NewJobRunRepository(app)
+			deadLetterRepo = pocketbase.NewDeadLetterRepository(app)
+			watchlistRepo = pocketbase.NewWatchlistRepository(app)
+			notifyRepo = pocketbase.NewNotificationRepository(app)
+			webhookRepo = pocketbase.NewWebhookRepository(app)
+			db = tracing.SystemPocketBase
+			logger.Info("using pocketbase repositories", "data_dir", app.DataDir())
+		}
+
+		// Trace the queries that reach the database.
+		billRepo = tracing.NewBillRepository(billRepo, db)
+		legislatorRepo = tracing.NewLegislatorRepository(legislatorRepo, db)
+		statsRepo = tracing.NewStatsRepository(statsRepo, db)
+		syncRepo = tracing.NewSyncRepository(syncRepo, db)
+		jobRunRepo = tracing.NewJobRunRepository(jobRunRepo, db)
+		deadLetterRepo = tracing.NewDeadLetterRepository(deadLetterRepo, db)
+		watchlistRepo = tracing.NewWatchlistRepository(watchlistRepo, db)
+		notifyRepo = tracing.NewNotificationRepository(notifyRepo, db)
+		webhookRepo = tracing.NewWebhookRepository(webhookRepo, db)
+
+		// Queue notifications and webhook deliveries of the bill and
+		// legislator changes this server's jobs write, and push them to
+		// WatchBills streams.
+		broker := events.NewBroker(watchBuffer)
+		sink := events.Sinks{
+			notify.NewNotifier(notifyRepo, watchlistRepo, logger),
+			webhook.NewPublisher(webhookRepo, logger),
+			broker,
+		}
+		billRepo = events.NewBillRepository(billRepo, sink, logger)
+		legislatorRepo = events.NewLegislatorRepository(legislatorRepo, sink, logger)
+
+		// Cache reads; ingestion jobs bump sync versions to invalidate them.
+		versions := cache.NewVersions(syncRepo, cfg.Cache.SyncCheckInterval)
+		billRepo = cache.NewBillRepository(billRepo, versions, cfg.Cache.BillTTL)
+		legislatorRepo = cache.NewLegislatorRepository(legislatorRepo, versions, cfg.Cache.LegislatorTTL)
+
+		// Special sessions are called by the governor; General Sessions
+		// follow a fixed rule.
+		special, err := calendar.ParseSpecialSessions(cfg.SpecialSessions)
+		if err != nil {
+			return err
+		}
+		sessions := calendar.New(special)
+
+		// Ingestion writes through the caching repositories so this server's
+		// reads see its own syncs immediately.
+		stores := ingest.Stores{
+			Bills:       billRepo,
+			Legislators: legislatorRepo,
+			Stats:       statsRepo,
+			Sync:        syncRepo,
+			DeadLetters: deadLetterRepo,
+		}
+
+		// Run the ingestion jobs in-process unless another instance does.
+		var jobs *scheduler.Scheduler
+		if !cfg.Scheduler.Disabled {
+			jobs = newScheduler(cfg, stores, jobRunRepo, sessions, logger)
+			if err := jobs.Register(app.Cron()); err != nil {
+				return err
+			}
+			app.OnTerminate().BindFunc(func(te *core.TerminateEvent) error {
+				jobs.Stop()
+				return te.Next()
+			})
+			logger.Info("scheduled ingestion jobs", "jobs", jobs.Jobs())
+		}
+
+		// Deliver queued notifications and webhooks, also only on the
+		// instance running the jobs.
+		channels, vapidPublicKey, err := notificationChannels(cfg.Notifications)
 		if err != nil {
 			return err
 		}
+		if jobs != nil {
+			dispatcher := notify.NewDispatcher(notifyRepo, channels, logger.With("job", "notifications"))
+			err := app.Cron().Add("notifications", cfg.Scheduler.Notifications, func() {
+				if _, err := dispatcher.Dispatch(ctx); err != nil {
+					logger.Error("notification dispatch failed", "error", err)
+				}
+			})
+			if err != nil {
+				return err
+			}
+			deliverer := webhook.NewDeliverer(webhookRepo, webhook.NewClient(), logger.With("job", "webhooks"))
+			err = app.Cron().Add("webhooks", cfg.Scheduler.Webhooks, func() {
+				if _, err := deliverer.Deliver(ctx); err != nil {
+					logger.Error("webhook delivery failed", "error", err)
+				}
+			})
+			if err != nil {
+				return err
+			}
+		}
 
-		grpcServer := grpc.NewServer()
-		pb.RegisterBillServiceServer(grpcServer, service.NewBillService(billRepo))
-		pb.RegisterLegislatorServiceServer(grpcServer, service.NewLegislatorService(legislatorRepo))
+		// Secure the gRPC listener with TLS, and mutual TLS if client CAs
+		// are set. With GRPCOnHTTP there's none; gRPC is served on the HTTP
+		// address below.
+		creds, err := grpcCredentials(cfg.TLS)
+		if err != nil {
+			return err
+		}
+		var lis net.Listener
+		if !cfg.Listen.GRPCOnHTTP {
+			lis, err = net.Listen("tcp", cfg.Listen.GRPC)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Identify callers from their Supabase access tokens. Without a
+		// secret or key set, only public RPCs can be called.
+		var verifier *auth.Verifier
+		if cfg.Auth.Enabled() {
+			verifier, err = auth.NewVerifier(auth.VerifierConfig{
+				Secret:   cfg.Auth.SupabaseJWTSecret,
+				JWKSFile: cfg.Auth.SupabaseJWKSFile,
+				Issuer:   cfg.Auth.SupabaseJWTIssuer,
+			})
+			if err != nil {
+				return err
+			}
+		} else {
+			logger.Warn("SUPABASE_JWT_SECRET and SUPABASE_JWKS_FILE not set; authenticated RPCs disabled")
+		}
+		authn := auth.NewInterceptor(verifier)
+
+		// Rate limit callers by API key or IP address. Keys are always kept
+		// in PocketBase, with the superusers who manage them.
+		apiKeyRepo := tracing.NewAPIKeyRepository(pocketbase.NewAPIKeyRepository(app), tracing.SystemPocketBase)
+		limits := ratelimit.NewGuard(apiKeyRepo, ratelimit.Config{IP: ipRateLimit, Key: apiKeyRateLimit}, logger)
+		err = app.Cron().Add("api-key-usage", apiKeyUsageSchedule, func() {
+			if err := limits.Flush(ctx); err != nil {
+				logger.Error("storing api key usage failed", "error", err)
+			}
+		})
+		if err != nil {
+			return err
+		}
+		app.OnTerminate().BindFunc(func(te *core.TerminateEvent) error {
+			if err := limits.Flush(ctx); err != nil {
+				logger.Error("storing api key usage failed", "error", err)
+			}
+			return te.Next()
+		})
+
+		// Trace and measure every RPC, including those the limits reject.
+		grpcServer := grpc.NewServer(
+			grpc.Creds(inproc.ServerCredentials(creds)),
+			grpc.StatsHandler(otelgrpc.NewServerHandler()),
+			grpc.ChainUnaryInterceptor(telemetry.UnaryInterceptor(), limits.Unary(), authn.Unary(), requireSuperuser(app)),
+			grpc.ChainStreamInterceptor(telemetry.StreamInterceptor(), limits.Stream(), authn.Stream()),
+		)
+		pb.RegisterBillServiceServer(grpcServer, service.NewBillService(billRepo, watchlistRepo, broker))
+		pb.RegisterLegislatorServiceServer(grpcServer, service.NewLegislatorService(legislatorRepo, statsRepo))
 		pb.RegisterDistrictServiceServer(grpcServer, service.NewDistrictService(legislatorRepo))
+		pb.RegisterAdminServiceServer(grpcServer, service.NewAdminService(
+			billRepo, legislatorRepo, adminJobs(jobs), jobRunRepo, deadLetterRepo,
+			ingest.NewResyncer(stores, cfg.Utah.Token, logger.With("job", "resync")),
+		))
+		pb.RegisterStatusServiceServer(grpcServer, service.NewStatusService(sessions))
+		pb.RegisterWatchlistServiceServer(grpcServer, service.NewWatchlistService(watchlistRepo, billRepo, legislatorRepo))
+		pb.RegisterNotificationServiceServer(grpcServer, service.NewNotificationService(
+			notifyRepo, billRepo, legislatorRepo, slices.Sorted(maps.Keys(channels)), vapidPublicKey,
+		))
+		pb.RegisterWebhookServiceServer(grpcServer, service.NewWebhookService(webhookRepo, billRepo, legislatorRepo))
+		pb.RegisterApiKeyServiceServer(grpcServer, service.NewAPIKeyService(apiKeyRepo))
 
-		logger.Info("serving gRPC", "addr", ":50051")
-		go func() {
+		// Report every service as SERVING over grpc.health.v1 until the
+		// server stops.
+		healthServer := grpchealth.NewServer()
+		healthpb.RegisterHealthServer(grpcServer, healthServer)
+		for name := range grpcServer.GetServiceInfo() {
+			healthServer.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+		}
+		app.OnTerminate().BindFunc(func(te *core.TerminateEvent) error {
+			healthServer.Shutdown()
+			return te.Next()
+		})
+
+		// Serve gRPC on its listener, and in memory to the gateway.
+		serving := &health.Latch{}
+		serve := func(lis net.Listener) {
 			if err := grpcServer.Serve(lis); err != nil {
-				logger.Error("gRPC server failed", "error", err)
+				logger.Error("gRPC server failed", "addr", lis.Addr().String(), "error", err)
+				healthServer.Shutdown()
+				serving.Fail(fmt.Errorf("gRPC server stopped: %w", err))
 			}
-		}()
-
-		// Start gRPC-Gateway
-		// SECURITY NOTE: Using insecure credentials is acceptable here because this
-		// is internal communication between the gRPC-Gateway and the gRPC server
-		// running on localhost. In production, consider using mutual TLS.
-		conn, err := grpc.NewClient(
-			"localhost:50051",
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-		)
+		}
+		if lis != nil {
+			logger.Info("serving gRPC", "addr", lis.Addr().String(), "tls", cfg.TLS.Enabled())
+			go serve(lis)
+		}
+		local := inproc.Listen()
+		go serve(local)
+
+		// Start gRPC-Gateway, calling the services in process
+		conn, err := local.Dial(grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
 		if err != nil {
 			return err
 		}
 
-		gwmux := runtime.NewServeMux()
+		// Streams are served as Server-Sent Events to clients that accept
+		// them, and as newline-delimited JSON otherwise.
+		gwmux := runtime.NewServeMux(runtime.WithMarshalerOption(sse.ContentType, sse.NewMarshaler()))
 
 		if err := pb.RegisterBillServiceHandler(ctx, gwmux, conn); err != nil {
 			return err
@@ -88,91 +389,367 @@ func main() {
 		if err := pb.RegisterDistrictServiceHandler(ctx, gwmux, conn); err != nil {
 			return err
 		}
+		if err := pb.RegisterAdminServiceHandler(ctx, gwmux, conn); err != nil {
+			return err
+		}
+		if err := pb.RegisterStatusServiceHandler(ctx, gwmux, conn); err != nil {
+			return err
+		}
+		if err := pb.RegisterWatchlistServiceHandler(ctx, gwmux, conn); err != nil {
+			return err
+		}
+		if err := pb.RegisterNotificationServiceHandler(ctx, gwmux, conn); err != nil {
+			return err
+		}
+		if err := pb.RegisterWebhookServiceHandler(ctx, gwmux, conn); err != nil {
+			return err
+		}
+		if err := pb.RegisterApiKeyServiceHandler(ctx, gwmux, conn); err != nil {
+			return err
+		}
 
-		// Mount gRPC-Gateway on PocketBase router
-		e.Router.Any("/api/v1/{path...}", func(c *core.RequestEvent) error {
-			gwmux.ServeHTTP(c.Response, c.Request)
+		// Mount gRPC-Gateway on PocketBase router under the API prefix, with
+		// ETag/Last-Modified revalidation for the mobile app, behind the rate
+		// limits. Traces start here, or continue the caller's.
+		prefix := cfg.Listen.APIPrefix
+		traced := otelhttp.NewHandler(gwmux, "gateway")
+		gateway := http.StripPrefix(prefix, limits.Handler(httpcache.Handler(traced, lastSynced(versions)), realIP(app)))
+		// Streams can't be buffered for revalidation, and outlive the
+		// server's write timeout.
+		stream := http.StripPrefix(prefix, limits.Handler(sse.Stream(traced), realIP(app)))
+		e.Router.GET(prefix+"/v1/bills:watch", func(c *core.RequestEvent) error {
+			stream.ServeHTTP(c.Response, c.Request)
 			return nil
 		})
+		e.Router.Any(prefix+"/v1/{path...}", func(c *core.RequestEvent) error {
+			gateway.ServeHTTP(c.Response, c.Request)
+			return nil
+		})
+
+		logger.Info("serving gRPC-Gateway", "addr", prefix+"/v1")
 
-		logger.Info("serving gRPC-Gateway", "addr", "/api/v1")
-		return e.Next()
+		// Liveness fails if the gRPC server stopped. Readiness also needs it
+		// to answer health checks, the databases to respond and the synced
+		// data to be fresh.
+		live := health.NewChecker(healthCheckTimeout)
+		live.Add("grpc_server", serving.Check)
+		ready := health.NewChecker(healthCheckTimeout)
+		ready.Add("grpc", health.GRPC(conn))
+		ready.Add("pocketbase", health.Ping(func(ctx context.Context) error {
+			_, err := app.DB().NewQuery("SELECT 1").WithContext(ctx).Execute()
+			return err
+		}))
+		if pool != nil {
+			ready.Add("postgres", health.Ping(pool.Ping))
+		}
+		ready.Add("bills_sync", health.Freshness(jobRunRepo, "bills", func(now time.Time) time.Duration {
+			return 2*calendar.PollInterval(sessions.Mode(now)) + freshnessGrace
+		}))
+		ready.Add("legislators_sync", health.Freshness(jobRunRepo, "legislators", func(time.Time) time.Duration {
+			return legislatorsMaxAge
+		}))
+		liveness, readiness := live.Handler(), ready.Handler()
+		e.Router.GET("/healthz", func(c *core.RequestEvent) error {
+			liveness.ServeHTTP(c.Response, c.Request)
+			return nil
+		})
+		e.Router.GET("/readyz", func(c *core.RequestEvent) error {
+			readiness.ServeHTTP(c.Response, c.Request)
+			return nil
+		})
+
+		// Serve Prometheus metrics, behind the metrics token if one is set.
+		metrics := telemetry.Handler(cfg.MetricsToken)
+		e.Router.GET("/metrics", func(c *core.RequestEvent) error {
+			metrics.ServeHTTP(c.Response, c.Request)
+			return nil
+		})
+
+		// The HTTP server's handler is built by e.Next.
+		if err := e.Next(); err != nil {
+			return err
+		}
+		if cfg.Listen.GRPCOnHTTP {
+			serveGRPCOnHTTP(e.Server, grpcServer, realIP(app))
+			logger.Info("serving gRPC", "addr", e.Server.Addr)
+		}
+		return nil
 	})
 
 	// ---------------------------------------------------------------------------
-	// Start PocketBase (handles graceful shutdown internally)
+	// Start PocketBase (handles graceful shutdown internally), serving on the
+	// configured address and origins unless its flags say otherwise
 	// ---------------------------------------------------------------------------
+	serve := cmd.NewServeCommand(app, true)
+	if cfg.Listen.HTTP != "" {
+		serve.PersistentFlags().Lookup("http").Value.Set(cfg.Listen.HTTP)
+	}
+	if len(cfg.CORS.AllowedOrigins) > 0 {
+		// Replace rather than Set, so --origins replaces them in turn.
+		serve.PersistentFlags().Lookup("origins").Value.(pflag.SliceValue).Replace(cfg.CORS.AllowedOrigins)
+	}
+	app.RootCmd.AddCommand(cmd.NewSuperuserCommand(app), serve, configCommand(&cfg))
+
 	logger.Info("starting PocketBase")
-	if err := app.Start(); err != nil {
+	if err := app.Execute(); err != nil {
 		logger.Error("failed to start app", "error", err)
 		os.Exit(1)
 	}
 }
 
-// setupCollections creates the legislators and bills collections if they don't exist,
-// or updates their schema if they do. This is idempotent.
-func setupCollections(app core.App) error {
-	// Create or update legislators collection
-	legislators, err := app.FindCollectionByNameOrId("legislators")
-	if err != nil {
-		legislators = core.NewBaseCollection("legislators")
-	}
-
-	legislators.Fields = core.NewFieldsList(
-		&core.TextField{Name: "chamber", Required: true, Max: 10},
-		&core.NumberField{Name: "district_number", Required: true},
-		&core.TextField{Name: "first_name", Required: true, Max: 100},
-		&core.TextField{Name: "last_name", Required: true, Max: 100},
-		&core.TextField{Name: "party", Max: 50},
-		&core.EmailField{Name: "email"},
-		&core.TextField{Name: "phone", Max: 20},
-		&core.URLField{Name: "website"},
-		&core.URLField{Name: "image_url"},
-		&core.TextField{Name: "utah_legislature_id", Max: 50},
-		&core.NumberField{Name: "legiscan_id"},
-		&core.TextField{Name: "openstates_id", Max: 50},
-	)
-
-	// Public read, authenticated admin write
-	legislators.ListRule = types.Pointer("")
-	legislators.ViewRule = types.Pointer("")
-	legislators.CreateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
-	legislators.UpdateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
-	legislators.DeleteRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
-
-	if err := app.Save(legislators); err != nil {
-		return err
-	}
-
-	// Create or update bills collection
-	bills, err := app.FindCollectionByNameOrId("bills")
+// newScheduler registers the ingestion jobs whose credentials are
+// configured (the same settings the ingest command reads). Legislators from
+// OpenStates alone need no token.
+// Bills are polled at the cadence of the session calendar's current mode:
+// every 15 minutes on a session's final night, hourly in session, daily in
+// the interim and weekly otherwise.
+func newScheduler(cfg config.Server, stores ingest.Stores, runs repository.JobRunRepository, sessions *calendar.Calendar, logger *slog.Logger) *scheduler.Scheduler {
+	s := scheduler.New(runs, logger, cfg.Scheduler.Jitter)
+
+	token := cfg.Utah.Token
+	if token != "" || cfg.LegislatorsSource == ingest.SourceOpenStates {
+		legislators := ingest.LegislatorOptions{Source: cfg.LegislatorsSource, Token: token}
+		s.Add(scheduler.Job{
+			Name:     "legislators",
+			Schedule: cfg.Scheduler.Legislators,
+			Run: func(ctx context.Context) (ingest.Result, error) {
+				return ingest.SyncLegislators(ctx, stores, legislators, logger.With("job", "legislators"))
+			},
+		})
+	}
+	if token != "" {
+		s.Add(scheduler.Job{
+			Name:     "bills",
+			Schedule: cfg.Scheduler.Bills,
+			Active:   sessions.Due,
+			Run: func(ctx context.Context) (ingest.Result, error) {
+				session := cfg.Utah.Session
+				if session == "" {
+					session = sessions.Latest(time.Now()).Name
+				}
+				opts := ingest.BillOptions{Token: token, Session: session}
+				return ingest.SyncBills(ctx, stores, opts, logger.With("job", "bills"))
+			},
+		})
+	} else if cfg.LegislatorsSource == ingest.SourceOpenStates {
+		logger.Warn("UTAH_LEGISLATURE_TOKEN not set; bills job disabled")
+	} else {
+		logger.Warn("UTAH_LEGISLATURE_TOKEN not set; legislators and bills jobs disabled")
+	}
+
+	if cfg.LegiScan.APIKey != "" {
+		stats := ingest.StatsOptions{APIKey: cfg.LegiScan.APIKey, SessionYear: cfg.LegiScan.SessionYear, Force: cfg.LegiScan.Force}
+		s.Add(scheduler.Job{
+			Name:     "legislator_stats",
+			Schedule: cfg.Scheduler.Stats,
+			Run: func(ctx context.Context) (ingest.Result, error) {
+				return ingest.SyncLegislatorStats(ctx, stores, stats, logger.With("job", "legislator_stats"))
+			},
+		})
+	}
+	return s
+}
+
+// notificationChannels configures the notification channels whose settings
+// are set, returning them by name along with the VAPID public key browsers
+// subscribe to web push with.
+func notificationChannels(cfg config.Notifications) (map[string]notify.Channel, string, error) {
+	channels := map[string]notify.Channel{}
+	if cfg.SMTPAddr != "" {
+		email, err := notify.NewEmail(notify.EmailConfig{
+			Addr:     cfg.SMTPAddr,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.SMTPFrom,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		channels[domain.ChannelEmail] = email
+	}
+
+	var vapidPublicKey string
+	if cfg.VAPIDPrivateKey != "" {
+		push, err := notify.NewWebPush(notify.WebPushConfig{PrivateKey: cfg.VAPIDPrivateKey, Subject: cfg.VAPIDSubject})
+		if err != nil {
+			return nil, "", err
+		}
+		channels[domain.ChannelWebPush] = push
+		vapidPublicKey = push.PublicKey()
+	}
+
+	if cfg.FCMCredentialsFile != "" {
+		creds, err := os.ReadFile(cfg.FCMCredentialsFile)
+		if err != nil {
+			return nil, "", err
+		}
+		fcm, err := notify.NewFCM(notify.FCMConfig{Credentials: creds})
+		if err != nil {
+			return nil, "", err
+		}
+		channels[domain.ChannelFCM] = fcm
+	}
+	return channels, vapidPublicKey, nil
+}
+
+// loadConfig loads the server settings, reading the flags among args and
+// ignoring the rest, which are PocketBase's. It returns the flags so the
+// root command can accept and document them.
+func loadConfig(args []string) (config.Server, *pflag.FlagSet, error) {
+	cfg := config.DefaultServer()
+	flags := pflag.NewFlagSet("api", pflag.ContinueOnError)
+	flags.ParseErrorsWhitelist.UnknownFlags = true
+	flags.SetOutput(io.Discard)
+	configPath := flags.String("config", "", "JSON config file")
+	config.BindFlags(flags, &cfg)
+	if err := flags.Parse(args); err != nil && !errors.Is(err, pflag.ErrHelp) {
+		return cfg, nil, err
+	}
+	if err := config.Load(&cfg, *configPath, flags); err != nil {
+		return cfg, nil, err
+	}
+	return cfg, flags, cfg.Validate()
+}
+
+// configCommand prints the loaded settings, redacting secrets.
+func configCommand(cfg *config.Server) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the server settings",
+	}
+	c.AddCommand(&cobra.Command{
+		Use:   "print",
+		Short: "Print the settings as a JSON config file, with secrets redacted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return config.Print(cmd.OutOrStdout(), cfg)
+		},
+	})
+	return c
+}
+
+// grpcCredentials returns the credentials of the gRPC server's listener:
+// TLS if a certificate is set, requiring client certificates if client CAs
+// are, and plaintext otherwise.
+func grpcCredentials(cfg config.TLS) (credentials.TransportCredentials, error) {
+	if !cfg.Enabled() {
+		return insecure.NewCredentials(), nil
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
 	if err != nil {
-		bills = core.NewBaseCollection("bills")
-	}
-
-	bills.Fields = core.NewFieldsList(
-		&core.TextField{Name: "bill_number", Required: true, Max: 20},
-		&core.TextField{Name: "bill_type", Required: true, Max: 10},
-		&core.NumberField{Name: "session_year", Required: true},
-		&core.TextField{Name: "title", Required: true, Max: 500},
-		&core.TextField{Name: "description", Max: 10000},
-		&core.TextField{Name: "status", Required: true, Max: 50},
-		&core.RelationField{Name: "sponsor", CollectionId: legislators.Id},
-		&core.URLField{Name: "full_text_url"},
-		&core.TextField{Name: "last_action", Max: 500},
-		&core.DateField{Name: "last_action_date"},
-		&core.URLField{Name: "fiscal_note_url"},
-		&core.DateField{Name: "effective_date"},
-		&core.TextField{Name: "utah_legislature_id", Max: 50},
-		&core.NumberField{Name: "legiscan_id"},
-	)
-
-	// Public read, authenticated admin write
-	bills.ListRule = types.Pointer("")
-	bills.ViewRule = types.Pointer("")
-	bills.CreateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
-	bills.UpdateRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
-	bills.DeleteRule = types.Pointer("@request.auth.id != '' && @request.auth.isAdmin = true")
-
-	return app.Save(bills)
+		return nil, fmt.Errorf("load tls certificate: %w", err)
+	}
+	tlsConfig := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+	if cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read tls client CAs: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("read tls client CAs: no certificates in %s", cfg.ClientCAFile)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// serveGRPCOnHTTP makes server pass gRPC requests to grpcServer, and accept
+// HTTP/2 without TLS (h2c) from gRPC clients, which don't upgrade from
+// HTTP/1.1. Everything else still goes to the server's handler. Calls are
+// rate limited by clientIP, as gateway requests are, since behind a proxy
+// their peer address is the proxy's.
+func serveGRPCOnHTTP(server *http.Server, grpcServer *grpc.Server, clientIP func(*http.Request) string) {
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(true)
+	server.Protocols = protocols
+
+	next := server.Handler
+	server.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		// Streams outlive the server's timeouts.
+		rc := http.NewResponseController(w)
+		_ = rc.SetReadDeadline(time.Time{})
+		_ = rc.SetWriteDeadline(time.Time{})
+		grpcServer.ServeHTTP(w, r.WithContext(ratelimit.WithClientIP(r.Context(), clientIP(r))))
+	})
+}
+
+// adminJobs returns jobs as a service.JobScheduler, keeping a nil
+// *scheduler.Scheduler a nil interface.
+func adminJobs(jobs *scheduler.Scheduler) service.JobScheduler {
+	if jobs == nil {
+		return nil
+	}
+	return jobs
+}
+
+// realIP returns the client IP address of a request as PocketBase reports
+// it, trusting the proxy headers set in its settings.
+func realIP(app core.App) func(*http.Request) string {
+	return func(r *http.Request) string {
+		e := core.RequestEvent{App: app}
+		e.Request = r
+		return e.RealIP()
+	}
+}
+
+// lastSynced reports when the data behind a gateway request last changed:
+// the latest sync of bills (and their sponsors) for bill routes, and of
+// legislators for legislator and district routes. Other routes, including
+// stats (written by their own job) and users' feeds of followed bills, have
+// no Last-Modified and rely on ETags alone.
+func lastSynced(versions *cache.Versions) httpcache.LastModifiedFunc {
+	return func(r *http.Request) time.Time {
+		path := r.URL.Path
+		switch {
+		case strings.HasSuffix(path, "/stats"):
+			return time.Time{}
+		case r.URL.Query().Get("followed") == "true":
+			// A user's feed also changes when they follow a bill.
+			return time.Time{}
+		case strings.HasPrefix(path, "/v1/bills"):
+			bills := versions.Get(r.Context(), domain.EntityBills).SyncedAt
+			legislators := versions.Get(r.Context(), domain.EntityLegislators).SyncedAt
+			if legislators.After(bills) {
+				return legislators
+			}
+			return bills
+		case strings.HasPrefix(path, "/v1/legislators"), strings.HasPrefix(path, "/v1/districts"):
+			return versions.Get(r.Context(), domain.EntityLegislators).SyncedAt
+		}
+		return time.Time{}
+	}
+}
+
+// requireSuperuser rejects calls to RPCs with the SUPERUSER auth policy
+// (the AdminService) that don't carry a valid PocketBase superuser token.
+// The gateway forwards the HTTP Authorization header as "authorization"
+// metadata, so the same token works over both.
+func requireSuperuser(app core.App) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if auth.MethodPolicy(info.FullMethod) != pb.AuthPolicy_AUTH_POLICY_SUPERUSER {
+			return handler(ctx, req)
+		}
+
+		token := auth.BearerToken(ctx)
+		if token == "" {
+			return nil, status.Error(codes.Unauthenticated, "superuser token required")
+		}
+
+		rec, err := app.FindAuthRecordByToken(token, core.TokenTypeAuth)
+		if err != nil || !rec.IsSuperuser() {
+			return nil, status.Error(codes.PermissionDenied, "superuser access required")
+		}
+		return handler(ctx, req)
+	}
 }
@@ -0,0 +1,48 @@
+-- Stable legislator identity: people are keyed on their Utah Legislature ID,
+-- and the seats they hold are tracked as time-bounded terms. Keying people on
+-- (chamber, district_number) turned chamber moves and redistricting into
+-- identity changes and rewrote sponsorship history.
+
+ALTER TABLE utah_legislators
+    DROP CONSTRAINT IF EXISTS utah_legislators_chamber_district_number_key;
+
+CREATE UNIQUE INDEX utah_legislators_utah_legislature_id_key
+    ON utah_legislators (utah_legislature_id)
+    WHERE utah_legislature_id IS NOT NULL AND utah_legislature_id <> '';
+
+-- ---------------------------------------------------------------------------
+-- Legislator terms (seats held over time; end_date NULL = current)
+-- ---------------------------------------------------------------------------
+CREATE TABLE utah_legislator_terms (
+    id               UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    legislator_id    UUID NOT NULL REFERENCES utah_legislators(id) ON DELETE CASCADE,
+    chamber          TEXT NOT NULL CHECK (chamber IN ('house', 'senate')),
+    district_number  INTEGER NOT NULL,
+    start_date       DATE,              -- NULL when unknown
+    end_date         DATE,
+    created_at       TIMESTAMPTZ DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX utah_legislator_terms_seat_idx ON utah_legislator_terms (chamber, district_number);
+CREATE INDEX utah_legislator_terms_legislator_idx ON utah_legislator_terms (legislator_id);
+
+-- At most one current holder per seat.
+CREATE UNIQUE INDEX utah_legislator_terms_current_seat_key
+    ON utah_legislator_terms (chamber, district_number)
+    WHERE end_date IS NULL;
+
+-- Existing rows each held exactly one seat; open a current term for them.
+INSERT INTO utah_legislator_terms (legislator_id, chamber, district_number, start_date)
+SELECT id, chamber, district_number, term_start
+FROM utah_legislators;
+
+CREATE TRIGGER update_utah_legislator_terms_updated_at
+    BEFORE UPDATE ON utah_legislator_terms
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE utah_legislator_terms ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Utah legislator terms are publicly readable"
+    ON utah_legislator_terms FOR SELECT TO PUBLIC USING (true);
@@ -0,0 +1,27 @@
+-- Per-session legislator voting statistics, computed from LegiScan roll
+-- calls by the legislator_stats job and replaced wholesale per session.
+
+CREATE TABLE utah_legislator_stats (
+    id                   UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    legislator_id        UUID NOT NULL REFERENCES utah_legislators(id) ON DELETE CASCADE,
+    session_id           INTEGER NOT NULL,          -- LegiScan session_id
+    session_name         TEXT,
+    session_year         INTEGER NOT NULL,
+    eligible_votes       INTEGER NOT NULL DEFAULT 0,
+    votes_cast           INTEGER NOT NULL DEFAULT 0,
+    missed_votes         INTEGER NOT NULL DEFAULT 0,
+    participation_rate   DOUBLE PRECISION NOT NULL DEFAULT 0,
+    votes_against_party  INTEGER NOT NULL DEFAULT 0,
+    bills_sponsored      INTEGER NOT NULL DEFAULT 0,
+    bills_passed         INTEGER NOT NULL DEFAULT 0,
+    agreement            JSONB NOT NULL DEFAULT '[]'::jsonb,
+    computed_at          TIMESTAMPTZ NOT NULL,
+    UNIQUE (legislator_id, session_id)
+);
+
+CREATE INDEX utah_legislator_stats_session_idx ON utah_legislator_stats (session_id);
+
+ALTER TABLE utah_legislator_stats ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Utah legislator stats are publicly readable"
+    ON utah_legislator_stats FOR SELECT TO PUBLIC USING (true);
@@ -0,0 +1,15 @@
+-- Columns the Go API stores alongside the original Utah schema, so its
+-- Postgres repository (DATABASE_URL) keeps the same data as PocketBase:
+-- OpenStates offices and social handles, and per-field source provenance
+-- ({"field": {"source": "...", "updated_at": "..."}}).
+
+ALTER TABLE utah_legislators
+    ADD COLUMN offices    JSONB NOT NULL DEFAULT '[]'::jsonb,
+    ADD COLUMN twitter    TEXT,
+    ADD COLUMN facebook   TEXT,
+    ADD COLUMN instagram  TEXT,
+    ADD COLUMN youtube    TEXT,
+    ADD COLUMN provenance JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+ALTER TABLE utah_bills
+    ADD COLUMN provenance JSONB NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1,13 @@
+-- Per-entity sync markers. Ingestion jobs bump an entity's version after a
+-- sync so API servers can drop cached reads and revalidate clients.
+
+CREATE TABLE sync_versions (
+    entity     TEXT PRIMARY KEY,              -- "bills", "legislators"
+    version    BIGINT NOT NULL DEFAULT 0,
+    synced_at  TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE sync_versions ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Sync versions are publicly readable"
+    ON sync_versions FOR SELECT TO PUBLIC USING (true);
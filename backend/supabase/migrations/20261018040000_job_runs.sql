@@ -0,0 +1,19 @@
+-- Run history of the ingestion jobs executed by the API server's embedded
+-- scheduler, whether on schedule or triggered through the admin API.
+
+CREATE TABLE job_runs (
+    id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    job          TEXT NOT NULL,                  -- "legislators", "bills", ...
+    trigger      TEXT NOT NULL,                  -- "schedule" or "manual"
+    status       TEXT NOT NULL,                  -- "running", "succeeded", "failed"
+    started_at   TIMESTAMPTZ NOT NULL,
+    finished_at  TIMESTAMPTZ,
+    upserted     INTEGER NOT NULL DEFAULT 0,
+    failed       INTEGER NOT NULL DEFAULT 0,
+    error        TEXT
+);
+
+CREATE INDEX job_runs_job_started_idx ON job_runs (job, started_at DESC);
+
+-- Operator data: no public policy, so only the service role can read it.
+ALTER TABLE job_runs ENABLE ROW LEVEL SECURITY;
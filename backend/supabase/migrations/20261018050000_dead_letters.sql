@@ -0,0 +1,18 @@
+-- Records the ingestion jobs failed to write, kept with their payload so
+-- they can be retried individually by the resync command or admin API.
+
+CREATE TABLE dead_letters (
+    id               UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    kind             TEXT NOT NULL,               -- "bill" or "legislator"
+    source           TEXT,                        -- upstream source, e.g. "utah_legislature"
+    key              TEXT NOT NULL,               -- "2026GS/HB0001" for bills, source ID for legislators
+    payload          JSONB,
+    error            TEXT,
+    attempts         INTEGER NOT NULL DEFAULT 1,
+    first_failed_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_failed_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (kind, key)
+);
+
+-- Operator data: no public policy, so only the service role can read it.
+ALTER TABLE dead_letters ENABLE ROW LEVEL SECURITY;
@@ -0,0 +1,14 @@
+-- Keep the sponsor's Utah Legislature ID on each bill, so bills stored
+-- before their sponsor can be linked once the legislator is synced.
+
+ALTER TABLE utah_bills
+    ADD COLUMN sponsor_utah_id TEXT;
+
+-- Backfill from bills already linked.
+UPDATE utah_bills b
+SET sponsor_utah_id = l.utah_legislature_id
+FROM utah_legislators l
+WHERE l.id = b.sponsor_id;
+
+CREATE INDEX utah_bills_unresolved_sponsor_idx
+    ON utah_bills (sponsor_utah_id) WHERE sponsor_id IS NULL;
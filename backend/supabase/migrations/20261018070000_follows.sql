@@ -0,0 +1,28 @@
+-- Per-user watchlists: the bills, legislators, committees and Utah Code
+-- titles each user follows.
+
+CREATE TABLE follows (
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id     UUID NOT NULL REFERENCES auth.users(id) ON DELETE CASCADE,
+    kind        TEXT NOT NULL CHECK (kind IN ('bill', 'legislator', 'committee', 'code_title')),
+    target_id   TEXT NOT NULL,  -- bill or legislator id, committee code, or code title
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, kind, target_id)
+);
+
+-- Who follows an item, for notifications.
+CREATE INDEX idx_follows_kind_target ON follows (kind, target_id);
+
+ALTER TABLE follows ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can view their own follows"
+    ON follows FOR SELECT
+    USING (auth.uid() = user_id);
+
+CREATE POLICY "Users can insert their own follows"
+    ON follows FOR INSERT
+    WITH CHECK (auth.uid() = user_id);
+
+CREATE POLICY "Users can delete their own follows"
+    ON follows FOR DELETE
+    USING (auth.uid() = user_id);
@@ -0,0 +1,82 @@
+-- Notifications of bill status changes: per-user delivery settings,
+-- subscriptions, push devices and the outbox the API server sends from.
+
+-- Delivery settings live alongside the notification_enabled flag the app
+-- already has.
+ALTER TABLE settings
+    ADD COLUMN notification_email       TEXT,
+    ADD COLUMN timezone                 TEXT,         -- IANA zone for quiet hours; America/Denver if NULL
+    ADD COLUMN quiet_hours_start        INTEGER NOT NULL DEFAULT 0 CHECK (quiet_hours_start BETWEEN 0 AND 1439),
+    ADD COLUMN quiet_hours_end          INTEGER NOT NULL DEFAULT 0 CHECK (quiet_hours_end BETWEEN 0 AND 1439),
+    ADD COLUMN digest_interval_minutes  INTEGER NOT NULL DEFAULT 0 CHECK (digest_interval_minutes >= 0),
+    ADD COLUMN last_digest_at           TIMESTAMPTZ;
+
+CREATE TABLE notification_subscriptions (
+    id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id      UUID NOT NULL REFERENCES auth.users(id) ON DELETE CASCADE,
+    event_types  TEXT[] NOT NULL DEFAULT '{}',  -- empty matches every type
+    bill_id      TEXT,  -- stored bill id
+    sponsor_id   TEXT,  -- stored legislator id
+    followed     BOOLEAN NOT NULL DEFAULT false, -- matches the user's followed bills
+    channels     TEXT[] NOT NULL DEFAULT '{}',  -- empty means every channel
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notification_subscriptions_user ON notification_subscriptions (user_id);
+
+CREATE TABLE notification_devices (
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id     UUID NOT NULL REFERENCES auth.users(id) ON DELETE CASCADE,
+    channel     TEXT NOT NULL CHECK (channel IN ('webpush', 'fcm')),
+    token       TEXT NOT NULL,  -- web push endpoint or FCM registration token
+    p256dh      TEXT,           -- web push subscription keys
+    auth        TEXT,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (channel, token)
+);
+
+CREATE INDEX idx_notification_devices_user ON notification_devices (user_id);
+
+-- The outbox: notifications matched to a user, waiting for delivery.
+CREATE TABLE notifications (
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id     UUID NOT NULL REFERENCES auth.users(id) ON DELETE CASCADE,
+    event_type  TEXT NOT NULL,
+    bill_id     TEXT,
+    title       TEXT NOT NULL,
+    body        TEXT NOT NULL,
+    channels    TEXT[] NOT NULL DEFAULT '{}',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp(),
+    sent_at     TIMESTAMPTZ
+);
+
+CREATE INDEX idx_notifications_pending ON notifications (created_at) WHERE sent_at IS NULL;
+
+ALTER TABLE notification_subscriptions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE notification_devices ENABLE ROW LEVEL SECURITY;
+ALTER TABLE notifications ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can view their own notification subscriptions"
+    ON notification_subscriptions FOR SELECT
+    USING (auth.uid() = user_id);
+
+CREATE POLICY "Users can insert their own notification subscriptions"
+    ON notification_subscriptions FOR INSERT
+    WITH CHECK (auth.uid() = user_id);
+
+CREATE POLICY "Users can delete their own notification subscriptions"
+    ON notification_subscriptions FOR DELETE
+    USING (auth.uid() = user_id);
+
+CREATE POLICY "Users can view their own notification devices"
+    ON notification_devices FOR SELECT
+    USING (auth.uid() = user_id);
+
+CREATE POLICY "Users can delete their own notification devices"
+    ON notification_devices FOR DELETE
+    USING (auth.uid() = user_id);
+
+-- Users can read their notification history; only the API writes it.
+CREATE POLICY "Users can view their own notifications"
+    ON notifications FOR SELECT
+    USING (auth.uid() = user_id);
@@ -0,0 +1,40 @@
+-- Outbound webhooks: URLs that receive legislative events as signed POSTs,
+-- and the delivery log the API server sends and retries from.
+
+CREATE TABLE webhooks (
+    id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    owner_id     UUID NOT NULL REFERENCES auth.users(id) ON DELETE CASCADE,
+    url          TEXT NOT NULL,
+    secret       TEXT NOT NULL,                 -- HMAC-SHA256 signing key
+    event_types  TEXT[] NOT NULL DEFAULT '{}',  -- empty matches every type
+    bill_id      TEXT,                          -- stored bill id
+    sponsor_id   TEXT,                          -- stored legislator id
+    keywords     TEXT[] NOT NULL DEFAULT '{}',  -- matched against titles and names
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhooks_owner ON webhooks (owner_id);
+
+CREATE TABLE webhook_deliveries (
+    id               UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    webhook_id       UUID NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_id         TEXT NOT NULL,
+    event_type       TEXT NOT NULL,
+    payload          JSON NOT NULL,  -- JSON, not JSONB: the exact bytes are signed
+    status           TEXT NOT NULL CHECK (status IN ('pending', 'succeeded', 'failed')),
+    attempts         INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_attempt_at  TIMESTAMPTZ,
+    response_status  INTEGER,
+    last_error       TEXT,
+    replay_of        UUID REFERENCES webhook_deliveries(id) ON DELETE SET NULL,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp()
+);
+
+CREATE INDEX idx_webhook_deliveries_webhook ON webhook_deliveries (webhook_id, created_at DESC);
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+-- Secrets are only ever shown once, by the API, so owners can't read the
+-- tables directly; they manage webhooks through the API.
+ALTER TABLE webhooks ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_deliveries ENABLE ROW LEVEL SECURITY;
@@ -0,0 +1,5 @@
+-- Record the LegiScan dataset_hash each session's stats were computed from,
+-- so the stats job can skip downloading datasets that haven't changed.
+
+ALTER TABLE utah_legislator_stats
+    ADD COLUMN dataset_hash TEXT NOT NULL DEFAULT '';
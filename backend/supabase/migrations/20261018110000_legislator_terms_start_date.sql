@@ -0,0 +1,7 @@
+-- Terms backfilled from legislators with no term_start were left with a NULL
+-- start_date, which matched any as-of date. As for a seat first seen by a
+-- sync, date them from when they were opened; the next sync with an upstream
+-- start date moves them back.
+UPDATE utah_legislator_terms
+SET start_date = created_at::date
+WHERE start_date IS NULL;